@@ -120,3 +120,202 @@ func TestComputeMedian(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeVolumeWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name           string
+		providerPrices aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]
+		volumes        aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]
+		expectedPrices map[oracletypes.CurrencyPair]*big.Int
+	}{
+		{
+			"empty provider prices",
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]{},
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]{},
+			map[oracletypes.CurrencyPair]*big.Int{},
+		},
+		{
+			"no volumes falls back to the median",
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]{
+				"provider1": {
+					btcusd: big.NewInt(100),
+				},
+				"provider2": {
+					btcusd: big.NewInt(200),
+				},
+			},
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]{},
+			map[oracletypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(150),
+			},
+		},
+		{
+			"equal volumes match the median",
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]{
+				"provider1": {
+					btcusd: big.NewInt(100),
+				},
+				"provider2": {
+					btcusd: big.NewInt(200),
+				},
+			},
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]{
+				"provider1": {
+					btcusd: big.NewFloat(10),
+				},
+				"provider2": {
+					btcusd: big.NewFloat(10),
+				},
+			},
+			map[oracletypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(150),
+			},
+		},
+		{
+			"illiquid providers carry less weight",
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]{
+				"provider1": {
+					btcusd: big.NewInt(100),
+					ethusd: big.NewInt(200),
+				},
+				"provider2": {
+					btcusd: big.NewInt(110),
+					ethusd: big.NewInt(210),
+				},
+				"provider3": {
+					btcusd: big.NewInt(500),
+					ethusd: big.NewInt(220),
+				},
+			},
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]{
+				"provider1": {
+					btcusd: big.NewFloat(1),
+					ethusd: big.NewFloat(1),
+				},
+				"provider2": {
+					btcusd: big.NewFloat(1),
+					ethusd: big.NewFloat(1),
+				},
+				"provider3": {
+					btcusd: big.NewFloat(100),
+					ethusd: big.NewFloat(0.5),
+				},
+			},
+			map[oracletypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(500),
+				ethusd: big.NewInt(210),
+			},
+		},
+		{
+			"providers with zero volume carry no weight",
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]{
+				"provider1": {
+					btcusd: big.NewInt(100),
+				},
+				"provider2": {
+					btcusd: big.NewInt(200),
+				},
+				"provider3": {
+					btcusd:  big.NewInt(300),
+					usdtusd: nil, // should be ignored
+				},
+			},
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]{
+				"provider1": {
+					btcusd: big.NewFloat(0),
+				},
+				"provider2": {
+					btcusd: big.NewFloat(1),
+				},
+				"provider3": {
+					btcusd: big.NewFloat(5),
+				},
+			},
+			map[oracletypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(300),
+			},
+		},
+		{
+			"providers without volume carry the median volume",
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]{
+				"provider1": {
+					btcusd: big.NewInt(100),
+				},
+				"provider2": {
+					btcusd: big.NewInt(200),
+				},
+				"provider3": {
+					btcusd: big.NewInt(300),
+				},
+			},
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]{
+				"provider1": {
+					btcusd: big.NewFloat(1),
+				},
+				"provider3": {
+					btcusd: big.NewFloat(5),
+				},
+			},
+			map[oracletypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(300),
+			},
+		},
+		{
+			"weighting is applied when a provider has no volume",
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int]{
+				"provider1": {
+					btcusd: big.NewInt(100),
+				},
+				"provider2": {
+					btcusd: big.NewInt(110),
+				},
+				"provider3": {
+					btcusd: big.NewInt(500),
+				},
+				"provider4": {
+					btcusd: big.NewInt(510),
+				},
+			},
+			aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]{
+				"provider2": {
+					btcusd: big.NewFloat(1),
+				},
+				"provider3": {
+					btcusd: big.NewFloat(100),
+				},
+				"provider4": {
+					btcusd: big.NewFloat(100),
+				},
+			},
+			map[oracletypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(500),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			medianFn := aggregator.ComputeVolumeWeightedMedian(
+				func() aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float] {
+					return tc.volumes
+				},
+			)
+			prices := medianFn(tc.providerPrices)
+
+			if len(prices) != len(tc.expectedPrices) {
+				t.Fatalf("expected %d prices, got %d", len(tc.expectedPrices), len(prices))
+			}
+
+			for asset, expectedPrice := range tc.expectedPrices {
+				price, ok := prices[asset]
+				if !ok {
+					t.Fatalf("expected price for asset %s", asset)
+				}
+
+				if price.Cmp(expectedPrice) != 0 {
+					t.Fatalf("expected price %s, got %s", expectedPrice, price)
+				}
+			}
+		})
+	}
+}
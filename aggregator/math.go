@@ -50,6 +50,55 @@ func ComputeMedian() AggregateFn[string, map[types.CurrencyPair]*big.Int] {
 	}
 }
 
+// ComputeVolumeWeightedMedian inputs the aggregated prices from all providers and computes the
+// volume-weighted median price for each asset, such that a provider with little volume for an
+// asset cannot carry the same weight as a provider with deep liquidity. volumesFn returns the 24
+// hour volume reported by each provider for each asset at the time of aggregation. If any provider
+// did not report a volume for an asset, the unweighted median price is used for the asset (see
+// CalculateVolumeWeightedMedian).
+func ComputeVolumeWeightedMedian(
+	volumesFn func() AggregatedProviderData[string, map[types.CurrencyPair]*big.Float],
+) AggregateFn[string, map[types.CurrencyPair]*big.Int] {
+	return func(providers AggregatedProviderData[string, map[types.CurrencyPair]*big.Int]) map[types.CurrencyPair]*big.Int {
+		volumes := volumesFn()
+
+		// Aggregate prices across all providers for each asset, along with the volume reported
+		// by each provider.
+		pricesByAsset := make(map[types.CurrencyPair][]WeightedPrice)
+		for provider, providerPrices := range providers {
+			for cp, price := range providerPrices {
+				// Only include prices that are not nil
+				if price == nil {
+					continue
+				}
+
+				var volume *big.Float
+				if providerVolumes, ok := volumes[provider]; ok {
+					volume = providerVolumes[cp]
+				}
+
+				pricesByAsset[cp] = append(pricesByAsset[cp], WeightedPrice{
+					Price:  price,
+					Weight: volume,
+				})
+			}
+		}
+
+		medianPrices := make(map[types.CurrencyPair]*big.Int)
+
+		// Iterate through all assets and compute the volume-weighted median price
+		for cp, prices := range pricesByAsset {
+			if len(prices) == 0 {
+				continue
+			}
+
+			medianPrices[cp] = CalculateVolumeWeightedMedian(prices)
+		}
+
+		return medianPrices
+	}
+}
+
 // CalculateMedian calculates the median from a list of big.Ints. Returns an
 // average if the number of values is even.
 func CalculateMedian(values []*big.Int) *big.Int {
@@ -79,3 +128,114 @@ func CalculateMedian(values []*big.Int) *big.Int {
 
 	return median
 }
+
+// WeightedPrice is a price along with the weight it carries when calculating a weighted median
+// i.e. the volume traded at the price.
+type WeightedPrice struct {
+	// Price is the price.
+	Price *big.Int
+	// Weight is the weight of the price. This must be non-negative.
+	Weight *big.Float
+}
+
+// CalculateVolumeWeightedMedian calculates the weighted median from a list of prices weighted by the
+// volume reported alongside them. A price without a volume carries the median of the reported
+// volumes, such that a provider that does not report volume is never dropped from the median and
+// does not disable the weighting of the providers that do. If none of the volumes are positive,
+// each price carries an equal weight i.e. the median is returned.
+func CalculateVolumeWeightedMedian(prices []WeightedPrice) *big.Int {
+	reported := make([]*big.Float, 0, len(prices))
+	weighted := false
+	for _, price := range prices {
+		if price.Weight == nil {
+			continue
+		}
+
+		reported = append(reported, price.Weight)
+		weighted = weighted || price.Weight.Sign() > 0
+	}
+
+	if weighted {
+		if len(reported) < len(prices) {
+			medianWeight := calculateMedianWeight(reported)
+
+			filled := make([]WeightedPrice, len(prices))
+			for i, price := range prices {
+				filled[i] = price
+				if price.Weight == nil {
+					filled[i].Weight = medianWeight
+				}
+			}
+			prices = filled
+		}
+
+		return CalculateWeightedMedian(prices)
+	}
+
+	values := make([]*big.Int, len(prices))
+	for i, price := range prices {
+		values[i] = price.Price
+	}
+
+	return CalculateMedian(values)
+}
+
+// calculateMedianWeight returns the median of the given weights. Returns an average if the number
+// of weights is even. The weights must not be empty.
+func calculateMedianWeight(weights []*big.Float) *big.Float {
+	sorted := make([]*big.Float, len(weights))
+	copy(sorted, weights)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+
+	median := new(big.Float).Add(sorted[middle-1], sorted[middle])
+	return median.Quo(median, big.NewFloat(2))
+}
+
+// CalculateWeightedMedian calculates the weighted median from a list of weighted prices. The
+// weighted median is the price at which the cumulative weight of the sorted prices reaches half
+// of the total weight. If the cumulative weight is exactly half of the total weight, the average
+// of that price and the next price is returned, such that equal weights yield the same result as
+// CalculateMedian. The weights must not all be zero.
+func CalculateWeightedMedian(prices []WeightedPrice) *big.Int {
+	// Sort the prices.
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Price.Cmp(prices[j].Price) < 0
+	})
+
+	// Compute the median weight.
+	total := new(big.Float)
+	for _, price := range prices {
+		total.Add(total, price.Weight)
+	}
+	middle := new(big.Float).Quo(total, big.NewFloat(2))
+
+	// Iterate through the prices and compute the median price.
+	sum := new(big.Float)
+	for index, price := range prices {
+		sum.Add(sum, price.Weight)
+
+		switch sum.Cmp(middle) {
+		case 0:
+			// If the cumulative weight is exactly half of the total weight, average the price
+			// with the next price.
+			if index < len(prices)-1 {
+				median := new(big.Int).Add(price.Price, prices[index+1].Price)
+				return median.Div(median, big.NewInt(2))
+			}
+
+			return price.Price
+		case 1:
+			return price.Price
+		}
+	}
+
+	// If we reached the end of the list, return the last price.
+	return prices[len(prices)-1].Price
+}
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: slinky/service/v1/admin.proto
+
+package servicev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Admin_Providers_FullMethodName       = "/slinky.service.v1.Admin/Providers"
+	Admin_DisableProvider_FullMethodName = "/slinky.service.v1.Admin/DisableProvider"
+	Admin_EnableProvider_FullMethodName  = "/slinky.service.v1.Admin/EnableProvider"
+	Admin_ExcludePair_FullMethodName     = "/slinky.service.v1.Admin/ExcludePair"
+	Admin_IncludePair_FullMethodName     = "/slinky.service.v1.Admin/IncludePair"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	// Providers defines a method for listing the providers of the oracle along
+	// with their connection status, the time of their latest price and their
+	// error counts for each currency pair.
+	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
+	// DisableProvider defines a method for ignoring the prices of a provider,
+	// either for a given duration or until it is enabled.
+	DisableProvider(ctx context.Context, in *DisableProviderRequest, opts ...grpc.CallOption) (*DisableProviderResponse, error)
+	// EnableProvider defines a method for enabling a disabled provider.
+	EnableProvider(ctx context.Context, in *EnableProviderRequest, opts ...grpc.CallOption) (*EnableProviderResponse, error)
+	// ExcludePair defines a method for ignoring the prices of a single currency
+	// pair on a provider, either for a given duration or until it is included.
+	ExcludePair(ctx context.Context, in *ExcludePairRequest, opts ...grpc.CallOption) (*ExcludePairResponse, error)
+	// IncludePair defines a method for including an excluded currency pair on a
+	// provider.
+	IncludePair(ctx context.Context, in *IncludePairRequest, opts ...grpc.CallOption) (*IncludePairResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
+	out := new(QueryProvidersResponse)
+	err := c.cc.Invoke(ctx, Admin_Providers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DisableProvider(ctx context.Context, in *DisableProviderRequest, opts ...grpc.CallOption) (*DisableProviderResponse, error) {
+	out := new(DisableProviderResponse)
+	err := c.cc.Invoke(ctx, Admin_DisableProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) EnableProvider(ctx context.Context, in *EnableProviderRequest, opts ...grpc.CallOption) (*EnableProviderResponse, error) {
+	out := new(EnableProviderResponse)
+	err := c.cc.Invoke(ctx, Admin_EnableProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ExcludePair(ctx context.Context, in *ExcludePairRequest, opts ...grpc.CallOption) (*ExcludePairResponse, error) {
+	out := new(ExcludePairResponse)
+	err := c.cc.Invoke(ctx, Admin_ExcludePair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) IncludePair(ctx context.Context, in *IncludePairRequest, opts ...grpc.CallOption) (*IncludePairResponse, error) {
+	out := new(IncludePairResponse)
+	err := c.cc.Invoke(ctx, Admin_IncludePair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	// Providers defines a method for listing the providers of the oracle along
+	// with their connection status, the time of their latest price and their
+	// error counts for each currency pair.
+	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
+	// DisableProvider defines a method for ignoring the prices of a provider,
+	// either for a given duration or until it is enabled.
+	DisableProvider(context.Context, *DisableProviderRequest) (*DisableProviderResponse, error)
+	// EnableProvider defines a method for enabling a disabled provider.
+	EnableProvider(context.Context, *EnableProviderRequest) (*EnableProviderResponse, error)
+	// ExcludePair defines a method for ignoring the prices of a single currency
+	// pair on a provider, either for a given duration or until it is included.
+	ExcludePair(context.Context, *ExcludePairRequest) (*ExcludePairResponse, error)
+	// IncludePair defines a method for including an excluded currency pair on a
+	// provider.
+	IncludePair(context.Context, *IncludePairRequest) (*IncludePairResponse, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
+func (UnimplementedAdminServer) DisableProvider(context.Context, *DisableProviderRequest) (*DisableProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableProvider not implemented")
+}
+func (UnimplementedAdminServer) EnableProvider(context.Context, *EnableProviderRequest) (*EnableProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableProvider not implemented")
+}
+func (UnimplementedAdminServer) ExcludePair(context.Context, *ExcludePairRequest) (*ExcludePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExcludePair not implemented")
+}
+func (UnimplementedAdminServer) IncludePair(context.Context, *IncludePairRequest) (*IncludePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncludePair not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_Providers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Providers(ctx, req.(*QueryProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DisableProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DisableProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DisableProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DisableProvider(ctx, req.(*DisableProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_EnableProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).EnableProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_EnableProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).EnableProvider(ctx, req.(*EnableProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ExcludePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExcludePairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ExcludePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ExcludePair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ExcludePair(ctx, req.(*ExcludePairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_IncludePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncludePairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).IncludePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_IncludePair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).IncludePair(ctx, req.(*IncludePairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "slinky.service.v1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Providers",
+			Handler:    _Admin_Providers_Handler,
+		},
+		{
+			MethodName: "DisableProvider",
+			Handler:    _Admin_DisableProvider_Handler,
+		},
+		{
+			MethodName: "EnableProvider",
+			Handler:    _Admin_EnableProvider_Handler,
+		},
+		{
+			MethodName: "ExcludePair",
+			Handler:    _Admin_ExcludePair_Handler,
+		},
+		{
+			MethodName: "IncludePair",
+			Handler:    _Admin_IncludePair_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "slinky/service/v1/admin.proto",
+}
@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Oracle_Prices_FullMethodName = "/slinky.service.v1.Oracle/Prices"
+	Oracle_Prices_FullMethodName       = "/slinky.service.v1.Oracle/Prices"
+	Oracle_StreamPrices_FullMethodName = "/slinky.service.v1.Oracle/StreamPrices"
+	Oracle_PriceDetails_FullMethodName = "/slinky.service.v1.Oracle/PriceDetails"
+	Oracle_PriceHistory_FullMethodName = "/slinky.service.v1.Oracle/PriceHistory"
 )
 
 // OracleClient is the client API for Oracle service.
@@ -28,6 +31,18 @@ const (
 type OracleClient interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A response is
+	// sent every time the oracle updates its prices.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+	// PriceDetails defines a method for fetching the latest prices along with
+	// metadata about how each price was derived i.e. the raw price reported by
+	// each provider.
+	PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error)
+	// PriceHistory defines a method for fetching the prices reported by the
+	// oracle in past updates, along with the provider prices that each price was
+	// derived from. Only the updates retained by the oracle's bounded price
+	// history are returned.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
 }
 
 type oracleClient struct {
@@ -47,12 +62,74 @@ func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts
 	return out, nil
 }
 
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Oracle_ServiceDesc.Streams[0], Oracle_StreamPrices_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oracleClient) PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error) {
+	out := new(QueryPriceDetailsResponse)
+	err := c.cc.Invoke(ctx, Oracle_PriceDetails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Oracle_PriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OracleServer is the server API for Oracle service.
 // All implementations must embed UnimplementedOracleServer
 // for forward compatibility
 type OracleServer interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A response is
+	// sent every time the oracle updates its prices.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
+	// PriceDetails defines a method for fetching the latest prices along with
+	// metadata about how each price was derived i.e. the raw price reported by
+	// each provider.
+	PriceDetails(context.Context, *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error)
+	// PriceHistory defines a method for fetching the prices reported by the
+	// oracle in past updates, along with the provider prices that each price was
+	// derived from. Only the updates retained by the oracle's bounded price
+	// history are returned.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
 	mustEmbedUnimplementedOracleServer()
 }
 
@@ -63,6 +140,15 @@ type UnimplementedOracleServer struct {
 func (UnimplementedOracleServer) Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (UnimplementedOracleServer) StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (UnimplementedOracleServer) PriceDetails(context.Context, *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDetails not implemented")
+}
+func (UnimplementedOracleServer) PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
 func (UnimplementedOracleServer) mustEmbedUnimplementedOracleServer() {}
 
 // UnsafeOracleServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +180,63 @@ func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_PriceDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).PriceDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_PriceDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).PriceDetails(ctx, req.(*QueryPriceDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_PriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Oracle_ServiceDesc is the grpc.ServiceDesc for Oracle service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,7 +248,21 @@ var Oracle_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Oracle_Prices_Handler,
 		},
+		{
+			MethodName: "PriceDetails",
+			Handler:    _Oracle_PriceDetails_Handler,
+		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Oracle_PriceHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/service/v1/oracle.proto",
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"sort"
+	"strings"
 	"syscall"
 	"time"
 
@@ -18,8 +19,13 @@ import (
 )
 
 var (
-	host = flag.String("host", "localhost", "host for the grpc-service to listen on")
-	port = flag.String("port", "8080", "port for the grpc-service to listen on")
+	host          = flag.String("host", "localhost", "host for the grpc-service to listen on")
+	port          = flag.String("port", "8080", "port for the grpc-service to listen on")
+	stream        = flag.Bool("stream", false, "stream prices as they are updated instead of polling")
+	details       = flag.Bool("details", false, "log the provider prices that each aggregated price was derived from and exit")
+	history       = flag.Duration("history", 0, "log the prices reported by the oracle over the given duration i.e. 1m and exit")
+	currencyPairs = flag.String("currency-pairs", "", "comma separated list of currency pairs to stream or fetch details or history for i.e. BITCOIN/USD,ETHEREUM/USD")
+	minInterval   = flag.Duration("min-interval", 0, "minimum amount of time between two streamed price updates")
 )
 
 func main() {
@@ -43,6 +49,21 @@ func main() {
 	// Create a new client
 	client := types.NewOracleClient(conn)
 
+	if *stream {
+		streamPrices(client, sigs)
+		return
+	}
+
+	if *details {
+		priceDetails(client)
+		return
+	}
+
+	if *history > 0 {
+		priceHistory(client)
+		return
+	}
+
 	// Continuous loop
 	for {
 		select {
@@ -57,26 +78,124 @@ func main() {
 				log.Fatalf("could not get prices: %v", err) //nolint
 			}
 
-			prices := resp.GetPrices()
+			logPrices(resp.GetPrices())
 
-			var keys []string
-			for k := range prices {
-				keys = append(keys, k)
-			}
+			// Wait for a bit before making the next request
+			log.Printf("Sleeping for 10 seconds...\n\n")
+			time.Sleep(time.Second * 10) // Adjust the interval as needed
+		}
+	}
+}
 
-			// Sort the prices by the currency pair
-			sort.Slice(keys, func(i, j int) bool {
-				return keys[i] < keys[j]
-			})
+// streamPrices logs the prices streamed from the oracle until an interrupt or terminate signal is received.
+func streamPrices(client types.OracleClient, sigs <-chan os.Signal) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-			// Log the response
-			for _, key := range keys {
-				log.Printf("Currency Pair, Price: (%s, %s)", key, prices[key])
+	go func() {
+		<-sigs
+		log.Printf("Received interrupt or terminate signal, exiting...\n")
+		cancel()
+	}()
+
+	req := &types.StreamPricesRequest{
+		MinInterval: *minInterval,
+	}
+	if len(*currencyPairs) > 0 {
+		req.CurrencyPairs = strings.Split(*currencyPairs, ",")
+	}
+
+	// Call StreamPrices RPC
+	log.Printf("Calling StreamPrices RPC...\n")
+	s, err := client.StreamPrices(ctx, req)
+	if err != nil {
+		log.Fatalf("could not stream prices: %v", err) //nolint
+	}
+
+	for {
+		resp, err := s.Recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("price stream closed: %v", err)
 			}
+			return
+		}
 
-			// Wait for a bit before making the next request
-			log.Printf("Sleeping for 10 seconds...\n\n")
-			time.Sleep(time.Second * 10) // Adjust the interval as needed
+		log.Printf("Received prices at %s\n", resp.GetTimestamp())
+		logPrices(resp.GetPrices())
+	}
+}
+
+// priceDetails logs the aggregated prices along with the provider prices that each price was derived from.
+func priceDetails(client types.OracleClient) {
+	req := &types.QueryPriceDetailsRequest{}
+	if len(*currencyPairs) > 0 {
+		req.CurrencyPairs = strings.Split(*currencyPairs, ",")
+	}
+
+	// Call PriceDetails RPC
+	log.Printf("Calling PriceDetails RPC...\n")
+	resp, err := client.PriceDetails(context.Background(), req)
+	if err != nil {
+		log.Fatalf("could not get price details: %v", err) //nolint
+	}
+
+	var keys []string
+	for k := range resp.GetPrices() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	log.Printf("Received price details at %s\n", resp.GetTimestamp())
+	for _, key := range keys {
+		d := resp.GetPrices()[key]
+		log.Printf(
+			"Currency Pair: %s, Price: %s, Raw Price: %s, Decimals: %d, Providers: %d, Spread: %s (%d bps)",
+			key, d.Price, d.RawPrice, d.Decimals, d.NumProviders, d.Spread, d.SpreadBps,
+		)
+
+		for _, p := range d.ProviderPrices {
+			log.Printf("\tProvider: %s, Price: %s, Timestamp: %s", p.Provider, p.Price, p.Timestamp)
 		}
 	}
 }
+
+// priceHistory logs the prices reported by the oracle in each update within the history duration.
+func priceHistory(client types.OracleClient) {
+	req := &types.QueryPriceHistoryRequest{
+		StartTime: time.Now().UTC().Add(-*history),
+	}
+	if len(*currencyPairs) > 0 {
+		req.CurrencyPairs = strings.Split(*currencyPairs, ",")
+	}
+
+	// Call PriceHistory RPC
+	log.Printf("Calling PriceHistory RPC...\n")
+	resp, err := client.PriceHistory(context.Background(), req)
+	if err != nil {
+		log.Fatalf("could not get price history: %v", err) //nolint
+	}
+
+	for _, entry := range resp.GetEntries() {
+		log.Printf("Prices at %s\n", entry.Timestamp)
+		logPrices(entry.Prices)
+	}
+}
+
+// logPrices logs the given prices sorted by currency pair.
+func logPrices(prices map[string]string) {
+	var keys []string
+	for k := range prices {
+		keys = append(keys, k)
+	}
+
+	// Sort the prices by the currency pair
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	// Log the response
+	for _, key := range keys {
+		log.Printf("Currency Pair, Price: (%s, %s)", key, prices[key])
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/BurntSushi/toml"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/fakeexchange"
+)
+
+var (
+	host                = flag.String("host", "localhost", "host for the fake exchange server to listen on")
+	port                = flag.String("port", "8081", "port for the fake exchange server to listen on")
+	configPath          = flag.String("config-path", "fake_exchange.toml", "path to the fake exchange config file")
+	oracleCfgPath       = flag.String("oracle-config-path", "", "path to an oracle config file to point at the fake exchange server")
+	outputOracleCfgPath = flag.String("output-oracle-config-path", "oracle_fake_exchange.toml", "path to write the oracle config that points at the fake exchange server to")
+)
+
+// start the fake exchange server, cancel on interrupt or terminate.
+func main() {
+	// channel with width for either signal
+	sigs := make(chan os.Signal, 1)
+
+	// gracefully trigger close on interrupt or terminate signals
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	// create context
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// parse flags
+	flag.Parse()
+
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create logger: %s\n", err.Error())
+		return
+	}
+
+	cfg, err := fakeexchange.ReadConfigFromFile(*configPath)
+	if err != nil {
+		logger.Error("failed to read fake exchange config file", zap.Error(err))
+		return
+	}
+
+	// Write a copy of the oracle config in which every provider points at the fake exchange server.
+	if *oracleCfgPath != "" {
+		if err := writeOracleConfig(fmt.Sprintf("http://%s:%s", *host, *port)); err != nil {
+			logger.Error("failed to write oracle config", zap.Error(err))
+			return
+		}
+
+		logger.Info("wrote oracle config", zap.String("path", *outputOracleCfgPath))
+	}
+
+	srv, err := fakeexchange.NewServer(logger, cfg)
+	if err != nil {
+		logger.Error("failed to create fake exchange server", zap.Error(err))
+		return
+	}
+
+	// cancel server on interrupt or terminate
+	go func() {
+		<-sigs
+		logger.Info("received interrupt or terminate signal, closing fake exchange server")
+
+		cancel()
+	}()
+
+	if err := srv.Start(ctx, fmt.Sprintf("%s:%s", *host, *port)); err != nil {
+		logger.Error("stopping fake exchange server", zap.Error(err))
+	}
+}
+
+// writeOracleConfig rewrites the oracle config such that every provider points at the fake exchange
+// server served at the given URL.
+func writeOracleConfig(url string) error {
+	oracleCfg, err := config.ReadOracleConfigFromFile(*oracleCfgPath)
+	if err != nil {
+		return err
+	}
+
+	oracleCfg, err = fakeexchange.RewriteOracleConfig(oracleCfg, url)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(*outputOracleCfgPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return toml.NewEncoder(f).Encode(oracleCfg)
+}
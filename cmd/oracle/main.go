@@ -2,17 +2,22 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle"
 	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
+	"github.com/skip-mev/slinky/oracle/pairsync"
 	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/base/replay"
 	oracleserver "github.com/skip-mev/slinky/service/servers/oracle"
 	promserver "github.com/skip-mev/slinky/service/servers/prometheus"
 	"github.com/skip-mev/slinky/tests/simapp"
@@ -22,6 +27,10 @@ var (
 	host          = flag.String("host", "localhost", "host for the grpc-service to listen on")
 	port          = flag.String("port", "8080", "port for the grpc-service to listen on")
 	oracleCfgPath = flag.String("oracle-config-path", "oracle_config.toml", "path to the oracle config file")
+	watchConfig   = flag.Bool("watch-config", true, "reload the oracle config whenever the config file changes")
+	recordPath    = flag.String("record-path", "", "path of the file to record the raw provider traffic to")
+	replayPath    = flag.String("replay-path", "", "path of a recording to replay instead of connecting to the providers")
+	replaySpeed   = flag.Float64("replay-speed", 1, "factor by which the recording is replayed faster than it was recorded; 0 replays it as fast as possible")
 )
 
 // start the oracle-grpc server + oracle process, cancel on interrupt or terminate.
@@ -60,26 +69,74 @@ func main() {
 		}
 	}
 
+	// Record the provider traffic, or replay a recording instead of connecting to the providers.
+	var factoryOpts []simapp.ProviderFactoryOption
+	if *recordPath != "" {
+		recorder, err := replay.NewRecorder(*recordPath)
+		if err != nil {
+			logger.Error("failed to create recorder", zap.Error(err))
+			return
+		}
+		defer recorder.Close()
+
+		factoryOpts = append(factoryOpts, simapp.WithRecorder(recorder))
+	}
+
+	if *replayPath != "" {
+		player, err := replay.NewPlayerFromFile(*replayPath, *replaySpeed)
+		if err != nil {
+			logger.Error("failed to load recording", zap.Error(err))
+			return
+		}
+
+		factoryOpts = append(factoryOpts, simapp.WithPlayer(player))
+	}
+
 	// This can be replaced with a custom provider factory. See the simapp package for an example.
-	providers, err := simapp.DefaultProviderFactory()(logger, cfg)
+	providerFactory := simapp.DefaultProviderFactory(factoryOpts...)
+	providers, err := providerFactory(logger, cfg)
 	if err != nil {
 		logger.Error("failed to create providers using the factory", zap.Error(err))
 		return
 	}
 
+	// Create the oracle metrics. These are shared by the oracle and the aggregator.
+	oracleMetrics := oraclemetrics.NewMetricsFromConfig(cfg.Metrics)
+
 	// Create the conversion market aggregator.
-	aggregator, err := oraclemath.NewMedianAggregator(logger, cfg.Market)
+	aggregator, err := oraclemath.NewMedianAggregator(logger, cfg.Market, oracleMetrics)
 	if err != nil {
 		logger.Error("failed to create median aggregator", zap.Error(err))
 		return
 	}
 
+	// Create the price smoother. Only the currency pairs with smoothing configured are smoothed.
+	smoother, err := oraclemath.NewPriceSmoother(logger, cfg.Market, time.Now)
+	if err != nil {
+		logger.Error("failed to create price smoother", zap.Error(err))
+		return
+	}
+
+	// Create the circuit breaker. Only the currency pairs with a circuit breaker configured are held.
+	circuitBreaker, err := oraclemath.NewCircuitBreaker(logger, cfg.Market, oracleMetrics)
+	if err != nil {
+		logger.Error("failed to create circuit breaker", zap.Error(err))
+		return
+	}
+
 	// Create the oracle.
 	oracle, err := oracle.New(
-		oracle.WithUpdateInterval(cfg.UpdateInterval),
+		oracle.WithConfig(cfg),
 		oracle.WithProviders(providers),                        // Replace with custom providers.
+		oracle.WithProviderFactory(providerFactory),            // Replace with custom provider factory.
 		oracle.WithAggregateFunction(aggregator.AggregateFn()), // Replace with custom aggregation function.
-		oracle.WithMetricsConfig(cfg.Metrics),
+		oracle.WithMarketConfigUpdater(aggregator),
+		oracle.WithPriceWithholder(aggregator),
+		oracle.WithConversionPathResolver(aggregator),
+		oracle.WithVolumeWeighter(aggregator),
+		oracle.WithPriceSmoother(smoother),
+		oracle.WithCircuitBreaker(circuitBreaker),
+		oracle.WithMetrics(oracleMetrics),
 		oracle.WithLogger(logger),
 	)
 	if err != nil {
@@ -88,7 +145,13 @@ func main() {
 	}
 
 	// create server
-	srv := oracleserver.NewOracleServer(oracle, logger)
+	srv := oracleserver.NewOracleServer(
+		oracle,
+		logger,
+		oracleserver.WithHealthConfig(cfg.Health),
+		oracleserver.WithAdminConfig(cfg.Admin),
+		oracleserver.WithTLSConfig(cfg.TLS),
+	)
 
 	// cancel oracle on interrupt or terminate
 	go func() {
@@ -100,6 +163,25 @@ func main() {
 		cancel()
 	}()
 
+	// reload the oracle config on SIGHUP, and optionally whenever the config file changes
+	go reloadOracleConfig(ctx, logger, oracle, *oracleCfgPath, *watchConfig)
+
+	// sync the oracle's currency pairs with the currency pairs tracked on chain, and check their
+	// decimals against the decimals on chain whenever a node is configured
+	if len(cfg.CurrencyPairSync.Address) > 0 {
+		syncer, err := pairsync.NewSyncerFromConfig(logger, cfg.CurrencyPairSync, oracle)
+		if err != nil {
+			logger.Error("failed to create currency pair syncer", zap.Error(err))
+			return
+		}
+
+		go func() {
+			if err := syncer.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				logger.Error("currency pair syncer exited", zap.Error(err))
+			}
+		}()
+	}
+
 	// start prometheus metrics
 	if cfg.Metrics.Enabled {
 		logger.Info("starting prometheus metrics", zap.String("address", cfg.Metrics.PrometheusServerAddress))
@@ -124,3 +206,42 @@ func main() {
 		logger.Error("stopping server", zap.Error(err))
 	}
 }
+
+// reloadOracleConfig re-reads the oracle config whenever a SIGHUP is received, and if watch is set,
+// whenever the config file changes. The new config is applied to the running oracle.
+func reloadOracleConfig(ctx context.Context, logger *zap.Logger, o *oracle.OracleImpl, path string, watch bool) {
+	hups := make(chan os.Signal, 1)
+	signal.Notify(hups, syscall.SIGHUP)
+	defer signal.Stop(hups)
+
+	apply := func(cfg config.OracleConfig, err error) {
+		if err != nil {
+			logger.Error("failed to read oracle config; keeping current config", zap.Error(err))
+			return
+		}
+
+		logger.Info("reloading oracle config", zap.String("path", path))
+		if err := o.UpdateConfig(cfg); err != nil {
+			logger.Error("failed to reload oracle config; keeping current config", zap.Error(err))
+		}
+	}
+
+	if watch {
+		go func() {
+			logger.Info("watching oracle config file", zap.String("path", path))
+			if err := config.WatchOracleConfigFile(ctx, path, apply); err != nil && !errors.Is(err, context.Canceled) {
+				logger.Error("stopped watching oracle config file", zap.Error(err))
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hups:
+			logger.Info("received SIGHUP")
+			apply(config.ReadOracleConfigFromFile(path))
+		}
+	}
+}
@@ -54,6 +54,52 @@ var (
 			Enabled:                 true,
 			PrometheusServerAddress: "0.0.0.0:8002",
 		},
+		// -----------------------------------------------------------	//
+		// -----------------Currency Pair Sync Config-----------------	//
+		// -----------------------------------------------------------	//
+		CurrencyPairSync: config.CurrencyPairSyncConfig{
+			Enabled:  false,
+			Address:  "localhost:9090",
+			Interval: time.Minute,
+			Timeout:  5 * time.Second,
+		},
+		// -----------------------------------------------------------	//
+		// ----------------------Snapshot Config----------------------	//
+		// -----------------------------------------------------------	//
+		Snapshot: config.SnapshotConfig{
+			Enabled:  false,
+			Path:     "oracle_snapshot.json",
+			Interval: 10 * time.Second,
+		},
+		// -----------------------------------------------------------	//
+		// --------------------Price History Config-------------------	//
+		// -----------------------------------------------------------	//
+		PriceHistory: config.PriceHistoryConfig{
+			Enabled:    true,
+			MaxEntries: 400,
+			MaxAge:     10 * time.Minute,
+		},
+		// -----------------------------------------------------------	//
+		// -----------------------Health Config-----------------------	//
+		// -----------------------------------------------------------	//
+		Health: config.HealthConfig{
+			MinFreshPairRatio:   0.8,
+			MinHealthyProviders: 3,
+			MaxSyncAge:          15 * time.Second,
+		},
+		// -----------------------------------------------------------	//
+		// -----------------------Admin Config------------------------	//
+		// -----------------------------------------------------------	//
+		Admin: config.AdminConfig{
+			Enabled: false,
+			Token:   config.SecretConfig{Env: "SLINKY_ADMIN_TOKEN"},
+		},
+		// -----------------------------------------------------------	//
+		// ------------------------TLS Config-------------------------	//
+		// -----------------------------------------------------------	//
+		TLS: config.TLSConfig{
+			Enabled: false,
+		},
 		UpdateInterval: 1500 * time.Millisecond,
 		Providers: []config.ProviderConfig{
 			// -----------------------------------------------------------	//
@@ -0,0 +1,299 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// ProviderStatus is the status of a provider along with any overrides applied by an operator.
+type ProviderStatus struct {
+	// Name is the name of the provider.
+	Name string
+
+	// Type is the type of the provider data handler.
+	Type providertypes.ProviderType
+
+	// Connection is the status of the connection to the provider's data source. This is unknown
+	// if the provider does not report its status.
+	Connection providertypes.ConnectionStatus
+
+	// Disabled is true if the prices of the provider are ignored by the oracle. DisabledUntil
+	// is the time at which the provider is enabled again, and is zero if the provider is
+	// disabled until it is explicitly enabled.
+	Disabled      bool
+	DisabledUntil time.Time
+
+	// Errors is the number of responses that failed to resolve a currency pair.
+	Errors uint64
+
+	// Pairs is the status of each currency pair that the provider fetches prices for.
+	Pairs map[oracletypes.CurrencyPair]PairStatus
+}
+
+// PairStatus is the status of a single currency pair on a provider.
+type PairStatus struct {
+	// LastUpdate is the timestamp of the latest price reported by the provider. This is zero if
+	// the provider has not reported a price.
+	LastUpdate time.Time
+
+	// Errors is the number of responses that failed to resolve the currency pair, and LastError
+	// is the error of the latest failed response.
+	Errors    uint64
+	LastError string
+
+	// Excluded is true if the prices of the currency pair on the provider are ignored by the
+	// oracle. ExcludedUntil is the time at which the currency pair is included again, and is
+	// zero if it is excluded until it is explicitly included.
+	Excluded      bool
+	ExcludedUntil time.Time
+}
+
+// override is an operator override that expires at the given time. A zero expiry never expires.
+type override struct {
+	until time.Time
+}
+
+// active returns true if the override has not expired.
+func (ov override) active(now time.Time) bool {
+	return ov.until.IsZero() || now.Before(ov.until)
+}
+
+// newOverride returns an override that expires after the given duration. A zero duration never
+// expires.
+func newOverride(d time.Duration) (override, error) {
+	switch {
+	case d < 0:
+		return override{}, fmt.Errorf("duration cannot be negative")
+	case d == 0:
+		return override{}, nil
+	default:
+		return override{until: time.Now().UTC().Add(d)}, nil
+	}
+}
+
+// GetProviderStatuses returns the status of each provider that the oracle fetches prices from,
+// sorted by name. The connection status, the time of the latest price and the error counts are
+// only reported for providers that implement the StatusReporter interface i.e. the base provider.
+func (o *OracleImpl) GetProviderStatuses() []ProviderStatus {
+	now := time.Now().UTC()
+
+	providers := o.getProviders()
+	statuses := make([]ProviderStatus, 0, len(providers))
+	for _, p := range providers {
+		status := ProviderStatus{
+			Name:       p.Name(),
+			Type:       p.Type(),
+			Connection: providertypes.UnknownConnectionStatus,
+			Pairs:      make(map[oracletypes.CurrencyPair]PairStatus),
+		}
+
+		if reporter, ok := p.(providertypes.StatusReporter[oracletypes.CurrencyPair]); ok {
+			providerStatus := reporter.GetStatus()
+
+			status.Connection = providerStatus.Connection
+			for cp, idStatus := range providerStatus.IDs {
+				status.Errors += idStatus.Errors
+				status.Pairs[cp] = PairStatus{
+					LastUpdate: idStatus.LastUpdate,
+					Errors:     idStatus.Errors,
+					LastError:  idStatus.LastError,
+				}
+			}
+		} else {
+			for _, cp := range p.GetIDs() {
+				status.Pairs[cp] = PairStatus{}
+			}
+
+			for cp, result := range p.GetData() {
+				if _, ok := status.Pairs[cp]; ok {
+					status.Pairs[cp] = PairStatus{LastUpdate: result.Timestamp}
+				}
+			}
+		}
+
+		o.adminMtx.Lock()
+		if ov, ok := o.disabledProviders[p.Name()]; ok && ov.active(now) {
+			status.Disabled = true
+			status.DisabledUntil = ov.until
+		}
+
+		for cp, ov := range o.excludedPairs[p.Name()] {
+			pairStatus, ok := status.Pairs[cp]
+			if !ok || !ov.active(now) {
+				continue
+			}
+
+			pairStatus.Excluded = true
+			pairStatus.ExcludedUntil = ov.until
+			status.Pairs[cp] = pairStatus
+		}
+		o.adminMtx.Unlock()
+
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+// DisableProvider disables the provider with the given name for the given duration, or until it
+// is enabled if the duration is zero. The prices of a disabled provider are ignored from the next
+// oracle update onwards, while the provider keeps running so that it can be enabled without
+// reconnecting.
+func (o *OracleImpl) DisableProvider(name string, d time.Duration) error {
+	if _, err := o.getProvider(name); err != nil {
+		return err
+	}
+
+	ov, err := newOverride(d)
+	if err != nil {
+		return err
+	}
+
+	o.adminMtx.Lock()
+	defer o.adminMtx.Unlock()
+
+	o.disabledProviders[name] = ov
+	o.logger.Info("disabled provider", zap.String("provider", name), zap.Duration("duration", d))
+
+	return nil
+}
+
+// EnableProvider enables the provider with the given name. This is a no-op if the provider is
+// not disabled.
+func (o *OracleImpl) EnableProvider(name string) error {
+	if _, err := o.getProvider(name); err != nil {
+		return err
+	}
+
+	o.adminMtx.Lock()
+	defer o.adminMtx.Unlock()
+
+	delete(o.disabledProviders, name)
+	o.logger.Info("enabled provider", zap.String("provider", name))
+
+	return nil
+}
+
+// ExcludePair excludes the given currency pair on the provider with the given name for the given
+// duration, or until it is included if the duration is zero. The prices of an excluded currency
+// pair are ignored from the next oracle update onwards.
+func (o *OracleImpl) ExcludePair(name string, cp oracletypes.CurrencyPair, d time.Duration) error {
+	p, err := o.getProvider(name)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(p.GetIDs(), cp) {
+		return fmt.Errorf("provider %s does not fetch prices for %s", name, cp)
+	}
+
+	ov, err := newOverride(d)
+	if err != nil {
+		return err
+	}
+
+	o.adminMtx.Lock()
+	defer o.adminMtx.Unlock()
+
+	if _, ok := o.excludedPairs[name]; !ok {
+		o.excludedPairs[name] = make(map[oracletypes.CurrencyPair]override)
+	}
+
+	o.excludedPairs[name][cp] = ov
+	o.logger.Info(
+		"excluded currency pair",
+		zap.String("provider", name),
+		zap.String("pair", cp.String()),
+		zap.Duration("duration", d),
+	)
+
+	return nil
+}
+
+// IncludePair includes the given currency pair on the provider with the given name. This is a
+// no-op if the currency pair is not excluded.
+func (o *OracleImpl) IncludePair(name string, cp oracletypes.CurrencyPair) error {
+	if _, err := o.getProvider(name); err != nil {
+		return err
+	}
+
+	o.adminMtx.Lock()
+	defer o.adminMtx.Unlock()
+
+	delete(o.excludedPairs[name], cp)
+	if len(o.excludedPairs[name]) == 0 {
+		delete(o.excludedPairs, name)
+	}
+
+	o.logger.Info("included currency pair", zap.String("provider", name), zap.String("pair", cp.String()))
+
+	return nil
+}
+
+// isProviderDisabled returns true if the provider with the given name is disabled. Expired
+// overrides are removed.
+func (o *OracleImpl) isProviderDisabled(name string) bool {
+	o.adminMtx.Lock()
+	defer o.adminMtx.Unlock()
+
+	ov, ok := o.disabledProviders[name]
+	if !ok {
+		return false
+	}
+
+	if !ov.active(time.Now().UTC()) {
+		delete(o.disabledProviders, name)
+		o.logger.Info("provider re-enabled after expiry", zap.String("provider", name))
+		return false
+	}
+
+	return true
+}
+
+// getExcludedPairs returns the set of currency pairs that are excluded on the provider with the
+// given name. Expired overrides are removed.
+func (o *OracleImpl) getExcludedPairs(name string) map[oracletypes.CurrencyPair]struct{} {
+	o.adminMtx.Lock()
+	defer o.adminMtx.Unlock()
+
+	now := time.Now().UTC()
+	excluded := make(map[oracletypes.CurrencyPair]struct{})
+	for cp, ov := range o.excludedPairs[name] {
+		if !ov.active(now) {
+			delete(o.excludedPairs[name], cp)
+			o.logger.Info("currency pair re-included after expiry", zap.String("provider", name), zap.String("pair", cp.String()))
+			continue
+		}
+
+		excluded[cp] = struct{}{}
+	}
+
+	if len(o.excludedPairs[name]) == 0 {
+		delete(o.excludedPairs, name)
+	}
+
+	return excluded
+}
+
+// getProvider returns the provider with the given name.
+func (o *OracleImpl) getProvider(name string) (providertypes.Provider[oracletypes.CurrencyPair, *big.Int], error) {
+	for _, p := range o.getProviders() {
+		if p.Name() == name {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown provider %s", name)
+}
@@ -0,0 +1,187 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/slinky/oracle"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	providermocks "github.com/skip-mev/slinky/providers/types/mocks"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func (s *OracleTestSuite) TestAdminOverrides() {
+	btc := s.currencyPairs[0]
+	eth := s.currencyPairs[1]
+
+	fresh := providertypes.Result[*big.Int]{
+		Value:     big.NewInt(100),
+		Timestamp: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	newProvider := func(name string) providertypes.Provider[oracletypes.CurrencyPair, *big.Int] {
+		provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+		provider.On("Name").Return(name).Maybe()
+		provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+		provider.On("Type").Return(providertypes.API).Maybe()
+		provider.On("GetIDs").Return([]oracletypes.CurrencyPair{btc, eth}).Maybe()
+		provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+			btc: fresh,
+			eth: fresh,
+		}).Maybe()
+
+		return provider
+	}
+
+	o, err := oracle.New(
+		oracle.WithUpdateInterval(50*time.Millisecond),
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{
+			newProvider("provider1"),
+			newProvider("provider2"),
+		}),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go o.Start(ctx)
+
+	// waitFor waits until the provider prices used in the latest oracle update match the expected
+	// set of currency pairs for each provider.
+	waitFor := func(expected map[string][]oracletypes.CurrencyPair) {
+		s.Require().Eventually(func() bool {
+			providerPrices := o.GetProviderPrices()
+			if len(providerPrices) != len(expected) {
+				return false
+			}
+
+			for provider, cps := range expected {
+				if len(providerPrices[provider]) != len(cps) {
+					return false
+				}
+
+				for _, cp := range cps {
+					if _, ok := providerPrices[provider][cp]; !ok {
+						return false
+					}
+				}
+			}
+
+			return true
+		}, 2*time.Second, 10*time.Millisecond)
+	}
+
+	waitFor(map[string][]oracletypes.CurrencyPair{
+		"provider1": {btc, eth},
+		"provider2": {btc, eth},
+	})
+
+	s.Run("disabled provider is skipped", func() {
+		s.Require().NoError(o.DisableProvider("provider1", 0))
+		waitFor(map[string][]oracletypes.CurrencyPair{
+			"provider2": {btc, eth},
+		})
+
+		s.Require().NoError(o.EnableProvider("provider1"))
+		waitFor(map[string][]oracletypes.CurrencyPair{
+			"provider1": {btc, eth},
+			"provider2": {btc, eth},
+		})
+	})
+
+	s.Run("excluded pair is skipped", func() {
+		s.Require().NoError(o.ExcludePair("provider2", eth, 0))
+		waitFor(map[string][]oracletypes.CurrencyPair{
+			"provider1": {btc, eth},
+			"provider2": {btc},
+		})
+
+		s.Require().NoError(o.IncludePair("provider2", eth))
+		waitFor(map[string][]oracletypes.CurrencyPair{
+			"provider1": {btc, eth},
+			"provider2": {btc, eth},
+		})
+	})
+
+	s.Run("overrides expire", func() {
+		s.Require().NoError(o.DisableProvider("provider1", 300*time.Millisecond))
+		s.Require().NoError(o.ExcludePair("provider2", btc, 300*time.Millisecond))
+		waitFor(map[string][]oracletypes.CurrencyPair{
+			"provider2": {eth},
+		})
+
+		waitFor(map[string][]oracletypes.CurrencyPair{
+			"provider1": {btc, eth},
+			"provider2": {btc, eth},
+		})
+	})
+
+	s.Run("invalid overrides", func() {
+		s.Require().Error(o.DisableProvider("unknown", 0))
+		s.Require().Error(o.DisableProvider("provider1", -time.Second))
+		s.Require().Error(o.EnableProvider("unknown"))
+		s.Require().Error(o.ExcludePair("unknown", btc, 0))
+		s.Require().Error(o.ExcludePair("provider1", s.currencyPairs[2], 0))
+		s.Require().Error(o.ExcludePair("provider1", btc, -time.Second))
+		s.Require().Error(o.IncludePair("unknown", btc))
+	})
+
+	o.Stop()
+}
+
+func (s *OracleTestSuite) TestGetProviderStatuses() {
+	btc := s.currencyPairs[0]
+	eth := s.currencyPairs[1]
+
+	timestamp := time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider2").Maybe()
+	provider.On("Type").Return(providertypes.WebSockets).Maybe()
+	provider.On("GetIDs").Return([]oracletypes.CurrencyPair{btc, eth}).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		btc: {
+			Value:     big.NewInt(100),
+			Timestamp: timestamp,
+		},
+	}).Maybe()
+
+	noStatusProvider := s.noStartProvider("provider1")
+	noStatusProvider.(*providermocks.Provider[oracletypes.CurrencyPair, *big.Int]).On("GetIDs").Return(nil).Maybe()
+
+	o, err := oracle.New(
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{
+			provider,
+			noStatusProvider,
+		}),
+	)
+	s.Require().NoError(err)
+
+	s.Require().NoError(o.DisableProvider("provider1", 0))
+	s.Require().NoError(o.ExcludePair("provider2", eth, time.Hour))
+
+	statuses := o.GetProviderStatuses()
+	s.Require().Len(statuses, 2)
+
+	// Providers that do not report their status only report the time of their latest prices.
+	s.Require().Equal("provider1", statuses[0].Name)
+	s.Require().Equal(providertypes.API, statuses[0].Type)
+	s.Require().Equal(providertypes.UnknownConnectionStatus, statuses[0].Connection)
+	s.Require().True(statuses[0].Disabled)
+	s.Require().True(statuses[0].DisabledUntil.IsZero())
+
+	s.Require().Equal("provider2", statuses[1].Name)
+	s.Require().Equal(providertypes.WebSockets, statuses[1].Type)
+	s.Require().False(statuses[1].Disabled)
+	s.Require().Equal(timestamp, statuses[1].Pairs[btc].LastUpdate)
+	s.Require().False(statuses[1].Pairs[btc].Excluded)
+	s.Require().True(statuses[1].Pairs[eth].LastUpdate.IsZero())
+	s.Require().True(statuses[1].Pairs[eth].Excluded)
+	s.Require().WithinDuration(time.Now().Add(time.Hour), statuses[1].Pairs[eth].ExcludedUntil, time.Minute)
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+)
+
+// MinAdminTokenLength is the minimum length of the token that authenticates admin requests.
+const MinAdminTokenLength = 16
+
+// AdminConfig is the config for the admin service of the oracle server. The admin service lists
+// the status of each provider and allows an operator to disable a provider, or to exclude a
+// currency pair on a provider, while the oracle is running. Every admin request must present the
+// token as a bearer token in the authorization metadata. Since the token is sent with every
+// request, the admin service can only be enabled if TLS is enabled on the oracle server.
+type AdminConfig struct {
+	// Enabled is a flag that indicates whether the admin service is served.
+	Enabled bool `mapstructure:"enabled" toml:"enabled"`
+
+	// Token references the secret that authenticates admin requests. The token is read from an
+	// environment variable or a file rather than from the config.
+	Token SecretConfig `mapstructure:"token" toml:"token"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *AdminConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if err := c.Token.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid admin token: %w", err)
+	}
+
+	return nil
+}
+
+// ReadToken reads the token that authenticates admin requests. This returns an error if the token
+// cannot be read or is shorter than MinAdminTokenLength.
+func (c *AdminConfig) ReadToken() (Secret, error) {
+	token, err := c.Token.Read()
+	if err != nil {
+		return "", fmt.Errorf("failed to read admin token: %w", err)
+	}
+
+	if len(token.Value()) < MinAdminTokenLength {
+		return "", fmt.Errorf("admin token must be at least %d characters", MinAdminTokenLength)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,102 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestAdminConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.AdminConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config with the token in an environment variable",
+			config: config.AdminConfig{
+				Enabled: true,
+				Token:   config.SecretConfig{Env: "ADMIN_TOKEN"},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config with the token in a file",
+			config: config.AdminConfig{
+				Enabled: true,
+				Token:   config.SecretConfig{File: "/etc/slinky/admin_token"},
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "disabled config",
+			config:      config.AdminConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with no token",
+			config: config.AdminConfig{
+				Enabled: true,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with the token in both an environment variable and a file",
+			config: config.AdminConfig{
+				Enabled: true,
+				Token:   config.SecretConfig{Env: "ADMIN_TOKEN", File: "/etc/slinky/admin_token"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAdminConfigReadToken(t *testing.T) {
+	t.Run("reads the token from an environment variable", func(t *testing.T) {
+		t.Setenv("SLINKY_TEST_ADMIN_TOKEN", "0123456789abcdef")
+
+		cfg := config.AdminConfig{Enabled: true, Token: config.SecretConfig{Env: "SLINKY_TEST_ADMIN_TOKEN"}}
+		token, err := cfg.ReadToken()
+		require.NoError(t, err)
+		require.Equal(t, "0123456789abcdef", token.Value())
+	})
+
+	t.Run("reads the token from a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "admin_token")
+		require.NoError(t, os.WriteFile(path, []byte("0123456789abcdef\n"), 0o600))
+
+		cfg := config.AdminConfig{Enabled: true, Token: config.SecretConfig{File: path}}
+		token, err := cfg.ReadToken()
+		require.NoError(t, err)
+		require.Equal(t, "0123456789abcdef", token.Value())
+	})
+
+	t.Run("short token", func(t *testing.T) {
+		t.Setenv("SLINKY_TEST_ADMIN_TOKEN", "token")
+
+		cfg := config.AdminConfig{Enabled: true, Token: config.SecretConfig{Env: "SLINKY_TEST_ADMIN_TOKEN"}}
+		_, err := cfg.ReadToken()
+		require.Error(t, err)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		cfg := config.AdminConfig{Enabled: true, Token: config.SecretConfig{Env: "SLINKY_TEST_MISSING_ADMIN_TOKEN"}}
+		_, err := cfg.ReadToken()
+		require.Error(t, err)
+	})
+}
@@ -40,6 +40,21 @@ type AggregateMarketConfig struct {
 	// provided in a topologically sorted order that resolve to the same currency pair
 	// defined in the CurrencyPair field.
 	AggregatedFeeds map[string]AggregateFeedConfig `mapstructure:"aggregated_feeds" toml:"aggregated_feeds"`
+
+	// OutlierFilter is the default outlier filter that is applied to the provider prices of
+	// each feed before the median price is calculated. This can be overridden per feed.
+	OutlierFilter OutlierFilterConfig `mapstructure:"outlier_filter" toml:"outlier_filter"`
+
+	// MinProviders is the default minimum number of distinct providers that must report a
+	// price for each feed in a conversion path for the path to be used. If no conversion path
+	// for a currency pair meets the quorum, the price of the currency pair is withheld. This
+	// can be overridden per aggregated feed. If zero, no quorum is required.
+	MinProviders uint64 `mapstructure:"min_providers" toml:"min_providers"`
+
+	// CircuitBreaker is the default circuit breaker that holds the reported price of each
+	// currency pair when its aggregated price moves too far between ticks. This can be
+	// overridden per aggregated feed.
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker" toml:"circuit_breaker"`
 }
 
 // FeedConfig represents the configurations for a given price feed. Each currency pair
@@ -47,6 +62,19 @@ type AggregateMarketConfig struct {
 type FeedConfig struct {
 	// CurrencyPair is the currency pair that the oracle will fetch prices for.
 	CurrencyPair oracletypes.CurrencyPair `mapstructure:"currency_pair" toml:"currency_pair"`
+
+	// OutlierFilter is the outlier filter that is applied to the provider prices of the feed.
+	// If unset, the market's outlier filter is used.
+	OutlierFilter OutlierFilterConfig `mapstructure:"outlier_filter" toml:"outlier_filter,omitempty"`
+
+	// Decimals is the number of decimals that the price of the feed is reported with. If zero,
+	// the legacy decimals of the currency pair are used (18 if the quote is ETHEREUM, 8 otherwise).
+	Decimals uint64 `mapstructure:"decimals" toml:"decimals,omitzero"`
+
+	// VolumeWeighted weights the provider prices of the feed by the 24 hour volume reported
+	// alongside them when calculating the median price of the feed. A provider that does not
+	// report a volume for the feed carries the median of the reported volumes.
+	VolumeWeighted bool `mapstructure:"volume_weighted" toml:"volume_weighted,omitempty"`
 }
 
 // AggregateFeedConfig represents all of the conversion markets that can be used to convert the
@@ -56,8 +84,33 @@ type AggregateFeedConfig struct {
 	CurrencyPair oracletypes.CurrencyPair `mapstructure:"currency_pair" toml:"currency_pair"`
 
 	// Conversions is a list of conversion operations that will be used to convert the price
-	// of the currency pair to the common currency pair.
+	// of the currency pair to the common currency pair. This must be empty if path discovery
+	// is enabled.
 	Conversions []Conversions `mapstructure:"conversions" toml:"conversions"`
+
+	// PathDiscovery configures the automatic discovery of the conversion paths from the feeds.
+	// If set and enabled, the discovered conversion paths are used instead of the Conversions.
+	PathDiscovery *PathDiscoveryConfig `mapstructure:"path_discovery" toml:"path_discovery,omitempty"`
+
+	// MinProviders is the minimum number of distinct providers that must report a price for
+	// each feed in a conversion path for the path to be used. If zero, the market's minimum
+	// number of providers is used.
+	MinProviders uint64 `mapstructure:"min_providers" toml:"min_providers,omitzero"`
+
+	// Smoothing is the smoothing that is applied to the aggregated price of the currency pair
+	// before it is reported. If unset, the latest aggregated price is reported.
+	Smoothing SmoothingConfig `mapstructure:"smoothing" toml:"smoothing,omitempty"`
+
+	// CircuitBreaker is the circuit breaker that holds the reported price of the currency pair
+	// when its aggregated price moves too far between ticks. If unset, the market's circuit
+	// breaker is used.
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker" toml:"circuit_breaker,omitempty"`
+
+	// Decimals is the number of decimals that the aggregated price of the currency pair is
+	// reported with. This must match the decimals of the currency pair in the x/oracle module.
+	// If zero, the decimals of the feed with the same currency pair are used, if any, and the
+	// legacy decimals of the currency pair otherwise.
+	Decimals uint64 `mapstructure:"decimals" toml:"decimals,omitzero"`
 }
 
 // Conversions is a type alias for a list of conversion operations.
@@ -87,8 +140,77 @@ func (c *AggregateMarketConfig) GetCurrencyPairs() []oracletypes.CurrencyPair {
 	return currencyPairs
 }
 
+// GetOutlierFilter returns the outlier filter for the given feed. The feed's outlier filter
+// takes precedence over the market's.
+func (c *AggregateMarketConfig) GetOutlierFilter(cp oracletypes.CurrencyPair) OutlierFilterConfig {
+	if feed, ok := c.Feeds[cp.String()]; ok && feed.OutlierFilter.Type != "" {
+		return feed.OutlierFilter
+	}
+
+	return c.OutlierFilter
+}
+
+// IsVolumeWeighted returns true if the provider prices of the given feed are weighted by their
+// reported volume.
+func (c *AggregateMarketConfig) IsVolumeWeighted(cp oracletypes.CurrencyPair) bool {
+	feed, ok := c.Feeds[cp.String()]
+	return ok && feed.VolumeWeighted
+}
+
+// GetMinProviders returns the minimum number of providers required for each feed in a conversion
+// path of the given currency pair. The aggregated feed's minimum takes precedence over the market's.
+func (c *AggregateMarketConfig) GetMinProviders(cp oracletypes.CurrencyPair) uint64 {
+	if feed, ok := c.AggregatedFeeds[cp.String()]; ok && feed.MinProviders > 0 {
+		return feed.MinProviders
+	}
+
+	return c.MinProviders
+}
+
+// GetSmoothing returns the smoothing config for the given currency pair.
+func (c *AggregateMarketConfig) GetSmoothing(cp oracletypes.CurrencyPair) SmoothingConfig {
+	if feed, ok := c.AggregatedFeeds[cp.String()]; ok {
+		return feed.Smoothing
+	}
+
+	return SmoothingConfig{}
+}
+
+// GetCircuitBreaker returns the circuit breaker config for the given currency pair. The aggregated
+// feed's circuit breaker takes precedence over the market's.
+func (c *AggregateMarketConfig) GetCircuitBreaker(cp oracletypes.CurrencyPair) CircuitBreakerConfig {
+	if feed, ok := c.AggregatedFeeds[cp.String()]; ok && feed.CircuitBreaker.IsEnabled() {
+		return feed.CircuitBreaker
+	}
+
+	return c.CircuitBreaker
+}
+
+// GetDecimals returns the number of decimals that the price of the given currency pair is reported
+// with. The aggregated feed's decimals take precedence over the feed's. If neither sets the decimals,
+// the legacy decimals of the currency pair are returned.
+func (c *AggregateMarketConfig) GetDecimals(cp oracletypes.CurrencyPair) uint64 {
+	if feed, ok := c.AggregatedFeeds[cp.String()]; ok && feed.Decimals > 0 {
+		return feed.Decimals
+	}
+
+	if feed, ok := c.Feeds[cp.String()]; ok && feed.Decimals > 0 {
+		return feed.Decimals
+	}
+
+	return cp.LegacyDecimals()
+}
+
 // ValidateBasic performs basic validation on the AggregateMarketConfig.
 func (c *AggregateMarketConfig) ValidateBasic() error {
+	if err := c.OutlierFilter.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid outlier filter: %w", err)
+	}
+
+	if err := c.CircuitBreaker.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid circuit breaker: %w", err)
+	}
+
 	// Verify the configurations of all price feeds.
 	for cpString, feedConfig := range c.Feeds {
 		cp, err := oracletypes.CurrencyPairFromString(cpString)
@@ -123,10 +245,49 @@ func (c *AggregateMarketConfig) ValidateBasic() error {
 			return fmt.Errorf("currency pair %s does not match the currency pair in the config", cpString)
 		}
 
-		if len(conversions.Conversions) == 0 {
+		if err := conversions.PathDiscovery.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid path discovery for %s: %w", cp, err)
+		}
+
+		switch {
+		case conversions.PathDiscovery.IsEnabled() && len(conversions.Conversions) > 0:
+			return fmt.Errorf("conversions cannot be provided for %s when path discovery is enabled", cp)
+		case conversions.PathDiscovery.IsEnabled():
+			if len(c.DiscoverConversions(cp, *conversions.PathDiscovery)) == 0 {
+				return fmt.Errorf("no conversion paths discovered for %s", cp)
+			}
+		case len(conversions.Conversions) == 0:
 			return fmt.Errorf("no operations provided for %s", cp)
 		}
 
+		if err := conversions.Smoothing.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid smoothing for %s: %w", cp, err)
+		}
+
+		if err := conversions.CircuitBreaker.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid circuit breaker for %s: %w", cp, err)
+		}
+
+		// Providers can only confirm a move of a price that they report directly, so the price of
+		// a currency pair that is only derived from conversions could never be confirmed by them.
+		if breaker := c.GetCircuitBreaker(cp); breaker.IsEnabled() && breaker.ConfirmationProviders > 0 {
+			if _, ok := c.Feeds[cp.String()]; !ok {
+				return fmt.Errorf("circuit breaker for %s cannot require confirmation providers since no feed reports its price", cp)
+			}
+		}
+
+		// The aggregated price must be reported with the same decimals as the feed with the same
+		// currency pair, if any.
+		if conversions.Decimals != 0 {
+			if err := oracletypes.ValidateDecimals(conversions.Decimals); err != nil {
+				return fmt.Errorf("invalid decimals for %s: %w", cp, err)
+			}
+
+			if feed, ok := c.Feeds[cp.String()]; ok && feed.Decimals != 0 && feed.Decimals != conversions.Decimals {
+				return fmt.Errorf("decimals for %s do not match the decimals of the feed", cp)
+			}
+		}
+
 		for _, feeds := range conversions.Conversions {
 			for _, conversion := range feeds {
 				if _, ok := c.Feeds[conversion.CurrencyPair.String()]; !ok {
@@ -149,6 +310,16 @@ func (c *FeedConfig) ValidateBasic() error {
 		return err
 	}
 
+	if err := c.OutlierFilter.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid outlier filter for %s: %w", c.CurrencyPair, err)
+	}
+
+	if c.Decimals != 0 {
+		if err := oracletypes.ValidateDecimals(c.Decimals); err != nil {
+			return fmt.Errorf("invalid decimals for %s: %w", c.CurrencyPair, err)
+		}
+	}
+
 	return nil
 }
 
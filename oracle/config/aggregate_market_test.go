@@ -414,6 +414,297 @@ func TestAggregateMarketConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid config with outlier filters",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						OutlierFilter: config.OutlierFilterConfig{
+							Type:      config.OutlierFilterDeviation,
+							Threshold: 0.05,
+						},
+					},
+				},
+				OutlierFilter: config.OutlierFilterConfig{
+					Type:      config.OutlierFilterMAD,
+					Threshold: 3,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid config with bad market outlier filter",
+			cfg: config.AggregateMarketConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Type: config.OutlierFilterMAD,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config with bad smoothing",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						Conversions: []config.Conversions{
+							{
+								{
+									CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+								},
+							},
+						},
+						Smoothing: config.SmoothingConfig{
+							Type: config.SmoothingEMA,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config with bad feed decimals",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"PEPE/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("PEPE", "USD"),
+						Decimals:     oracletypes.MaxDecimals + 1,
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config with aggregated feed decimals that do not match the feed",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"PEPE/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("PEPE", "USD"),
+						Decimals:     18,
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"PEPE/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("PEPE", "USD"),
+						Conversions: []config.Conversions{
+							{
+								{
+									CurrencyPair: oracletypes.NewCurrencyPair("PEPE", "USD"),
+								},
+							},
+						},
+						Decimals: 12,
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid config with path discovery",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT"),
+					},
+					"USD/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("USD", "USDT"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						PathDiscovery: &config.PathDiscoveryConfig{
+							MaxHops: 2,
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid config with conversions and path discovery",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT"),
+					},
+					"USD/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("USD", "USDT"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						Conversions: []config.Conversions{
+							{
+								{
+									CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT"),
+								},
+								{
+									CurrencyPair: oracletypes.NewCurrencyPair("USD", "USDT"),
+									Invert:       true,
+								},
+							},
+						},
+						PathDiscovery: &config.PathDiscoveryConfig{
+							MaxHops: 2,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config where no conversion paths are discovered",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT"),
+					},
+					"USD/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("USD", "USDT"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						PathDiscovery: &config.PathDiscoveryConfig{
+							MaxHops: 1,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config with too many hops",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT"),
+					},
+					"USD/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("USD", "USDT"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						PathDiscovery: &config.PathDiscoveryConfig{
+							MaxHops: config.MaxConversionHops + 1,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config with bad denied feed",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT"),
+					},
+					"USD/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("USD", "USDT"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						PathDiscovery: &config.PathDiscoveryConfig{
+							MaxHops: 2,
+							Deny:    []string{"BITCOIN"},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config with bad circuit breaker",
+			cfg: config.AggregateMarketConfig{
+				CircuitBreaker: config.CircuitBreakerConfig{
+					Threshold: 0.1,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid config with confirmation providers for a feed with provider prices",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						Conversions: []config.Conversions{
+							{{CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD")}},
+						},
+						CircuitBreaker: config.CircuitBreakerConfig{
+							Threshold:             0.1,
+							ConfirmationTicks:     3,
+							ConfirmationProviders: 2,
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid config with confirmation providers for a conversion only feed",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USDT": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT"),
+					},
+					"USDT/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("USDT", "USD"),
+					},
+				},
+				AggregatedFeeds: map[string]config.AggregateFeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						Conversions: []config.Conversions{
+							{
+								{CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USDT")},
+								{CurrencyPair: oracletypes.NewCurrencyPair("USDT", "USD")},
+							},
+						},
+					},
+				},
+				CircuitBreaker: config.CircuitBreakerConfig{
+					Threshold:             0.1,
+					ConfirmationTicks:     3,
+					ConfirmationProviders: 2,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid config with bad feed outlier filter",
+			cfg: config.AggregateMarketConfig{
+				Feeds: map[string]config.FeedConfig{
+					"BITCOIN/USD": {
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						OutlierFilter: config.OutlierFilterConfig{
+							Type:      "zscore",
+							Threshold: 1,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -427,3 +718,134 @@ func TestAggregateMarketConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOutlierFilter(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+	atom := oracletypes.NewCurrencyPair("COSMOS", "USD")
+
+	mad := config.OutlierFilterConfig{
+		Type:      config.OutlierFilterMAD,
+		Threshold: 3,
+	}
+	none := config.OutlierFilterConfig{
+		Type: config.OutlierFilterNone,
+	}
+
+	cfg := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btc.String(): {
+				CurrencyPair: btc,
+			},
+			eth.String(): {
+				CurrencyPair:  eth,
+				OutlierFilter: none,
+			},
+		},
+		OutlierFilter: mad,
+	}
+
+	// Feeds without an outlier filter inherit the market's.
+	require.Equal(t, mad, cfg.GetOutlierFilter(btc))
+	require.Equal(t, mad, cfg.GetOutlierFilter(atom))
+
+	// The feed's outlier filter takes precedence.
+	require.Equal(t, none, cfg.GetOutlierFilter(eth))
+	require.False(t, none.IsEnabled())
+	require.True(t, mad.IsEnabled())
+}
+
+func TestGetMinProviders(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+	atom := oracletypes.NewCurrencyPair("COSMOS", "USD")
+
+	cfg := config.AggregateMarketConfig{
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btc.String(): {
+				CurrencyPair: btc,
+				MinProviders: 5,
+			},
+			eth.String(): {
+				CurrencyPair: eth,
+			},
+		},
+		MinProviders: 3,
+	}
+
+	// The aggregated feed's minimum takes precedence.
+	require.Equal(t, uint64(5), cfg.GetMinProviders(btc))
+
+	// Aggregated feeds without a minimum inherit the market's.
+	require.Equal(t, uint64(3), cfg.GetMinProviders(eth))
+	require.Equal(t, uint64(3), cfg.GetMinProviders(atom))
+}
+
+func TestGetCircuitBreaker(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+	atom := oracletypes.NewCurrencyPair("COSMOS", "USD")
+
+	market := config.CircuitBreakerConfig{
+		Threshold:         0.1,
+		ConfirmationTicks: 3,
+	}
+	feed := config.CircuitBreakerConfig{
+		Threshold:             0.5,
+		ConfirmationTicks:     2,
+		ConfirmationProviders: 3,
+	}
+
+	cfg := config.AggregateMarketConfig{
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btc.String(): {
+				CurrencyPair:   btc,
+				CircuitBreaker: feed,
+			},
+			eth.String(): {
+				CurrencyPair: eth,
+			},
+		},
+		CircuitBreaker: market,
+	}
+
+	// The aggregated feed's circuit breaker takes precedence.
+	require.Equal(t, feed, cfg.GetCircuitBreaker(btc))
+
+	// Aggregated feeds without a circuit breaker inherit the market's.
+	require.Equal(t, market, cfg.GetCircuitBreaker(eth))
+	require.Equal(t, market, cfg.GetCircuitBreaker(atom))
+}
+
+func TestGetDecimals(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	pepe := oracletypes.NewCurrencyPair("PEPE", "USD")
+	pepeUSDT := oracletypes.NewCurrencyPair("PEPE", "USDT")
+	usdc := oracletypes.NewCurrencyPair("USDC", "ETHEREUM")
+
+	cfg := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btc.String(): {
+				CurrencyPair: btc,
+			},
+			pepeUSDT.String(): {
+				CurrencyPair: pepeUSDT,
+				Decimals:     18,
+			},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			pepe.String(): {
+				CurrencyPair: pepe,
+				Decimals:     18,
+			},
+		},
+	}
+
+	// Feeds and aggregated feeds use their configured decimals.
+	require.Equal(t, uint64(18), cfg.GetDecimals(pepe))
+	require.Equal(t, uint64(18), cfg.GetDecimals(pepeUSDT))
+
+	// Currency pairs without decimals use their legacy decimals.
+	require.Equal(t, uint64(8), cfg.GetDecimals(btc))
+	require.Equal(t, uint64(18), cfg.GetDecimals(usdc))
+}
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
+	"strings"
+	"text/template"
 	"time"
 )
 
@@ -26,11 +29,61 @@ type APIConfig struct {
 	// in a single request.
 	Atomic bool `mapstructure:"atomic" toml:"atomic"`
 
+	// BatchSize is the maximum number of ids that an atomic provider can fulfill in a
+	// single request. If set, the ids are split into batches of at most this size, and
+	// each batch is fulfilled with a single request. If zero, all ids are fulfilled in a
+	// single request.
+	BatchSize int `mapstructure:"batch_size" toml:"batch_size"`
+
 	// URL is the URL that is used to fetch data from the API.
 	URL string `mapstructure:"url" toml:"url"`
 
+	// FallbackURLs are the URLs that are used, in order, if URL is unhealthy (i.e. it is
+	// unreachable or geo-blocked). Each fallback URL must have the same format as URL, and
+	// can only differ from it before the first format verb (i.e. in the host).
+	FallbackURLs []string `mapstructure:"fallback_urls" toml:"fallback_urls"`
+
+	// FailbackInterval is the interval after which the provider attempts to use URL again
+	// once it has failed over to a fallback URL. If zero, DefaultFailbackInterval is used.
+	FailbackInterval time.Duration `mapstructure:"failback_interval" toml:"failback_interval"`
+
 	// Name is the name of the provider that corresponds to this config.
 	Name string `mapstructure:"name" toml:"name"`
+
+	// Headers are templates of the HTTP headers that are set on each request, keyed by header
+	// name. Templates can reference the current secret as {{ .Secret }}, i.e. an authorization
+	// header of "Bearer {{ .Secret }}", and can base64 encode values with the base64 function,
+	// i.e. "Basic {{ printf \"user:%s\" .Secret | base64 }}".
+	Headers map[string]string `mapstructure:"headers" toml:"headers"`
+
+	// QueryParameters are templates of the query parameters that are added to each request
+	// URL, keyed by parameter name. These use the same syntax as the headers.
+	QueryParameters map[string]string `mapstructure:"query_parameters" toml:"query_parameters"`
+
+	// Secrets are the secrets (i.e. API keys) that the headers and query parameters reference.
+	// Secrets are read from environment variables or files rather than from the config. If
+	// multiple secrets are configured, they are rotated round-robin across requests.
+	Secrets []SecretConfig `mapstructure:"secrets" toml:"secrets"`
+
+	// RateLimit is the config for the request budget of the provider, and for retrying requests
+	// that the API responds to with a retryable status code.
+	RateLimit RateLimitConfig `mapstructure:"rate_limit" toml:"rate_limit"`
+}
+
+// APITemplateData is the data that the header and query parameter templates of an API config are
+// executed with.
+type APITemplateData struct {
+	// Secret is the value of the secret used for the request.
+	Secret string
+}
+
+// NewAPITemplate parses the given header or query parameter template of an API config.
+func NewAPITemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(template.FuncMap{
+		"base64": func(s string) string {
+			return base64.StdEncoding.EncodeToString([]byte(s))
+		},
+	}).Parse(text)
 }
 
 // ValidateBasic performs basic validation of the API config.
@@ -51,6 +104,14 @@ func (c *APIConfig) ValidateBasic() error {
 		return fmt.Errorf("provider timeout must be greater than 0 and less than the interval")
 	}
 
+	if c.BatchSize < 0 {
+		return fmt.Errorf("api batch size cannot be negative")
+	}
+
+	if c.BatchSize > 0 && !c.Atomic {
+		return fmt.Errorf("api batch size can only be set for atomic providers")
+	}
+
 	if len(c.URL) == 0 {
 		return fmt.Errorf("provider url cannot be empty")
 	}
@@ -59,5 +120,84 @@ func (c *APIConfig) ValidateBasic() error {
 		return fmt.Errorf("provider name cannot be empty")
 	}
 
+	if err := validateFallbacks("api", c.URL, c.FallbackURLs, c.FailbackInterval); err != nil {
+		return err
+	}
+
+	_, format := splitAPIURL(c.URL)
+	for _, fallback := range c.FallbackURLs {
+		if _, fallbackFormat := splitAPIURL(fallback); fallbackFormat != format {
+			return fmt.Errorf("api fallback url %s must have the same format as the url", fallback)
+		}
+	}
+
+	if err := c.RateLimit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, secret := range c.Secrets {
+		if err := secret.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.validateTemplates("header", c.Headers); err != nil {
+		return err
+	}
+
+	return c.validateTemplates("query parameter", c.QueryParameters)
+}
+
+// validateTemplates checks that the given templates can be parsed, and that secrets are configured
+// if the templates reference them.
+func (c *APIConfig) validateTemplates(kind string, templates map[string]string) error {
+	for name, text := range templates {
+		if len(name) == 0 {
+			return fmt.Errorf("%s name cannot be empty", kind)
+		}
+
+		if _, err := NewAPITemplate(name, text); err != nil {
+			return fmt.Errorf("invalid %s template %s: %w", kind, name, err)
+		}
+
+		if len(c.Secrets) == 0 && strings.Contains(text, ".Secret") {
+			return fmt.Errorf("%s template %s references a secret but no secrets are configured", kind, name)
+		}
+	}
+
 	return nil
 }
+
+// GetEndpoints returns the URL and the fallback URLs of the provider, in the order in which
+// they are used.
+func (c *APIConfig) GetEndpoints() []string {
+	return append([]string{c.URL}, c.FallbackURLs...)
+}
+
+// GetFailbackInterval returns the interval after which the provider attempts to use URL again.
+func (c *APIConfig) GetFailbackInterval() time.Duration {
+	return getFailbackInterval(c.FailbackInterval)
+}
+
+// EndpointURL returns the given request URL, which is created from URL, rewritten to use the
+// given endpoint (i.e. a fallback URL) instead. The request URL is returned as is if it was not
+// created from URL.
+func (c *APIConfig) EndpointURL(url, endpoint string) string {
+	base, _ := splitAPIURL(c.URL)
+	if !strings.HasPrefix(url, base) {
+		return url
+	}
+
+	endpointBase, _ := splitAPIURL(endpoint)
+	return endpointBase + strings.TrimPrefix(url, base)
+}
+
+// splitAPIURL splits a URL into its base, which precedes the first format verb, and its format,
+// which includes the first format verb and everything after it.
+func splitAPIURL(url string) (string, string) {
+	if i := strings.Index(url, "%"); i >= 0 {
+		return url[:i], url[i:]
+	}
+
+	return url, ""
+}
@@ -1,6 +1,7 @@
 package config_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -101,6 +102,229 @@ func TestAPIConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with headers, query parameters and secrets",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				URL:        "http://test.com",
+				Name:       "test",
+				Headers: map[string]string{
+					"Authorization": "Bearer {{ .Secret }}",
+				},
+				QueryParameters: map[string]string{
+					"api_key": "{{ .Secret }}",
+				},
+				Secrets: []config.SecretConfig{
+					{Env: "API_KEY"},
+					{File: "api_key.txt"},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config with headers and no secrets",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				URL:        "http://test.com",
+				Name:       "test",
+				Headers: map[string]string{
+					"Accept": "application/json",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with template referencing a secret and no secrets",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				URL:        "http://test.com",
+				Name:       "test",
+				Headers: map[string]string{
+					"Authorization": "Bearer {{ .Secret }}",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with invalid template",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				URL:        "http://test.com",
+				Name:       "test",
+				QueryParameters: map[string]string{
+					"api_key": "{{ .Secret",
+				},
+				Secrets: []config.SecretConfig{
+					{Env: "API_KEY"},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with empty header name",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				URL:        "http://test.com",
+				Name:       "test",
+				Headers: map[string]string{
+					"": "value",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with invalid secret",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				URL:        "http://test.com",
+				Name:       "test",
+				Secrets: []config.SecretConfig{
+					{Env: "API_KEY", File: "api_key.txt"},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with a batch size",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				Atomic:     true,
+				BatchSize:  10,
+				URL:        "http://test.com",
+				Name:       "test",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a negative batch size",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				Atomic:     true,
+				BatchSize:  -1,
+				URL:        "http://test.com",
+				Name:       "test",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a batch size and a non-atomic provider",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				BatchSize:  10,
+				URL:        "http://test.com",
+				Name:       "test",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with fallback urls",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				MaxQueries:       1,
+				URL:              "http://test.com/price?symbol=%s",
+				FallbackURLs:     []string{"http://test2.com/price?symbol=%s"},
+				FailbackInterval: time.Minute,
+				Name:             "test",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a fallback url with a different format",
+			config: config.APIConfig{
+				Enabled:      true,
+				Timeout:      time.Second,
+				Interval:     time.Second,
+				MaxQueries:   1,
+				URL:          "http://test.com/price?symbol=%s",
+				FallbackURLs: []string{"http://test2.com/price?symbol=%s&limit=1"},
+				Name:         "test",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a duplicate fallback url",
+			config: config.APIConfig{
+				Enabled:      true,
+				Timeout:      time.Second,
+				Interval:     time.Second,
+				MaxQueries:   1,
+				URL:          "http://test.com",
+				FallbackURLs: []string{"http://test.com"},
+				Name:         "test",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an empty fallback url",
+			config: config.APIConfig{
+				Enabled:      true,
+				Timeout:      time.Second,
+				Interval:     time.Second,
+				MaxQueries:   1,
+				URL:          "http://test.com",
+				FallbackURLs: []string{""},
+				Name:         "test",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a negative failback interval",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				MaxQueries:       1,
+				URL:              "http://test.com",
+				FallbackURLs:     []string{"http://test2.com"},
+				FailbackInterval: -time.Second,
+				Name:             "test",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with invalid rate limit",
+			config: config.APIConfig{
+				Enabled:    true,
+				Timeout:    time.Second,
+				Interval:   time.Second,
+				MaxQueries: 1,
+				URL:        "http://test.com",
+				Name:       "test",
+				RateLimit: config.RateLimitConfig{
+					MaxRetries: -1,
+				},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -114,3 +338,20 @@ func TestAPIConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIConfigEndpointURL(t *testing.T) {
+	cfg := config.APIConfig{
+		URL:          "https://api.test.com/v1/price?symbol=%s",
+		FallbackURLs: []string{"https://api2.test.com/v1/price?symbol=%s"},
+	}
+
+	require.Equal(t, []string{cfg.URL, cfg.FallbackURLs[0]}, cfg.GetEndpoints())
+	require.Equal(t, config.DefaultFailbackInterval, cfg.GetFailbackInterval())
+
+	url := fmt.Sprintf(cfg.URL, "BTCUSDT")
+	require.Equal(t, url, cfg.EndpointURL(url, cfg.URL))
+	require.Equal(t, "https://api2.test.com/v1/price?symbol=BTCUSDT", cfg.EndpointURL(url, cfg.FallbackURLs[0]))
+
+	// URLs that are not created from the URL of the provider are not rewritten.
+	require.Equal(t, "https://other.com", cfg.EndpointURL("https://other.com", cfg.FallbackURLs[0]))
+}
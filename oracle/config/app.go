@@ -31,6 +31,22 @@ enabled = "{{ .Oracle.Enabled }}"
 # machine or a remote machine.
 oracle_address = "{{ .Oracle.OracleAddress }}"
 
+# Fallback Oracle Addresses are the URLs of additional oracle sidecars, in priority
+# order. The client queries the highest priority sidecar that is healthy, starting
+# with the oracle address, and fails over to the next sidecar if a request fails.
+# The client timeout is split between the sidecars that have not been queried yet,
+# such that every fallback sidecar is queried within the client timeout.
+fallback_oracle_addresses = [{{ range $i, $addr := .Oracle.FallbackOracleAddresses }}{{ if $i }}, {{ end }}"{{ $addr }}"{{ end }}]
+
+# Hedge Fraction is the fraction of the client timeout after which the next sidecar
+# is queried if the current sidecar has not responded yet, with the first response
+# being used. Hedged requests are disabled if this is zero.
+hedge_fraction = "{{ .Oracle.HedgeFraction }}"
+
+# Failover Cooldown is the time that a sidecar is deprioritized for after a failed
+# request. If zero, a default of 10s is used.
+failover_cooldown = "{{ .Oracle.FailoverCooldown }}"
+
 # Client Timeout is the time that the client is willing to wait for responses from 
 # the oracle before timing out.
 client_timeout = "{{ .Oracle.ClientTimeout }}"
@@ -43,15 +59,47 @@ metrics_enabled = "{{ .Oracle.MetricsEnabled }}"
 # PrometheusServerAddress is the address of the prometheus server that metrics will be
 # exposed to.
 prometheus_server_address = "{{ .Oracle.PrometheusServerAddress }}"
+
+# TLS configures TLS on the connection to the oracle sidecars. This must be enabled
+# if the oracle sidecars serve TLS.
+[oracle.tls]
+# Enabled indicates whether TLS is enabled.
+enabled = "{{ .Oracle.TLS.Enabled }}"
+
+# Cert File and Key File are the paths to the PEM encoded client certificate and its
+# private key. These are presented to oracle sidecars that require client certificates.
+cert_file = "{{ .Oracle.TLS.CertFile }}"
+key_file = "{{ .Oracle.TLS.KeyFile }}"
+
+# CA File is the path to the PEM encoded certificate authorities that the certificate
+# of the oracle sidecar is verified with. If empty, the system certificate authorities
+# are used.
+ca_file = "{{ .Oracle.TLS.CAFile }}"
+
+# Server Name is the name that the certificate of the oracle sidecar is verified
+# against. If empty, the host of the oracle address is used.
+server_name = "{{ .Oracle.TLS.ServerName }}"
 `
 )
 
+// DefaultFailoverCooldown is the default time that a sidecar is deprioritized for after a
+// failed request.
+const DefaultFailoverCooldown = 10 * time.Second
+
 const (
 	flagEnabled                 = "oracle.enabled"
 	flagOracleAddress           = "oracle.oracle_address"
+	flagFallbackOracleAddresses = "oracle.fallback_oracle_addresses"
+	flagHedgeFraction           = "oracle.hedge_fraction"
+	flagFailoverCooldown        = "oracle.failover_cooldown"
 	flagClientTimeout           = "oracle.client_timeout"
 	flagMetricsEnabled          = "oracle.metrics_enabled"
 	flagPrometheusServerAddress = "oracle.prometheus_server_address"
+	flagTLSEnabled              = "oracle.tls.enabled"
+	flagTLSCertFile             = "oracle.tls.cert_file"
+	flagTLSKeyFile              = "oracle.tls.key_file"
+	flagTLSCAFile               = "oracle.tls.ca_file"
+	flagTLSServerName           = "oracle.tls.server_name"
 )
 
 // AppConfig contains the application side oracle configurations that must
@@ -64,6 +112,19 @@ type AppConfig struct {
 	// used to connect to the oracle sidecar.
 	OracleAddress string `mapstructure:"oracle_address" toml:"oracle_address"`
 
+	// FallbackOracleAddresses are the URLs of additional oracle sidecars in priority order.
+	// The client fails over to these sidecars if the oracle sidecar is unhealthy, splitting the
+	// client timeout between the sidecars.
+	FallbackOracleAddresses []string `mapstructure:"fallback_oracle_addresses" toml:"fallback_oracle_addresses"`
+
+	// HedgeFraction is the fraction of the client timeout after which the next sidecar is
+	// queried if the current sidecar has not responded yet. If zero, requests are not hedged.
+	HedgeFraction float64 `mapstructure:"hedge_fraction" toml:"hedge_fraction"`
+
+	// FailoverCooldown is the time that a sidecar is deprioritized for after a failed
+	// request. If zero, DefaultFailoverCooldown is used.
+	FailoverCooldown time.Duration `mapstructure:"failover_cooldown" toml:"failover_cooldown"`
+
 	// ClientTimeout is the time that the client is willing to wait for responses
 	// from the oracle before timing out.
 	ClientTimeout time.Duration `mapstructure:"client_timeout" toml:"client_timeout"`
@@ -74,6 +135,9 @@ type AppConfig struct {
 	// PrometheusServerAddress is the address of the prometheus server that the oracle
 	// will expose metrics to.
 	PrometheusServerAddress string `mapstructure:"prometheus_server_address" toml:"prometheus_server_address"`
+
+	// TLS is the config for TLS on the connection to the oracle sidecars.
+	TLS TLSConfig `mapstructure:"tls" toml:"tls"`
 }
 
 // ValidateBasic performs basic validation of the app config.
@@ -86,6 +150,26 @@ func (c *AppConfig) ValidateBasic() error {
 		return fmt.Errorf("oracle address must be valid: %w", err)
 	}
 
+	seen := map[string]struct{}{c.OracleAddress: {}}
+	for _, addr := range c.FallbackOracleAddresses {
+		if _, err := url.ParseRequestURI(addr); err != nil {
+			return fmt.Errorf("fallback oracle address must be valid: %w", err)
+		}
+
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate oracle address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	if c.HedgeFraction < 0 || c.HedgeFraction >= 1 {
+		return fmt.Errorf("oracle hedge fraction must be at least 0 and less than 1; got %f", c.HedgeFraction)
+	}
+
+	if c.FailoverCooldown < 0 {
+		return fmt.Errorf("oracle failover cooldown cannot be negative")
+	}
+
 	if c.ClientTimeout <= 0 {
 		return fmt.Errorf("oracle client timeout must be greater than 0")
 	}
@@ -96,7 +180,29 @@ func (c *AppConfig) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	return c.TLS.ValidateBasic()
+}
+
+// GetOracleAddresses returns the addresses of the oracle sidecars in priority order, starting
+// with the oracle address.
+func (c *AppConfig) GetOracleAddresses() []string {
+	return append([]string{c.OracleAddress}, c.FallbackOracleAddresses...)
+}
+
+// GetHedgeDelay returns the time after which the next sidecar is queried if the current
+// sidecar has not responded yet. Requests are not hedged if this is zero.
+func (c *AppConfig) GetHedgeDelay() time.Duration {
+	return time.Duration(c.HedgeFraction * float64(c.ClientTimeout))
+}
+
+// GetFailoverCooldown returns the time that a sidecar is deprioritized for after a failed
+// request.
+func (c *AppConfig) GetFailoverCooldown() time.Duration {
+	if c.FailoverCooldown == 0 {
+		return DefaultFailoverCooldown
+	}
+
+	return c.FailoverCooldown
 }
 
 // ReadConfigFromFile reads a config from a file and returns the config.
@@ -144,6 +250,27 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// get the fallback oracle addresses
+	if v := opts.Get(flagFallbackOracleAddresses); v != nil {
+		if cfg.FallbackOracleAddresses, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	// get the hedge fraction
+	if v := opts.Get(flagHedgeFraction); v != nil {
+		if cfg.HedgeFraction, err = cast.ToFloat64E(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	// get the failover cooldown
+	if v := opts.Get(flagFailoverCooldown); v != nil {
+		if cfg.FailoverCooldown, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+
 	// get the client timeout
 	if v := opts.Get(flagClientTimeout); v != nil {
 		if cfg.ClientTimeout, err = cast.ToDurationE(v); err != nil {
@@ -165,6 +292,37 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// get the tls config
+	if v := opts.Get(flagTLSEnabled); v != nil {
+		if cfg.TLS.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := opts.Get(flagTLSCertFile); v != nil {
+		if cfg.TLS.CertFile, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := opts.Get(flagTLSKeyFile); v != nil {
+		if cfg.TLS.KeyFile, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := opts.Get(flagTLSCAFile); v != nil {
+		if cfg.TLS.CAFile, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := opts.Get(flagTLSServerName); v != nil {
+		if cfg.TLS.ServerName, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+
 	if err := cfg.ValidateBasic(); err != nil {
 		return cfg, err
 	}
@@ -1,11 +1,14 @@
 package config_test
 
 import (
+	"bytes"
 	"os"
 	"testing"
+	"text/template"
 	"time"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -71,6 +74,71 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "good config with fallback addresses and hedging",
+			config: config.AppConfig{
+				Enabled:                 true,
+				OracleAddress:           "localhost:8080",
+				FallbackOracleAddresses: []string{"localhost:8081", "localhost:8082"},
+				HedgeFraction:           0.5,
+				FailoverCooldown:        time.Minute,
+				ClientTimeout:           time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with invalid fallback address",
+			config: config.AppConfig{
+				Enabled:                 true,
+				OracleAddress:           "localhost:8080",
+				FallbackOracleAddresses: []string{""},
+				ClientTimeout:           time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with duplicate fallback address",
+			config: config.AppConfig{
+				Enabled:                 true,
+				OracleAddress:           "localhost:8080",
+				FallbackOracleAddresses: []string{"localhost:8080"},
+				ClientTimeout:           time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with hedge fraction of 1",
+			config: config.AppConfig{
+				Enabled:       true,
+				OracleAddress: "localhost:8080",
+				HedgeFraction: 1,
+				ClientTimeout: time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative failover cooldown",
+			config: config.AppConfig{
+				Enabled:          true,
+				OracleAddress:    "localhost:8080",
+				FailoverCooldown: -time.Second,
+				ClientTimeout:    time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with invalid tls config",
+			config: config.AppConfig{
+				Enabled:       true,
+				OracleAddress: "localhost:8080",
+				ClientTimeout: time.Second,
+				TLS: config.TLSConfig{
+					Enabled:  true,
+					CertFile: "client.pem",
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name: "bad config with no oracle address",
 			config: config.AppConfig{
@@ -156,3 +224,41 @@ func TestReadConfigFromFile(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultConfigTemplate(t *testing.T) {
+	expected := config.AppConfig{
+		Enabled:                 true,
+		OracleAddress:           "localhost:8080",
+		FallbackOracleAddresses: []string{"localhost:8081", "localhost:8082"},
+		HedgeFraction:           0.25,
+		FailoverCooldown:        time.Minute,
+		ClientTimeout:           time.Second,
+		MetricsEnabled:          true,
+		PrometheusServerAddress: "localhost:9090",
+		TLS: config.TLSConfig{
+			Enabled:    true,
+			CertFile:   "client.pem",
+			KeyFile:    "client-key.pem",
+			CAFile:     "ca.pem",
+			ServerName: "oracle",
+		},
+	}
+
+	tmpl, err := template.New("app").Parse(config.DefaultConfigTemplate)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ Oracle config.AppConfig }{Oracle: expected}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+
+	var cfg config.AppConfig
+	require.NoError(t, v.Sub("oracle").Unmarshal(&cfg))
+	require.Equal(t, expected, cfg)
+
+	require.Equal(t, []string{"localhost:8080", "localhost:8081", "localhost:8082"}, cfg.GetOracleAddresses())
+	require.Equal(t, 250*time.Millisecond, cfg.GetHedgeDelay())
+	require.Equal(t, time.Minute, cfg.GetFailoverCooldown())
+}
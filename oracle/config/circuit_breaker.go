@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+)
+
+// CircuitBreakerConfig defines when the price of a currency pair is held instead of being
+// reported. The circuit breaker trips when the aggregated price moves by more than the threshold
+// from the last reported price, and holds the last reported price until the move is confirmed.
+// This prevents single tick spikes caused by bad provider data from being reported.
+type CircuitBreakerConfig struct {
+	// Threshold is the maximum move of the aggregated price from the last reported price,
+	// expressed as a fraction of the last reported price (i.e. 0.1 for 10%). If zero, the
+	// circuit breaker is inherited i.e. an aggregated feed without a circuit breaker uses the
+	// market's circuit breaker.
+	Threshold float64 `mapstructure:"threshold" toml:"threshold"`
+
+	// ConfirmationTicks is the number of consecutive ticks, including the tick that tripped the
+	// circuit breaker, that the move must persist for before the new price is reported. Must be
+	// at least 2 if the circuit breaker is enabled.
+	ConfirmationTicks uint64 `mapstructure:"confirmation_ticks" toml:"confirmation_ticks"`
+
+	// ConfirmationProviders is the number of distinct providers whose own price must confirm the
+	// move before the new price is reported, irrespective of the number of ticks. If zero, the
+	// move can only be confirmed over consecutive ticks.
+	ConfirmationProviders uint64 `mapstructure:"confirmation_providers" toml:"confirmation_providers"`
+}
+
+// IsEnabled returns true if the config holds prices.
+func (c *CircuitBreakerConfig) IsEnabled() bool {
+	return c.Threshold > 0
+}
+
+// ValidateBasic performs basic validation of the circuit breaker config.
+func (c *CircuitBreakerConfig) ValidateBasic() error {
+	if c.Threshold < 0 {
+		return fmt.Errorf("circuit breaker threshold cannot be negative; got %f", c.Threshold)
+	}
+
+	if !c.IsEnabled() {
+		return nil
+	}
+
+	if c.ConfirmationTicks < 2 {
+		return fmt.Errorf("circuit breaker confirmation ticks must be at least 2; got %d", c.ConfirmationTicks)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestCircuitBreakerConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.CircuitBreakerConfig
+		expectedErr bool
+	}{
+		{
+			name:        "unset",
+			config:      config.CircuitBreakerConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config confirmed over ticks",
+			config: config.CircuitBreakerConfig{
+				Threshold:         0.1,
+				ConfirmationTicks: 3,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config confirmed by providers",
+			config: config.CircuitBreakerConfig{
+				Threshold:             0.5,
+				ConfirmationTicks:     5,
+				ConfirmationProviders: 3,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative threshold",
+			config: config.CircuitBreakerConfig{
+				Threshold:         -0.1,
+				ConfirmationTicks: 3,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no confirmation ticks",
+			config: config.CircuitBreakerConfig{
+				Threshold:             0.1,
+				ConfirmationProviders: 3,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "single confirmation tick",
+			config: config.CircuitBreakerConfig{
+				Threshold:         0.1,
+				ConfirmationTicks: 1,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// CurrencyPairSyncConfig is the config for syncing the oracle's set of currency pairs with the
+// set of currency pairs tracked by the x/oracle module on chain. When enabled, the oracle
+// periodically queries a node for all currency pairs and updates the set of currency pairs that
+// each provider fetches prices for. Only currency pairs that have a ticker mapping in a provider's
+// market config can be fetched by that provider. Whenever a node address is configured, the
+// oracle also periodically checks the decimals of its currency pairs against the decimals on
+// chain, even if syncing is disabled.
+type CurrencyPairSyncConfig struct {
+	// Enabled indicates whether the currency pairs should be synced from on chain state.
+	Enabled bool `mapstructure:"enabled" toml:"enabled"`
+
+	// Address is the gRPC address of the node that is queried for the on chain currency pairs
+	// and their decimals. If set, the decimals are checked even if syncing is disabled.
+	Address string `mapstructure:"address" toml:"address"`
+
+	// Interval is the interval at which the on chain currency pairs are queried.
+	Interval time.Duration `mapstructure:"interval" toml:"interval"`
+
+	// Timeout is the amount of time to wait for a response from the node before timing out.
+	Timeout time.Duration `mapstructure:"timeout" toml:"timeout"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *CurrencyPairSyncConfig) ValidateBasic() error {
+	if len(c.Address) == 0 {
+		if c.Enabled {
+			return fmt.Errorf("must supply a non-empty node address if currency pair sync is enabled")
+		}
+
+		return nil
+	}
+
+	if c.Interval <= 0 || c.Timeout <= 0 {
+		return fmt.Errorf("currency pair sync interval and timeout must be strictly positive")
+	}
+
+	if c.Timeout > c.Interval {
+		return fmt.Errorf("currency pair sync timeout must be less than or equal to the interval")
+	}
+
+	return nil
+}
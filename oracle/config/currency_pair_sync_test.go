@@ -0,0 +1,103 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestCurrencyPairSyncConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.CurrencyPairSyncConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config with sync enabled",
+			config: config.CurrencyPairSyncConfig{
+				Enabled:  true,
+				Address:  "localhost:9090",
+				Interval: time.Minute,
+				Timeout:  time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "sync not enabled",
+			config: config.CurrencyPairSyncConfig{
+				Enabled: false,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config with only the decimals check",
+			config: config.CurrencyPairSyncConfig{
+				Enabled:  false,
+				Address:  "localhost:9090",
+				Interval: time.Minute,
+				Timeout:  time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a node address and no interval",
+			config: config.CurrencyPairSyncConfig{
+				Enabled: false,
+				Address: "localhost:9090",
+				Timeout: time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no address",
+			config: config.CurrencyPairSyncConfig{
+				Enabled:  true,
+				Interval: time.Minute,
+				Timeout:  time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no interval",
+			config: config.CurrencyPairSyncConfig{
+				Enabled: true,
+				Address: "localhost:9090",
+				Timeout: time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no timeout",
+			config: config.CurrencyPairSyncConfig{
+				Enabled:  true,
+				Address:  "localhost:9090",
+				Interval: time.Minute,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with timeout greater than interval",
+			config: config.CurrencyPairSyncConfig{
+				Enabled:  true,
+				Address:  "localhost:9090",
+				Interval: time.Second,
+				Timeout:  time.Minute,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"reflect"
+)
+
+// OracleConfigDiff represents the set of changes between two oracle configurations. It is
+// used to determine which parts of a running oracle must be updated when the oracle
+// configuration is reloaded.
+type OracleConfigDiff struct {
+	// AddedProviders is the set of providers that are present in the new config but not
+	// in the old config.
+	AddedProviders []ProviderConfig
+
+	// RemovedProviders is the set of providers that are present in the old config but not
+	// in the new config.
+	RemovedProviders []ProviderConfig
+
+	// UpdatedProviders is the set of providers that are present in both configs but whose
+	// configurations (API, websocket or market) have changed. These providers must be
+	// recreated.
+	UpdatedProviders []ProviderConfig
+
+	// MarketUpdated is true if the aggregate market config has changed.
+	MarketUpdated bool
+
+	// UpdateIntervalUpdated is true if the oracle update interval has changed.
+	UpdateIntervalUpdated bool
+
+	// PriceHistoryUpdated is true if the price history config has changed.
+	PriceHistoryUpdated bool
+
+	// UnsupportedUpdates is the set of configs that have changed but cannot be applied to a
+	// running oracle (i.e. the production, metrics, currency pair sync, health, snapshot, admin
+	// and TLS configs). The oracle must be restarted for these to take effect.
+	UnsupportedUpdates []string
+}
+
+// DiffOracleConfig returns the set of changes required to go from the old config to the
+// new config. Both configs are expected to have been validated.
+func DiffOracleConfig(oldCfg, newCfg OracleConfig) OracleConfigDiff {
+	diff := OracleConfigDiff{
+		MarketUpdated:         !reflect.DeepEqual(oldCfg.Market, newCfg.Market),
+		UpdateIntervalUpdated: oldCfg.UpdateInterval != newCfg.UpdateInterval,
+		PriceHistoryUpdated:   oldCfg.PriceHistory != newCfg.PriceHistory,
+	}
+
+	if oldCfg.Production != newCfg.Production {
+		diff.UnsupportedUpdates = append(diff.UnsupportedUpdates, "production")
+	}
+	if !reflect.DeepEqual(oldCfg.Metrics, newCfg.Metrics) {
+		diff.UnsupportedUpdates = append(diff.UnsupportedUpdates, "metrics")
+	}
+	if !reflect.DeepEqual(oldCfg.CurrencyPairSync, newCfg.CurrencyPairSync) {
+		diff.UnsupportedUpdates = append(diff.UnsupportedUpdates, "currency pair sync")
+	}
+	if !reflect.DeepEqual(oldCfg.Health, newCfg.Health) {
+		diff.UnsupportedUpdates = append(diff.UnsupportedUpdates, "health")
+	}
+	if !reflect.DeepEqual(oldCfg.Snapshot, newCfg.Snapshot) {
+		diff.UnsupportedUpdates = append(diff.UnsupportedUpdates, "snapshot")
+	}
+	if !reflect.DeepEqual(oldCfg.Admin, newCfg.Admin) {
+		diff.UnsupportedUpdates = append(diff.UnsupportedUpdates, "admin")
+	}
+	if !reflect.DeepEqual(oldCfg.TLS, newCfg.TLS) {
+		diff.UnsupportedUpdates = append(diff.UnsupportedUpdates, "tls")
+	}
+
+	oldProviders := make(map[string]ProviderConfig, len(oldCfg.Providers))
+	for _, p := range oldCfg.Providers {
+		oldProviders[p.Name] = p
+	}
+
+	newProviders := make(map[string]struct{}, len(newCfg.Providers))
+	for _, p := range newCfg.Providers {
+		newProviders[p.Name] = struct{}{}
+
+		oldProvider, ok := oldProviders[p.Name]
+		switch {
+		case !ok:
+			diff.AddedProviders = append(diff.AddedProviders, p)
+		case !reflect.DeepEqual(withMarketDecimals(oldProvider, oldCfg.Market), withMarketDecimals(p, newCfg.Market)):
+			diff.UpdatedProviders = append(diff.UpdatedProviders, p)
+		}
+	}
+
+	for _, p := range oldCfg.Providers {
+		if _, ok := newProviders[p.Name]; !ok {
+			diff.RemovedProviders = append(diff.RemovedProviders, p)
+		}
+	}
+
+	return diff
+}
+
+// withMarketDecimals returns the provider config with the decimals of the aggregate market config
+// applied to its market config. Providers are compared with the decimals applied so that providers
+// whose currency pairs changed decimals are recreated.
+func withMarketDecimals(p ProviderConfig, market AggregateMarketConfig) ProviderConfig {
+	if updated, err := p.Market.WithDecimals(market); err == nil {
+		p.Market = updated
+	}
+
+	return p
+}
+
+// IsEmpty returns true if there are no changes between the two configs.
+func (d OracleConfigDiff) IsEmpty() bool {
+	return len(d.AddedProviders) == 0 &&
+		len(d.RemovedProviders) == 0 &&
+		len(d.UpdatedProviders) == 0 &&
+		!d.MarketUpdated &&
+		!d.UpdateIntervalUpdated &&
+		!d.PriceHistoryUpdated &&
+		len(d.UnsupportedUpdates) == 0
+}
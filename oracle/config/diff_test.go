@@ -0,0 +1,195 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestDiffOracleConfig(t *testing.T) {
+	apiProvider := config.ProviderConfig{
+		Name: "api",
+		API: config.APIConfig{
+			Enabled:    true,
+			Timeout:    time.Second,
+			Interval:   time.Second,
+			MaxQueries: 1,
+			URL:        "http://test.com",
+			Name:       "api",
+		},
+	}
+
+	updatedAPIProvider := apiProvider
+	updatedAPIProvider.API.Interval = 2 * time.Second
+
+	wsProvider := config.ProviderConfig{
+		Name: "websocket",
+		WebSocket: config.WebSocketConfig{
+			Enabled: true,
+			WSS:     "wss://test.com",
+			Name:    "websocket",
+		},
+	}
+
+	market := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			"BITCOIN/USD": {
+				CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		oldCfg   config.OracleConfig
+		newCfg   config.OracleConfig
+		expected config.OracleConfigDiff
+		empty    bool
+	}{
+		{
+			name:     "empty configs",
+			empty:    true,
+			expected: config.OracleConfigDiff{},
+		},
+		{
+			name: "identical configs",
+			oldCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+				Providers:      []config.ProviderConfig{apiProvider, wsProvider},
+				Market:         market,
+			},
+			newCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+				Providers:      []config.ProviderConfig{wsProvider, apiProvider},
+				Market:         market,
+			},
+			empty:    true,
+			expected: config.OracleConfigDiff{},
+		},
+		{
+			name: "added provider",
+			oldCfg: config.OracleConfig{
+				Providers: []config.ProviderConfig{apiProvider},
+			},
+			newCfg: config.OracleConfig{
+				Providers: []config.ProviderConfig{apiProvider, wsProvider},
+			},
+			expected: config.OracleConfigDiff{
+				AddedProviders: []config.ProviderConfig{wsProvider},
+			},
+		},
+		{
+			name: "removed provider",
+			oldCfg: config.OracleConfig{
+				Providers: []config.ProviderConfig{apiProvider, wsProvider},
+			},
+			newCfg: config.OracleConfig{
+				Providers: []config.ProviderConfig{wsProvider},
+			},
+			expected: config.OracleConfigDiff{
+				RemovedProviders: []config.ProviderConfig{apiProvider},
+			},
+		},
+		{
+			name: "updated provider",
+			oldCfg: config.OracleConfig{
+				Providers: []config.ProviderConfig{apiProvider, wsProvider},
+			},
+			newCfg: config.OracleConfig{
+				Providers: []config.ProviderConfig{updatedAPIProvider, wsProvider},
+			},
+			expected: config.OracleConfigDiff{
+				UpdatedProviders: []config.ProviderConfig{updatedAPIProvider},
+			},
+		},
+		{
+			name: "updated market and interval",
+			oldCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+			},
+			newCfg: config.OracleConfig{
+				UpdateInterval: 2 * time.Second,
+				Market:         market,
+			},
+			expected: config.OracleConfigDiff{
+				MarketUpdated:         true,
+				UpdateIntervalUpdated: true,
+			},
+		},
+		{
+			name: "updated price history",
+			oldCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+			},
+			newCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+				PriceHistory: config.PriceHistoryConfig{
+					Enabled:    true,
+					MaxEntries: 10,
+					MaxAge:     time.Minute,
+				},
+			},
+			expected: config.OracleConfigDiff{
+				PriceHistoryUpdated: true,
+			},
+		},
+		{
+			name: "updated production, metrics and currency pair sync",
+			oldCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+			},
+			newCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+				Production:     true,
+				Metrics: config.MetricsConfig{
+					Enabled:                 true,
+					PrometheusServerAddress: "0.0.0.0:8002",
+				},
+				CurrencyPairSync: config.CurrencyPairSyncConfig{
+					Enabled: true,
+				},
+			},
+			expected: config.OracleConfigDiff{
+				UnsupportedUpdates: []string{"production", "metrics", "currency pair sync"},
+			},
+		},
+		{
+			name: "updated health, snapshot, admin and tls",
+			oldCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+			},
+			newCfg: config.OracleConfig{
+				UpdateInterval: time.Second,
+				Health: config.HealthConfig{
+					MaxSyncAge: time.Minute,
+				},
+				Snapshot: config.SnapshotConfig{
+					Enabled:  true,
+					Path:     "snapshot.json",
+					Interval: time.Minute,
+				},
+				Admin: config.AdminConfig{
+					Enabled: true,
+				},
+				TLS: config.TLSConfig{
+					Enabled: true,
+				},
+			},
+			expected: config.OracleConfigDiff{
+				UnsupportedUpdates: []string{"health", "snapshot", "admin", "tls"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff := config.DiffOracleConfig(tc.oldCfg, tc.newCfg)
+			require.Equal(t, tc.expected, diff)
+			require.Equal(t, tc.empty, diff.IsEmpty())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultFailbackInterval is the default interval after which a provider that failed over to a
+// fallback endpoint attempts to use its primary endpoint again.
+const DefaultFailbackInterval = 5 * time.Minute
+
+// validateFallbacks checks that the fallback endpoints of a provider are set, and are distinct
+// from each other and from the primary endpoint.
+func validateFallbacks(kind, primary string, fallbacks []string, failbackInterval time.Duration) error {
+	if failbackInterval < 0 {
+		return fmt.Errorf("%s failback interval cannot be negative", kind)
+	}
+
+	seen := map[string]struct{}{primary: {}}
+	for _, fallback := range fallbacks {
+		if len(strings.TrimSpace(fallback)) == 0 {
+			return fmt.Errorf("%s fallback endpoint cannot be empty", kind)
+		}
+
+		if _, ok := seen[fallback]; ok {
+			return fmt.Errorf("duplicate %s endpoint %s", kind, fallback)
+		}
+		seen[fallback] = struct{}{}
+	}
+
+	return nil
+}
+
+// getFailbackInterval returns the given failback interval, or the default if it is not set.
+func getFailbackInterval(failbackInterval time.Duration) time.Duration {
+	if failbackInterval == 0 {
+		return DefaultFailbackInterval
+	}
+
+	return failbackInterval
+}
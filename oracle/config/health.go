@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// HealthConfig is the config for the readiness checks of the oracle server. The oracle is ready
+// once it is running, has updated its prices at least once, and enough currency pairs have fresh
+// prices and enough providers are healthy. The zero value only requires the oracle to be running
+// and to have updated its prices.
+type HealthConfig struct {
+	// MinFreshPairRatio is the minimum share, between 0 and 1, of the currency pairs in the market
+	// config that must have a fresh price for the oracle to be ready.
+	MinFreshPairRatio float64 `mapstructure:"min_fresh_pair_ratio" toml:"min_fresh_pair_ratio"`
+
+	// MinHealthyProviders is the minimum number of providers that must have reported a fresh price
+	// in the latest oracle update for the oracle to be ready.
+	MinHealthyProviders uint64 `mapstructure:"min_healthy_providers" toml:"min_healthy_providers"`
+
+	// MaxSyncAge is the maximum time since the latest oracle update. Once exceeded, every price is
+	// considered stale. If zero, the time since the latest update is not checked.
+	MaxSyncAge time.Duration `mapstructure:"max_sync_age" toml:"max_sync_age"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *HealthConfig) ValidateBasic() error {
+	if c.MinFreshPairRatio < 0 || c.MinFreshPairRatio > 1 {
+		return fmt.Errorf("health min fresh pair ratio must be between 0 and 1; got %f", c.MinFreshPairRatio)
+	}
+
+	if c.MaxSyncAge < 0 {
+		return fmt.Errorf("health max sync age cannot be negative")
+	}
+
+	return nil
+}
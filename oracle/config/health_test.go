@@ -0,0 +1,65 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestHealthConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.HealthConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config",
+			config: config.HealthConfig{
+				MinFreshPairRatio:   0.8,
+				MinHealthyProviders: 2,
+				MaxSyncAge:          10 * time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "empty config",
+			config:      config.HealthConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with negative fresh pair ratio",
+			config: config.HealthConfig{
+				MinFreshPairRatio: -0.1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with fresh pair ratio above 1",
+			config: config.HealthConfig{
+				MinFreshPairRatio: 1.1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative max sync age",
+			config: config.HealthConfig{
+				MaxSyncAge: -time.Second,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
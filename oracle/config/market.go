@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
@@ -33,6 +34,15 @@ type CurrencyPairMarketConfig struct {
 
 	// CurrencyPair is the on-chain representation of the currency pair.
 	CurrencyPair oracletypes.CurrencyPair `mapstructure:"currency_pair" toml:"currency_pair"`
+
+	// MaxPriceAge is the maximum age of a price reported for the currency pair for it to be
+	// included in the aggregated price. If zero, the provider's max price age is used.
+	MaxPriceAge time.Duration `mapstructure:"max_price_age" toml:"max_price_age,omitzero"`
+
+	// Decimals is the number of decimals that the provider reports the price of the currency pair
+	// with. This is set from the aggregate market config when the oracle config is validated. If
+	// zero, the legacy decimals of the currency pair are used.
+	Decimals uint64 `mapstructure:"decimals" toml:"decimals,omitzero"`
 }
 
 // NewMarketConfig returns a new MarketConfig instance.
@@ -88,11 +98,61 @@ func (c *MarketConfig) ValidateBasic() error {
 	return nil
 }
 
+// WithDecimals returns a copy of the market config in which the decimals of each currency pair are
+// set to the decimals of the currency pair in the aggregate market config, such that the provider
+// reports prices with the same decimals as the aggregated price. This errors if a currency pair
+// already has different decimals. The market config is not modified.
+func (c *MarketConfig) WithDecimals(market AggregateMarketConfig) (MarketConfig, error) {
+	updated := MarketConfig{
+		Name:                        c.Name,
+		CurrencyPairToMarketConfigs: make(map[string]CurrencyPairMarketConfig, len(c.CurrencyPairToMarketConfigs)),
+	}
+
+	for cpStr, marketConfig := range c.CurrencyPairToMarketConfigs {
+		decimals := market.GetDecimals(marketConfig.CurrencyPair)
+		if marketConfig.Decimals != 0 && marketConfig.Decimals != decimals {
+			return MarketConfig{}, fmt.Errorf(
+				"decimals %d for %s do not match the decimals %d in the market config",
+				marketConfig.Decimals,
+				marketConfig.CurrencyPair,
+				decimals,
+			)
+		}
+
+		marketConfig.Decimals = decimals
+		updated.CurrencyPairToMarketConfigs[cpStr] = marketConfig
+	}
+
+	updated.Invert()
+
+	return updated, nil
+}
+
 // ValidateBasic performs basic validation of the currency pair market config.
 func (c *CurrencyPairMarketConfig) ValidateBasic() error {
 	if len(c.Ticker) == 0 {
 		return fmt.Errorf("ticker cannot be empty")
 	}
 
+	if c.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age cannot be negative")
+	}
+
+	if c.Decimals != 0 {
+		if err := oracletypes.ValidateDecimals(c.Decimals); err != nil {
+			return err
+		}
+	}
+
 	return c.CurrencyPair.ValidateBasic()
 }
+
+// GetDecimals returns the number of decimals that the provider reports the price of the currency
+// pair with.
+func (c *CurrencyPairMarketConfig) GetDecimals() int {
+	if c.Decimals == 0 {
+		return int(c.CurrencyPair.LegacyDecimals())
+	}
+
+	return int(c.Decimals)
+}
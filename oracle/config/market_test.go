@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -66,6 +67,34 @@ func TestMarketConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "bad decimals",
+			config: config.MarketConfig{
+				Name: "test",
+				CurrencyPairToMarketConfigs: map[string]config.CurrencyPairMarketConfig{
+					"BITCOIN/USD": {
+						Ticker:       "BTC/USD",
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						Decimals:     oracletypes.MaxDecimals + 1,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative max price age",
+			config: config.MarketConfig{
+				Name: "test",
+				CurrencyPairToMarketConfigs: map[string]config.CurrencyPairMarketConfig{
+					"BITCOIN/USD": {
+						Ticker:       "BTC/USD",
+						CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						MaxPriceAge:  -time.Second,
+					},
+				},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -86,3 +115,68 @@ func TestMarketConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestWithDecimals(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	pepe := oracletypes.NewCurrencyPair("PEPE", "USD")
+
+	market := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btc.String(): {
+				CurrencyPair: btc,
+			},
+			pepe.String(): {
+				CurrencyPair: pepe,
+				Decimals:     18,
+			},
+		},
+	}
+
+	newMarketConfig := func(decimals uint64) config.MarketConfig {
+		cfg := config.MarketConfig{
+			Name: "test",
+			CurrencyPairToMarketConfigs: map[string]config.CurrencyPairMarketConfig{
+				btc.String(): {
+					Ticker:       "BTC/USD",
+					CurrencyPair: btc,
+				},
+				pepe.String(): {
+					Ticker:       "PEPE/USD",
+					CurrencyPair: pepe,
+					Decimals:     decimals,
+				},
+			},
+		}
+		require.NoError(t, cfg.ValidateBasic())
+
+		return cfg
+	}
+
+	t.Run("decimals are set from the market config", func(t *testing.T) {
+		cfg := newMarketConfig(0)
+		updated, err := cfg.WithDecimals(market)
+		require.NoError(t, err)
+
+		btcConfig := updated.TickerToMarketConfigs["BTC/USD"]
+		require.Equal(t, 8, btcConfig.GetDecimals())
+		pepeConfig := updated.TickerToMarketConfigs["PEPE/USD"]
+		require.Equal(t, 18, pepeConfig.GetDecimals())
+		require.Equal(t, pepeConfig, updated.CurrencyPairToMarketConfigs[pepe.String()])
+
+		// The original market config is not modified.
+		require.Zero(t, cfg.CurrencyPairToMarketConfigs[pepe.String()].Decimals)
+		require.Zero(t, cfg.TickerToMarketConfigs["PEPE/USD"].Decimals)
+	})
+
+	t.Run("matching decimals are accepted", func(t *testing.T) {
+		cfg := newMarketConfig(18)
+		_, err := cfg.WithDecimals(market)
+		require.NoError(t, err)
+	})
+
+	t.Run("mismatched decimals are rejected", func(t *testing.T) {
+		cfg := newMarketConfig(8)
+		_, err := cfg.WithDecimals(market)
+		require.Error(t, err)
+	})
+}
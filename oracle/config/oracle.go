@@ -29,6 +29,28 @@ type OracleConfig struct {
 
 	// Metrics is the metrics configurations for the oracle.
 	Metrics MetricsConfig `mapstructure:"metrics" toml:"metrics"`
+
+	// CurrencyPairSync is the config for syncing the oracle's currency pairs with the currency
+	// pairs tracked by the x/oracle module on chain.
+	CurrencyPairSync CurrencyPairSyncConfig `mapstructure:"currency_pair_sync" toml:"currency_pair_sync"`
+
+	// Snapshot is the config for persisting the latest prices to disk such that they can be
+	// restored when the oracle restarts.
+	Snapshot SnapshotConfig `mapstructure:"snapshot" toml:"snapshot"`
+
+	// PriceHistory is the config for the bounded in-memory history of the prices reported by
+	// the oracle.
+	PriceHistory PriceHistoryConfig `mapstructure:"price_history" toml:"price_history"`
+
+	// Health is the config for the readiness checks of the oracle server.
+	Health HealthConfig `mapstructure:"health" toml:"health"`
+
+	// Admin is the config for the admin service of the oracle server.
+	Admin AdminConfig `mapstructure:"admin" toml:"admin"`
+
+	// TLS is the config for TLS on the oracle server. The oracle server can optionally require
+	// clients to present a certificate.
+	TLS TLSConfig `mapstructure:"tls" toml:"tls"`
 }
 
 // ValidateBasic performs basic validation on the oracle config.
@@ -47,22 +69,67 @@ func (c *OracleConfig) ValidateBasic() error {
 		return fmt.Errorf("market is not formatted correctly: %w", err)
 	}
 
-	return c.Metrics.ValidateBasic()
+	// The provider prices must be reported with the same decimals as the aggregated prices.
+	for _, p := range c.Providers {
+		if _, err := p.Market.WithDecimals(c.Market); err != nil {
+			return fmt.Errorf("provider %s market config is not formatted correctly: %w", p.Name, err)
+		}
+	}
+
+	if err := c.Metrics.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.CurrencyPairSync.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.Snapshot.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.PriceHistory.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.Health.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.Admin.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.TLS.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if c.TLS.Enabled && c.TLS.CertFile == "" {
+		return fmt.Errorf("tls cert file is required on the oracle server")
+	}
+
+	if c.Admin.Enabled && !c.TLS.Enabled {
+		return fmt.Errorf("tls must be enabled on the oracle server to enable the admin service")
+	}
+
+	return nil
 }
 
 // ReadOracleConfigFromFile reads a config from a file and returns the config.
 func ReadOracleConfigFromFile(path string) (OracleConfig, error) {
-	// Read in config file.
-	viper.SetConfigFile(path)
-	viper.SetConfigType("toml")
+	// Read in config file. A fresh viper instance is used so that the config can be safely
+	// re-read while the oracle is running i.e. when the config is hot-reloaded.
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
 
-	if err := viper.ReadInConfig(); err != nil {
+	if err := v.ReadInConfig(); err != nil {
 		return OracleConfig{}, err
 	}
 
 	// Unmarshal the config.
 	var config OracleConfig
-	if err := viper.Unmarshal(&config); err != nil {
+	if err := v.Unmarshal(&config); err != nil {
 		return OracleConfig{}, err
 	}
 
@@ -186,3 +186,23 @@ func TestOracleConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOracleConfigAdminRequiresTLS(t *testing.T) {
+	cfg := config.OracleConfig{
+		UpdateInterval: time.Second,
+		Admin: config.AdminConfig{
+			Enabled: true,
+			Token:   config.SecretConfig{Env: "ADMIN_TOKEN"},
+		},
+	}
+
+	// The admin token is sent with every request, so it must not be sent in cleartext.
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.TLS = config.TLSConfig{
+		Enabled:  true,
+		CertFile: "server.pem",
+		KeyFile:  "server-key.pem",
+	}
+	require.NoError(t, cfg.ValidateBasic())
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	// OutlierFilterNone disables outlier filtering. This can be used to disable filtering
+	// for a single feed when a default filter is configured for the market.
+	OutlierFilterNone = "none"
+
+	// OutlierFilterMAD rejects provider prices whose absolute deviation from the median
+	// exceeds threshold times the median absolute deviation (MAD) of all provider prices.
+	OutlierFilterMAD = "mad"
+
+	// OutlierFilterDeviation rejects provider prices whose deviation from the median exceeds
+	// threshold, expressed as a fraction of the median (i.e. 0.05 for 5%).
+	OutlierFilterDeviation = "deviation"
+)
+
+// OutlierFilterConfig defines how outlier provider prices are rejected before the median
+// price of a feed is calculated.
+type OutlierFilterConfig struct {
+	// Type is the type of outlier filter. Must be one of none, mad or deviation. If empty,
+	// the filter is inherited i.e. a feed without a filter uses the market's filter.
+	Type string `mapstructure:"type" toml:"type"`
+
+	// Threshold is the filter specific threshold at which a provider price is considered
+	// an outlier. For the mad filter, this is the number of median absolute deviations. For
+	// the deviation filter, this is the maximum fractional deviation from the median.
+	Threshold float64 `mapstructure:"threshold" toml:"threshold"`
+}
+
+// IsEnabled returns true if the config filters outliers.
+func (c *OutlierFilterConfig) IsEnabled() bool {
+	return c.Type != "" && c.Type != OutlierFilterNone
+}
+
+// ValidateBasic performs basic validation of the outlier filter config.
+func (c *OutlierFilterConfig) ValidateBasic() error {
+	switch c.Type {
+	case "", OutlierFilterNone:
+		return nil
+	case OutlierFilterMAD, OutlierFilterDeviation:
+	default:
+		return fmt.Errorf("unknown outlier filter type %s", c.Type)
+	}
+
+	if c.Threshold <= 0 {
+		return fmt.Errorf("outlier filter threshold must be positive; got %f", c.Threshold)
+	}
+
+	return nil
+}
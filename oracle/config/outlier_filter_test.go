@@ -0,0 +1,80 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestOutlierFilterConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.OutlierFilterConfig
+		expectedErr bool
+	}{
+		{
+			name:        "unset",
+			config:      config.OutlierFilterConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "disabled",
+			config: config.OutlierFilterConfig{
+				Type: config.OutlierFilterNone,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good mad config",
+			config: config.OutlierFilterConfig{
+				Type:      config.OutlierFilterMAD,
+				Threshold: 3,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good deviation config",
+			config: config.OutlierFilterConfig{
+				Type:      config.OutlierFilterDeviation,
+				Threshold: 0.05,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "unknown type",
+			config: config.OutlierFilterConfig{
+				Type:      "zscore",
+				Threshold: 3,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero threshold",
+			config: config.OutlierFilterConfig{
+				Type: config.OutlierFilterDeviation,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative threshold",
+			config: config.OutlierFilterConfig{
+				Type:      config.OutlierFilterMAD,
+				Threshold: -1,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
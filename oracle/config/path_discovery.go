@@ -0,0 +1,194 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// MaxConversionHops is the maximum number of feeds in a discovered conversion path.
+const MaxConversionHops = 4
+
+// PathDiscoveryConfig defines how the conversion paths of an aggregated feed are discovered from
+// the configured feeds instead of being listed by hand. The feeds form a graph of assets, where
+// each feed X/Y connects X to Y, and Y to X by inverting the price of the feed. Every path from the
+// base to the quote of the currency pair that uses at most MaxHops feeds and does not visit an
+// asset twice is used as a conversion path.
+type PathDiscoveryConfig struct {
+	// MaxHops is the maximum number of feeds in a discovered conversion path. If zero, conversion
+	// paths are not discovered.
+	MaxHops uint64 `mapstructure:"max_hops" toml:"max_hops"`
+
+	// Allow is the list of feeds (i.e. BTC/USDT) that may be used in the discovered conversion
+	// paths. If empty, all feeds may be used.
+	Allow []string `mapstructure:"allow" toml:"allow,omitempty"`
+
+	// Deny is the list of feeds that must not be used in the discovered conversion paths.
+	Deny []string `mapstructure:"deny" toml:"deny,omitempty"`
+}
+
+// IsEnabled returns true if the config discovers conversion paths. A nil config is disabled.
+func (c *PathDiscoveryConfig) IsEnabled() bool {
+	return c != nil && c.MaxHops > 0
+}
+
+// ValidateBasic performs basic validation of the path discovery config. A nil config is valid.
+func (c *PathDiscoveryConfig) ValidateBasic() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.MaxHops > MaxConversionHops {
+		return fmt.Errorf("max hops cannot exceed %d; got %d", MaxConversionHops, c.MaxHops)
+	}
+
+	for _, feed := range append(slices.Clone(c.Allow), c.Deny...) {
+		if _, err := oracletypes.CurrencyPairFromString(feed); err != nil {
+			return fmt.Errorf("invalid feed %s: %w", feed, err)
+		}
+	}
+
+	return nil
+}
+
+// GetConversions returns the conversion paths of the given currency pair. If path discovery is
+// enabled for the aggregated feed, the discovered conversion paths are returned. Otherwise, the
+// configured conversions are returned.
+func (c *AggregateMarketConfig) GetConversions(cp oracletypes.CurrencyPair) []Conversions {
+	feed, ok := c.AggregatedFeeds[cp.String()]
+	if !ok {
+		return nil
+	}
+
+	if feed.PathDiscovery.IsEnabled() {
+		return c.DiscoverConversions(cp, *feed.PathDiscovery)
+	}
+
+	return feed.Conversions
+}
+
+// DiscoverConversions enumerates every conversion path from the base to the quote of the given
+// currency pair through the feeds of the market config, subject to the path discovery config. The
+// paths are sorted by the number of feeds they use, and then by their string representation.
+func (c *AggregateMarketConfig) DiscoverConversions(
+	cp oracletypes.CurrencyPair,
+	discovery PathDiscoveryConfig,
+) []Conversions {
+	allowed := feedSet(discovery.Allow)
+	denied := feedSet(discovery.Deny)
+
+	// Build the asset graph. Each feed can be traversed from its base to its quote, or from its
+	// quote to its base by inverting the price.
+	edges := make(map[string][]Conversion)
+	for _, feed := range c.Feeds {
+		feedCP := feed.CurrencyPair
+		if _, ok := denied[feedCP]; ok {
+			continue
+		}
+
+		if _, ok := allowed[feedCP]; len(allowed) > 0 && !ok {
+			continue
+		}
+
+		edges[feedCP.Base] = append(edges[feedCP.Base], Conversion{CurrencyPair: feedCP})
+		edges[feedCP.Quote] = append(edges[feedCP.Quote], Conversion{CurrencyPair: feedCP, Invert: true})
+	}
+
+	// Sort the edges so that the discovered paths do not depend on the map iteration order.
+	for _, conversions := range edges {
+		slices.SortFunc(conversions, compareConversion)
+	}
+
+	paths := make([]Conversions, 0)
+	path := make(Conversions, 0, discovery.MaxHops)
+	visited := map[string]bool{cp.Base: true}
+
+	var walk func(asset string)
+	walk = func(asset string) {
+		if asset == cp.Quote {
+			paths = append(paths, slices.Clone(path))
+			return
+		}
+
+		if uint64(len(path)) == discovery.MaxHops {
+			return
+		}
+
+		for _, edge := range edges[asset] {
+			next := edge.Output()
+			if visited[next] {
+				continue
+			}
+
+			visited[next] = true
+			path = append(path, edge)
+			walk(next)
+			path = path[:len(path)-1]
+			visited[next] = false
+		}
+	}
+	walk(cp.Base)
+
+	slices.SortStableFunc(paths, func(a, b Conversions) int {
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return paths
+}
+
+// Output returns the asset that the conversion converts to i.e. the quote of the feed, or the
+// base if the feed is inverted.
+func (c Conversion) Output() string {
+	if c.Invert {
+		return c.CurrencyPair.Base
+	}
+
+	return c.CurrencyPair.Quote
+}
+
+// String returns the conversion path as a string i.e. BTC/USDT -> invert(USD/USDT).
+func (c Conversions) String() string {
+	feeds := make([]string, len(c))
+	for i, conversion := range c {
+		feeds[i] = conversion.CurrencyPair.String()
+		if conversion.Invert {
+			feeds[i] = fmt.Sprintf("invert(%s)", feeds[i])
+		}
+	}
+
+	return strings.Join(feeds, " -> ")
+}
+
+// compareConversion orders conversions by currency pair, with the inverted conversion last.
+func compareConversion(a, b Conversion) int {
+	if cmp := strings.Compare(a.CurrencyPair.String(), b.CurrencyPair.String()); cmp != 0 {
+		return cmp
+	}
+
+	switch {
+	case a.Invert == b.Invert:
+		return 0
+	case a.Invert:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// feedSet returns the set of currency pairs in the given list of feeds. Invalid feeds are ignored.
+func feedSet(feeds []string) map[oracletypes.CurrencyPair]struct{} {
+	set := make(map[oracletypes.CurrencyPair]struct{}, len(feeds))
+	for _, feed := range feeds {
+		if cp, err := oracletypes.CurrencyPairFromString(feed); err == nil {
+			set[cp] = struct{}{}
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,167 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestDiscoverConversions(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	btcUSDT := oracletypes.NewCurrencyPair("BITCOIN", "USDT")
+	usdUSDT := oracletypes.NewCurrencyPair("USD", "USDT")
+	ethBTC := oracletypes.NewCurrencyPair("ETHEREUM", "BITCOIN")
+	ethUSD := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+	ethUSDT := oracletypes.NewCurrencyPair("ETHEREUM", "USDT")
+
+	market := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btcUSD.String():  {CurrencyPair: btcUSD},
+			btcUSDT.String(): {CurrencyPair: btcUSDT},
+			usdUSDT.String(): {CurrencyPair: usdUSDT},
+			ethBTC.String():  {CurrencyPair: ethBTC},
+			ethUSDT.String(): {CurrencyPair: ethUSDT},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		cp        oracletypes.CurrencyPair
+		discovery config.PathDiscoveryConfig
+		expected  []string
+	}{
+		{
+			name:      "direct feed only",
+			cp:        btcUSD,
+			discovery: config.PathDiscoveryConfig{MaxHops: 1},
+			expected: []string{
+				"BITCOIN/USD",
+			},
+		},
+		{
+			name:      "paths up to two hops",
+			cp:        btcUSD,
+			discovery: config.PathDiscoveryConfig{MaxHops: 2},
+			expected: []string{
+				"BITCOIN/USD",
+				"BITCOIN/USDT -> invert(USD/USDT)",
+			},
+		},
+		{
+			name:      "paths up to three hops",
+			cp:        ethUSD,
+			discovery: config.PathDiscoveryConfig{MaxHops: 3},
+			expected: []string{
+				"ETHEREUM/BITCOIN -> BITCOIN/USD",
+				"ETHEREUM/USDT -> invert(USD/USDT)",
+				"ETHEREUM/BITCOIN -> BITCOIN/USDT -> invert(USD/USDT)",
+				"ETHEREUM/USDT -> invert(BITCOIN/USDT) -> BITCOIN/USD",
+			},
+		},
+		{
+			name: "denied feeds are not used",
+			cp:   ethUSD,
+			discovery: config.PathDiscoveryConfig{
+				MaxHops: 3,
+				Deny:    []string{"bitcoin/usd"},
+			},
+			expected: []string{
+				"ETHEREUM/USDT -> invert(USD/USDT)",
+				"ETHEREUM/BITCOIN -> BITCOIN/USDT -> invert(USD/USDT)",
+			},
+		},
+		{
+			name: "only allowed feeds are used",
+			cp:   ethUSD,
+			discovery: config.PathDiscoveryConfig{
+				MaxHops: 3,
+				Allow:   []string{"ETHEREUM/BITCOIN", "BITCOIN/USD", "ETHEREUM/USDT"},
+			},
+			expected: []string{
+				"ETHEREUM/BITCOIN -> BITCOIN/USD",
+			},
+		},
+		{
+			name:      "inverted currency pair",
+			cp:        oracletypes.NewCurrencyPair("USD", "BITCOIN"),
+			discovery: config.PathDiscoveryConfig{MaxHops: 2},
+			expected: []string{
+				"invert(BITCOIN/USD)",
+				"USD/USDT -> invert(BITCOIN/USDT)",
+			},
+		},
+		{
+			name:      "no paths",
+			cp:        oracletypes.NewCurrencyPair("COSMOS", "USD"),
+			discovery: config.PathDiscoveryConfig{MaxHops: 3},
+			expected:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paths := market.DiscoverConversions(tc.cp, tc.discovery)
+
+			actual := make([]string, len(paths))
+			for i, path := range paths {
+				actual[i] = path.String()
+				require.NoError(t, config.CheckSort(tc.cp, path))
+			}
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestGetConversions(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	btcUSDT := oracletypes.NewCurrencyPair("BITCOIN", "USDT")
+	usdtUSD := oracletypes.NewCurrencyPair("USDT", "USD")
+	ethUSD := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	manual := []config.Conversions{
+		{
+			{CurrencyPair: btcUSD},
+		},
+	}
+
+	market := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btcUSD.String():  {CurrencyPair: btcUSD},
+			btcUSDT.String(): {CurrencyPair: btcUSDT},
+			usdtUSD.String(): {CurrencyPair: usdtUSD},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btcUSD.String(): {
+				CurrencyPair: btcUSD,
+				Conversions:  manual,
+			},
+			usdtUSD.String(): {
+				CurrencyPair: usdtUSD,
+				PathDiscovery: &config.PathDiscoveryConfig{
+					MaxHops: 2,
+				},
+			},
+		},
+	}
+	require.NoError(t, market.ValidateBasic())
+
+	// The configured conversions are used if path discovery is disabled.
+	require.Equal(t, manual, market.GetConversions(btcUSD))
+
+	// The discovered conversions are used if path discovery is enabled.
+	require.Equal(t, []config.Conversions{
+		{
+			{CurrencyPair: usdtUSD},
+		},
+		{
+			{CurrencyPair: btcUSDT, Invert: true},
+			{CurrencyPair: btcUSD},
+		},
+	}, market.GetConversions(usdtUSD))
+
+	// Currency pairs without an aggregated feed have no conversions.
+	require.Empty(t, market.GetConversions(ethUSD))
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// PriceHistoryConfig is the config for the bounded in-memory history of the prices reported by the
+// oracle. When enabled, the oracle records the reported prices, along with the provider prices they
+// were derived from, on every update. Entries are evicted once the history holds more than the max
+// number of entries or once they are older than the max age.
+type PriceHistoryConfig struct {
+	// Enabled indicates whether the price history should be recorded.
+	Enabled bool `mapstructure:"enabled" toml:"enabled"`
+
+	// MaxEntries is the maximum number of oracle updates that are kept in the history.
+	MaxEntries uint64 `mapstructure:"max_entries" toml:"max_entries"`
+
+	// MaxAge is the maximum age of the oracle updates that are kept in the history.
+	MaxAge time.Duration `mapstructure:"max_age" toml:"max_age"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *PriceHistoryConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MaxEntries == 0 {
+		return fmt.Errorf("price history max entries must be strictly positive")
+	}
+
+	if c.MaxAge <= 0 {
+		return fmt.Errorf("price history max age must be strictly positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestPriceHistoryConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.PriceHistoryConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config with price history enabled",
+			config: config.PriceHistoryConfig{
+				Enabled:    true,
+				MaxEntries: 100,
+				MaxAge:     time.Minute,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "price history not enabled",
+			config: config.PriceHistoryConfig{
+				Enabled: false,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with no max entries",
+			config: config.PriceHistoryConfig{
+				Enabled: true,
+				MaxAge:  time.Minute,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no max age",
+			config: config.PriceHistoryConfig{
+				Enabled:    true,
+				MaxEntries: 100,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
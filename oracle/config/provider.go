@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"time"
+
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
 
 // ProviderConfig defines a config for a provider. To add a new provider, add the provider
@@ -21,6 +24,11 @@ type ProviderConfig struct {
 	// Market defines the provider's market configurations. In particular, this defines
 	// the mappings between on-chain and off-chain currency pairs.
 	Market MarketConfig `mapstructure:"market_config" toml:"market_config"`
+
+	// MaxPriceAge is the maximum age of a price reported by the provider for it to be
+	// included in the aggregated price. This can be overridden per currency pair in the
+	// market config. If zero, the oracle update interval is used.
+	MaxPriceAge time.Duration `mapstructure:"max_price_age" toml:"max_price_age,omitzero"`
 }
 
 func (c *ProviderConfig) ValidateBasic() error {
@@ -56,6 +64,10 @@ func (c *ProviderConfig) ValidateBasic() error {
 		}
 	}
 
+	if c.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age for %s cannot be negative", c.Name)
+	}
+
 	if err := c.Market.ValidateBasic(); err != nil {
 		return fmt.Errorf("market config for %s is not formatted correctly: %w", c.Name, err)
 	}
@@ -66,3 +78,18 @@ func (c *ProviderConfig) ValidateBasic() error {
 
 	return nil
 }
+
+// GetMaxPriceAge returns the maximum age of a price reported by the provider for the given
+// currency pair. The currency pair's max price age takes precedence over the provider's. If
+// neither is set, the given default is returned.
+func (c *ProviderConfig) GetMaxPriceAge(cp oracletypes.CurrencyPair, defaultAge time.Duration) time.Duration {
+	if marketConfig, ok := c.Market.CurrencyPairToMarketConfigs[cp.String()]; ok && marketConfig.MaxPriceAge > 0 {
+		return marketConfig.MaxPriceAge
+	}
+
+	if c.MaxPriceAge > 0 {
+		return c.MaxPriceAge
+	}
+
+	return defaultAge
+}
@@ -280,6 +280,32 @@ func TestProviderConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "negative max price age",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:    true,
+					Timeout:    time.Second,
+					Interval:   time.Second,
+					MaxQueries: 1,
+					Name:       "test",
+					Atomic:     true,
+					URL:        "http://test.com",
+				},
+				Name:        "test",
+				MaxPriceAge: -time.Second,
+				Market: config.MarketConfig{
+					Name: "test",
+					CurrencyPairToMarketConfigs: map[string]config.CurrencyPairMarketConfig{
+						"BITCOIN/USD": {
+							Ticker:       "BTC/USD",
+							CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+						},
+					},
+				},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -293,3 +319,40 @@ func TestProviderConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMaxPriceAge(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+	atom := oracletypes.NewCurrencyPair("COSMOS", "USD")
+
+	cfg := config.ProviderConfig{
+		Name:        "test",
+		MaxPriceAge: 30 * time.Second,
+		Market: config.MarketConfig{
+			Name: "test",
+			CurrencyPairToMarketConfigs: map[string]config.CurrencyPairMarketConfig{
+				btc.String(): {
+					Ticker:       "BTC/USD",
+					CurrencyPair: btc,
+					MaxPriceAge:  5 * time.Second,
+				},
+				eth.String(): {
+					Ticker:       "ETH/USD",
+					CurrencyPair: eth,
+				},
+			},
+		},
+	}
+
+	// The currency pair's max price age takes precedence.
+	require.Equal(t, 5*time.Second, cfg.GetMaxPriceAge(btc, time.Second))
+
+	// The provider's max price age is used if the currency pair does not set one.
+	require.Equal(t, 30*time.Second, cfg.GetMaxPriceAge(eth, time.Second))
+	require.Equal(t, 30*time.Second, cfg.GetMaxPriceAge(atom, time.Second))
+
+	// The default is used if neither is set.
+	cfg.MaxPriceAge = 0
+	require.Equal(t, time.Second, cfg.GetMaxPriceAge(eth, time.Second))
+	require.Equal(t, 5*time.Second, cfg.GetMaxPriceAge(btc, time.Second))
+}
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultInitialBackoff is the default delay before the first retry of a request.
+	DefaultInitialBackoff = 100 * time.Millisecond
+
+	// DefaultMaxBackoff is the default maximum delay between retries of a request.
+	DefaultMaxBackoff = 2 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes that are retried by default.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RateLimitConfig is the config for the request budget and the retries of an API based provider.
+// Regardless of this config, the provider honours the Retry-After and X-RateLimit-* headers
+// returned by the API, and stops sending requests until the API accepts requests again. The zero
+// value disables the request budget and retries.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the number of requests per second that the provider can send to the
+	// API, shared across all of its requests. If zero, the requests are not limited.
+	RequestsPerSecond float64 `mapstructure:"requests_per_second" toml:"requests_per_second"`
+
+	// Burst is the number of requests that the provider can send at once. If zero, this is one.
+	Burst int `mapstructure:"burst" toml:"burst"`
+
+	// MaxRetries is the maximum number of times a request is retried if the API responds with
+	// a retryable status code. If zero, requests are not retried. Retries must complete within
+	// the timeout of the provider.
+	MaxRetries int `mapstructure:"max_retries" toml:"max_retries"`
+
+	// InitialBackoff is the delay before the first retry of a request, which doubles with each
+	// retry up to MaxBackoff. Each delay is jittered. If zero, DefaultInitialBackoff is used.
+	InitialBackoff time.Duration `mapstructure:"initial_backoff" toml:"initial_backoff"`
+
+	// MaxBackoff is the maximum delay between retries of a request. If zero, DefaultMaxBackoff
+	// is used.
+	MaxBackoff time.Duration `mapstructure:"max_backoff" toml:"max_backoff"`
+
+	// RetryableStatusCodes are the HTTP status codes that are retried. If empty,
+	// DefaultRetryableStatusCodes are retried.
+	RetryableStatusCodes []int `mapstructure:"retryable_status_codes" toml:"retryable_status_codes"`
+}
+
+// ValidateBasic performs basic validation of the rate limit config.
+func (c *RateLimitConfig) ValidateBasic() error {
+	if c.RequestsPerSecond < 0 {
+		return fmt.Errorf("rate limit requests per second cannot be negative")
+	}
+
+	if c.Burst < 0 {
+		return fmt.Errorf("rate limit burst cannot be negative")
+	}
+
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("rate limit max retries cannot be negative")
+	}
+
+	if c.InitialBackoff < 0 || c.MaxBackoff < 0 {
+		return fmt.Errorf("rate limit backoff cannot be negative")
+	}
+
+	if c.GetMaxBackoff() < c.GetInitialBackoff() {
+		return fmt.Errorf("rate limit max backoff must be at least the initial backoff")
+	}
+
+	for _, code := range c.RetryableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid retryable status code %d", code)
+		}
+	}
+
+	return nil
+}
+
+// GetBurst returns the number of requests that can be sent at once.
+func (c *RateLimitConfig) GetBurst() int {
+	if c.Burst == 0 {
+		return 1
+	}
+
+	return c.Burst
+}
+
+// GetInitialBackoff returns the delay before the first retry of a request.
+func (c *RateLimitConfig) GetInitialBackoff() time.Duration {
+	if c.InitialBackoff == 0 {
+		return DefaultInitialBackoff
+	}
+
+	return c.InitialBackoff
+}
+
+// GetMaxBackoff returns the maximum delay between retries of a request.
+func (c *RateLimitConfig) GetMaxBackoff() time.Duration {
+	if c.MaxBackoff == 0 {
+		return DefaultMaxBackoff
+	}
+
+	return c.MaxBackoff
+}
+
+// IsRetryable returns true if a response with the given status code should be retried.
+func (c *RateLimitConfig) IsRetryable(code int) bool {
+	if len(c.RetryableStatusCodes) == 0 {
+		return slices.Contains(DefaultRetryableStatusCodes, code)
+	}
+
+	return slices.Contains(c.RetryableStatusCodes, code)
+}
@@ -0,0 +1,121 @@
+package config_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestRateLimitConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.RateLimitConfig
+		expectedErr bool
+	}{
+		{
+			name:        "good config with no rate limit",
+			config:      config.RateLimitConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config with all fields set",
+			config: config.RateLimitConfig{
+				RequestsPerSecond:    0.5,
+				Burst:                2,
+				MaxRetries:           3,
+				InitialBackoff:       50 * time.Millisecond,
+				MaxBackoff:           time.Second,
+				RetryableStatusCodes: []int{http.StatusTooManyRequests},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with negative requests per second",
+			config: config.RateLimitConfig{
+				RequestsPerSecond: -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative burst",
+			config: config.RateLimitConfig{
+				Burst: -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative max retries",
+			config: config.RateLimitConfig{
+				MaxRetries: -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative backoff",
+			config: config.RateLimitConfig{
+				InitialBackoff: -time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with max backoff less than the initial backoff",
+			config: config.RateLimitConfig{
+				InitialBackoff: time.Second,
+				MaxBackoff:     time.Millisecond,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with initial backoff greater than the default max backoff",
+			config: config.RateLimitConfig{
+				InitialBackoff: config.DefaultMaxBackoff + time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with invalid retryable status code",
+			config: config.RateLimitConfig{
+				RetryableStatusCodes: []int{600},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRateLimitConfigDefaults(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		cfg := config.RateLimitConfig{}
+
+		require.Equal(t, 1, cfg.GetBurst())
+		require.Equal(t, config.DefaultInitialBackoff, cfg.GetInitialBackoff())
+		require.Equal(t, config.DefaultMaxBackoff, cfg.GetMaxBackoff())
+		for _, code := range config.DefaultRetryableStatusCodes {
+			require.True(t, cfg.IsRetryable(code))
+		}
+		require.False(t, cfg.IsRetryable(http.StatusNotFound))
+	})
+
+	t.Run("custom retryable status codes", func(t *testing.T) {
+		cfg := config.RateLimitConfig{
+			RetryableStatusCodes: []int{http.StatusTooManyRequests},
+		}
+
+		require.True(t, cfg.IsRetryable(http.StatusTooManyRequests))
+		require.False(t, cfg.IsRetryable(http.StatusServiceUnavailable))
+	})
+}
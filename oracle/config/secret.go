@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// RedactedSecret is the value that secrets are replaced with in logs and config dumps.
+const RedactedSecret = "[REDACTED]"
+
+// Secret is a secret value (i.e. an API key) that is redacted whenever it is formatted, logged or
+// marshalled. The underlying value can only be read with Value.
+type Secret string
+
+// Value returns the underlying value of the secret.
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// String returns the redacted secret.
+func (s Secret) String() string {
+	return RedactedSecret
+}
+
+// GoString returns the redacted secret.
+func (s Secret) GoString() string {
+	return RedactedSecret
+}
+
+// MarshalText returns the redacted secret, such that the secret is redacted when it is marshalled
+// to JSON or TOML.
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(RedactedSecret), nil
+}
+
+// SecretConfig references a secret that is read from an environment variable or a file, such that
+// the secret itself is not stored in the config.
+type SecretConfig struct {
+	// Env is the name of the environment variable that contains the secret.
+	Env string `mapstructure:"env" toml:"env"`
+
+	// File is the path to the file that contains the secret. Leading and trailing whitespace
+	// is trimmed from the contents of the file.
+	File string `mapstructure:"file" toml:"file"`
+}
+
+// ValidateBasic performs basic validation of the secret config.
+func (c *SecretConfig) ValidateBasic() error {
+	if (c.Env == "") == (c.File == "") {
+		return fmt.Errorf("secret must be read from exactly one of an environment variable or a file")
+	}
+
+	return nil
+}
+
+// Read reads the secret from the environment variable or the file. This returns an error if the
+// secret is empty.
+func (c *SecretConfig) Read() (Secret, error) {
+	if err := c.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	if c.Env != "" {
+		value, ok := os.LookupEnv(c.Env)
+		if !ok || value == "" {
+			return "", fmt.Errorf("secret environment variable %s is not set", c.Env)
+		}
+
+		return Secret(value), nil
+	}
+
+	bz, err := os.ReadFile(c.File)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+
+	value := strings.TrimSpace(string(bz))
+	if value == "" {
+		return "", fmt.Errorf("secret file %s is empty", c.File)
+	}
+
+	return Secret(value), nil
+}
@@ -0,0 +1,99 @@
+package config_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestSecretConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "secret.txt")
+	require.NoError(t, os.WriteFile(file, []byte("file-secret\n"), 0o600))
+
+	emptyFile := filepath.Join(dir, "empty.txt")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("  \n"), 0o600))
+
+	t.Setenv("SLINKY_TEST_SECRET", "env-secret")
+	t.Setenv("SLINKY_TEST_EMPTY_SECRET", "")
+
+	testCases := []struct {
+		name        string
+		config      config.SecretConfig
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "secret from environment variable",
+			config:   config.SecretConfig{Env: "SLINKY_TEST_SECRET"},
+			expected: "env-secret",
+		},
+		{
+			name:     "secret from file",
+			config:   config.SecretConfig{File: file},
+			expected: "file-secret",
+		},
+		{
+			name:        "no source",
+			config:      config.SecretConfig{},
+			expectedErr: true,
+		},
+		{
+			name:        "both sources",
+			config:      config.SecretConfig{Env: "SLINKY_TEST_SECRET", File: file},
+			expectedErr: true,
+		},
+		{
+			name:        "unset environment variable",
+			config:      config.SecretConfig{Env: "SLINKY_TEST_UNSET_SECRET"},
+			expectedErr: true,
+		},
+		{
+			name:        "empty environment variable",
+			config:      config.SecretConfig{Env: "SLINKY_TEST_EMPTY_SECRET"},
+			expectedErr: true,
+		},
+		{
+			name:        "missing file",
+			config:      config.SecretConfig{File: filepath.Join(dir, "missing.txt")},
+			expectedErr: true,
+		},
+		{
+			name:        "empty file",
+			config:      config.SecretConfig{File: emptyFile},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret, err := tc.config.Read()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, secret.Value())
+		})
+	}
+}
+
+func TestSecretRedaction(t *testing.T) {
+	secret := config.Secret("super-secret")
+
+	require.Equal(t, config.RedactedSecret, secret.String())
+	require.Equal(t, config.RedactedSecret, fmt.Sprintf("%v", secret))
+	require.Equal(t, config.RedactedSecret, fmt.Sprintf("%#v", secret))
+
+	bz, err := json.Marshal(struct{ Key config.Secret }{Key: secret})
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "super-secret")
+}
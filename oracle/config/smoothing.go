@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// SmoothingNone disables smoothing. The latest aggregated price is reported.
+	SmoothingNone = "none"
+
+	// SmoothingTWAP reports the time-weighted average of the aggregated prices over the
+	// window.
+	SmoothingTWAP = "twap"
+
+	// SmoothingEMA reports the exponential moving average of the aggregated prices, where
+	// the window is the time constant of the average.
+	SmoothingEMA = "ema"
+)
+
+// SmoothingConfig defines how the aggregated price of a currency pair is smoothed before it
+// is reported by the oracle. This is useful for currency pairs with thin markets whose spot
+// price jitters from one oracle update to the next.
+type SmoothingConfig struct {
+	// Type is the type of smoothing. Must be one of none, twap or ema. If empty, smoothing
+	// is disabled.
+	Type string `mapstructure:"type" toml:"type"`
+
+	// Window is the smoothing window. For the twap smoothing, this is the duration over which
+	// the time-weighted average is calculated. For the ema smoothing, this is the time constant
+	// of the average i.e. the weight of a price decays by a factor of e every window.
+	Window time.Duration `mapstructure:"window" toml:"window"`
+}
+
+// IsEnabled returns true if the config smooths prices.
+func (c *SmoothingConfig) IsEnabled() bool {
+	return c.Type != "" && c.Type != SmoothingNone
+}
+
+// ValidateBasic performs basic validation of the smoothing config.
+func (c *SmoothingConfig) ValidateBasic() error {
+	switch c.Type {
+	case "", SmoothingNone:
+		return nil
+	case SmoothingTWAP, SmoothingEMA:
+	default:
+		return fmt.Errorf("unknown smoothing type %s", c.Type)
+	}
+
+	if c.Window <= 0 {
+		return fmt.Errorf("smoothing window must be positive; got %s", c.Window)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestSmoothingConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.SmoothingConfig
+		expectedErr bool
+	}{
+		{
+			name:        "unset",
+			config:      config.SmoothingConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "disabled",
+			config: config.SmoothingConfig{
+				Type: config.SmoothingNone,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good twap config",
+			config: config.SmoothingConfig{
+				Type:   config.SmoothingTWAP,
+				Window: time.Minute,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good ema config",
+			config: config.SmoothingConfig{
+				Type:   config.SmoothingEMA,
+				Window: 30 * time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "unknown type",
+			config: config.SmoothingConfig{
+				Type:   "vwap",
+				Window: time.Minute,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero window",
+			config: config.SmoothingConfig{
+				Type: config.SmoothingTWAP,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative window",
+			config: config.SmoothingConfig{
+				Type:   config.SmoothingEMA,
+				Window: -time.Second,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// SnapshotConfig is the config for persisting the latest provider prices and aggregated prices to
+// disk. When enabled, the oracle periodically writes a snapshot of its prices to the given path and
+// restores the snapshot on startup, such that prices are available before the providers have
+// fetched new prices. Restored prices are only served while they are within the max price age.
+type SnapshotConfig struct {
+	// Enabled indicates whether the snapshot should be written and restored.
+	Enabled bool `mapstructure:"enabled" toml:"enabled"`
+
+	// Path is the path of the snapshot file.
+	Path string `mapstructure:"path" toml:"path"`
+
+	// Interval is the interval at which the snapshot is written.
+	Interval time.Duration `mapstructure:"interval" toml:"interval"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *SnapshotConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if len(c.Path) == 0 {
+		return fmt.Errorf("must supply a non-empty snapshot path if the snapshot is enabled")
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("snapshot interval must be strictly positive")
+	}
+
+	return nil
+}
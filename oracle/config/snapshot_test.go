@@ -0,0 +1,62 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestSnapshotConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.SnapshotConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config with snapshot enabled",
+			config: config.SnapshotConfig{
+				Enabled:  true,
+				Path:     "oracle_snapshot.json",
+				Interval: 10 * time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "snapshot not enabled",
+			config: config.SnapshotConfig{
+				Enabled: false,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with no path",
+			config: config.SnapshotConfig{
+				Enabled:  true,
+				Interval: 10 * time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no interval",
+			config: config.SnapshotConfig{
+				Enabled: true,
+				Path:    "oracle_snapshot.json",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// TLSConfig is the config for TLS on the connection between the application and the oracle
+// sidecar. The same config is used on both sides of the connection:
+//
+//   - On the oracle sidecar, the certificate is served to clients and the CA is used to verify
+//     client certificates. If RequireClientCert is set, clients (i.e. validators) must present a
+//     certificate signed by the CA, such that only trusted clients can query prices.
+//   - On the application, the CA is used to verify the certificate of the oracle sidecar, and the
+//     certificate is presented to the oracle sidecar as a client certificate if it requires one.
+type TLSConfig struct {
+	// Enabled is a flag that indicates whether TLS is enabled.
+	Enabled bool `mapstructure:"enabled" toml:"enabled"`
+
+	// CertFile is the path to the PEM encoded certificate. This is required on the oracle
+	// sidecar, and optional on the application.
+	CertFile string `mapstructure:"cert_file" toml:"cert_file"`
+
+	// KeyFile is the path to the PEM encoded private key of the certificate.
+	KeyFile string `mapstructure:"key_file" toml:"key_file"`
+
+	// CAFile is the path to the PEM encoded certificate authorities that are used to verify the
+	// certificate of the other side of the connection. If empty, the application verifies the
+	// certificate of the oracle sidecar with the system certificate authorities.
+	CAFile string `mapstructure:"ca_file" toml:"ca_file"`
+
+	// RequireClientCert is a flag that indicates whether the oracle sidecar requires clients of
+	// the oracle service to present a certificate signed by the CA. The health endpoints do not
+	// require a certificate. This is ignored on the application.
+	RequireClientCert bool `mapstructure:"require_client_cert" toml:"require_client_cert"`
+
+	// ServerName is the optional name that the certificate of the oracle sidecar is verified
+	// against. If empty, the host of the oracle address is used. This is ignored on the oracle
+	// sidecar.
+	ServerName string `mapstructure:"server_name" toml:"server_name"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *TLSConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return fmt.Errorf("tls cert file and key file must be set together")
+	}
+
+	if c.RequireClientCert && c.CAFile == "" {
+		return fmt.Errorf("tls ca file is required to verify client certificates")
+	}
+
+	return nil
+}
+
+// ServerTLSConfig returns the TLS config of the oracle sidecar. This loads the certificate, and
+// the CA that client certificates are verified against if they are given. Client certificates
+// are never required at the TLS layer, since the same listener serves the health endpoints; the
+// oracle server enforces RequireClientCert on the oracle service itself.
+func (c *TLSConfig) ServerTLSConfig() (*tls.Config, error) {
+	if err := c.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if c.CertFile == "" {
+		return nil, fmt.Errorf("tls cert file is required on the oracle server")
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tls certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.CAFile != "" {
+		if cfg.ClientCAs, err = loadCertPool(c.CAFile); err != nil {
+			return nil, err
+		}
+
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return cfg, nil
+}
+
+// ClientTLSConfig returns the TLS config of the application. This loads the CA, and the
+// certificate if one is configured.
+func (c *TLSConfig) ClientTLSConfig() (*tls.Config, error) {
+	if err := c.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		ServerName: c.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls certificate: %w", err)
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.CAFile != "" {
+		pool, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.RootCAs = pool
+	}
+
+	return cfg, nil
+}
+
+// loadCertPool loads the PEM encoded certificate authorities at the given path.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tls ca file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("tls ca file %s does not contain any certificates", path)
+	}
+
+	return pool, nil
+}
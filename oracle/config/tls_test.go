@@ -0,0 +1,187 @@
+package config_test
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/config/testutils"
+)
+
+func TestTLSConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.TLSConfig
+		expectedErr bool
+	}{
+		{
+			name: "good server config",
+			config: config.TLSConfig{
+				Enabled:           true,
+				CertFile:          "server.pem",
+				KeyFile:           "server-key.pem",
+				CAFile:            "ca.pem",
+				RequireClientCert: true,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good client config without a certificate",
+			config: config.TLSConfig{
+				Enabled: true,
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "disabled config",
+			config:      config.TLSConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with cert file and no key file",
+			config: config.TLSConfig{
+				Enabled:  true,
+				CertFile: "server.pem",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with key file and no cert file",
+			config: config.TLSConfig{
+				Enabled: true,
+				KeyFile: "server-key.pem",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config requiring client certificates with no ca file",
+			config: config.TLSConfig{
+				Enabled:           true,
+				CertFile:          "server.pem",
+				KeyFile:           "server-key.pem",
+				RequireClientCert: true,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	files := testutils.CreateTLSFiles(t)
+
+	t.Run("verifies client certificates if given when they are required", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled:           true,
+			CertFile:          files.ServerCertFile,
+			KeyFile:           files.ServerKeyFile,
+			CAFile:            files.CAFile,
+			RequireClientCert: true,
+		}
+
+		tlsCfg, err := cfg.ServerTLSConfig()
+		require.NoError(t, err)
+		require.Len(t, tlsCfg.Certificates, 1)
+		require.NotNil(t, tlsCfg.ClientCAs)
+		require.Equal(t, tls.VerifyClientCertIfGiven, tlsCfg.ClientAuth)
+	})
+
+	t.Run("verifies client certificates if given", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled:  true,
+			CertFile: files.ServerCertFile,
+			KeyFile:  files.ServerKeyFile,
+			CAFile:   files.CAFile,
+		}
+
+		tlsCfg, err := cfg.ServerTLSConfig()
+		require.NoError(t, err)
+		require.Equal(t, tls.VerifyClientCertIfGiven, tlsCfg.ClientAuth)
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled: true,
+		}
+
+		_, err := cfg.ServerTLSConfig()
+		require.Error(t, err)
+	})
+
+	t.Run("missing certificate", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled:  true,
+			CertFile: "missing.pem",
+			KeyFile:  files.ServerKeyFile,
+		}
+
+		_, err := cfg.ServerTLSConfig()
+		require.Error(t, err)
+	})
+
+	t.Run("ca file without certificates", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled:  true,
+			CertFile: files.ServerCertFile,
+			KeyFile:  files.ServerKeyFile,
+			CAFile:   files.ServerKeyFile,
+		}
+
+		_, err := cfg.ServerTLSConfig()
+		require.Error(t, err)
+	})
+}
+
+func TestClientTLSConfig(t *testing.T) {
+	files := testutils.CreateTLSFiles(t)
+
+	t.Run("with a client certificate", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled:    true,
+			CertFile:   files.ClientCertFile,
+			KeyFile:    files.ClientKeyFile,
+			CAFile:     files.CAFile,
+			ServerName: "oracle",
+		}
+
+		tlsCfg, err := cfg.ClientTLSConfig()
+		require.NoError(t, err)
+		require.Len(t, tlsCfg.Certificates, 1)
+		require.NotNil(t, tlsCfg.RootCAs)
+		require.Equal(t, "oracle", tlsCfg.ServerName)
+	})
+
+	t.Run("without a client certificate", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled: true,
+			CAFile:  files.CAFile,
+		}
+
+		tlsCfg, err := cfg.ClientTLSConfig()
+		require.NoError(t, err)
+		require.Empty(t, tlsCfg.Certificates)
+		require.NotNil(t, tlsCfg.RootCAs)
+	})
+
+	t.Run("missing ca file", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled: true,
+			CAFile:  "missing.pem",
+		}
+
+		_, err := cfg.ClientTLSConfig()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultConfigReloadDebounce is the amount of time the config watcher waits after the last
+// file system event before re-reading the config. Editors typically emit several events for
+// a single save.
+const DefaultConfigReloadDebounce = 250 * time.Millisecond
+
+// WatchOracleConfigFile watches the oracle config file at the given path and invokes onChange
+// every time the contents of the file change. onChange receives either the newly read and
+// validated config or the error encountered while reading it. The parent directory is watched
+// rather than the file itself so that files that are atomically replaced (i.e. renamed into
+// place by an editor or swapped via a symlink by an orchestrator) are still picked up. This
+// blocks until the context is cancelled.
+func WatchOracleConfigFile(ctx context.Context, path string, onChange func(OracleConfig, error)) error {
+	if onChange == nil {
+		return fmt.Errorf("config change callback cannot be nil")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create config watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to watch config directory: %w", err)
+	}
+
+	// Track the digest of the last config that was read so that only actual changes to the
+	// file are reported.
+	last, err := fileDigest(path)
+	if err != nil {
+		return err
+	}
+
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			debounce = time.After(DefaultConfigReloadDebounce)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			onChange(OracleConfig{}, fmt.Errorf("config watcher error: %w", err))
+
+		case <-debounce:
+			debounce = nil
+
+			digest, err := fileDigest(path)
+			if err != nil {
+				onChange(OracleConfig{}, err)
+				continue
+			}
+
+			if bytes.Equal(digest, last) {
+				continue
+			}
+			last = digest
+
+			onChange(ReadOracleConfigFromFile(path))
+		}
+	}
+}
+
+// fileDigest returns the sha256 digest of the file at the given path.
+func fileDigest(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	digest := sha256.Sum256(bz)
+	return digest[:], nil
+}
@@ -0,0 +1,75 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestWatchOracleConfigFile(t *testing.T) {
+	bz, err := os.ReadFile("../../config/local/oracle.toml")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "oracle.toml")
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type result struct {
+		cfg config.OracleConfig
+		err error
+	}
+	results := make(chan result, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- config.WatchOracleConfigFile(ctx, path, func(cfg config.OracleConfig, err error) {
+			results <- result{cfg, err}
+		})
+	}()
+
+	// Give the watcher time to register the directory.
+	time.Sleep(100 * time.Millisecond)
+
+	t.Run("rewriting the same contents is not reported", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+		select {
+		case r := <-results:
+			t.Fatalf("unexpected config change: %v", r)
+		case <-time.After(3 * config.DefaultConfigReloadDebounce):
+		}
+	})
+
+	t.Run("invalid config is reported as an error", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("update_interval = \"0s\"\n"), 0o600))
+
+		select {
+		case r := <-results:
+			require.Error(t, r.err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for config change")
+		}
+	})
+
+	t.Run("valid config is reported", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+		select {
+		case r := <-results:
+			require.NoError(t, r.err)
+			require.NoError(t, r.cfg.ValidateBasic())
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for config change")
+		}
+	})
+
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+}
@@ -54,6 +54,14 @@ const (
 	// a provider can handle per-connection.  When this value is 0, one connection
 	// will handle all subscriptions.
 	DefaultMaxSubscriptionsPerConnection = 0
+
+	// DefaultMaxReconnectionTimeout is the default maximum delay between attempts to
+	// reconnect to the websocket endpoint.
+	DefaultMaxReconnectionTimeout = 5 * time.Minute
+
+	// DefaultMaxConnectionFailures is the default number of consecutive failed connections
+	// after which the subscriptions of a connection are moved to healthy connections.
+	DefaultMaxConnectionFailures = 3
 )
 
 // WebSocketConfig defines a config for a websocket based data provider.
@@ -70,9 +78,22 @@ type WebSocketConfig struct {
 	// to the websocket endpoint.
 	ReconnectionTimeout time.Duration `mapstructure:"reconnection_timeout" toml:"reconnection_timeout"`
 
+	// MaxReconnectionTimeout is the maximum delay between attempts to reconnect to the
+	// websocket endpoint. The delay starts at ReconnectionTimeout and doubles with each
+	// consecutive failed connection. If zero, DefaultMaxReconnectionTimeout is used.
+	MaxReconnectionTimeout time.Duration `mapstructure:"max_reconnection_timeout" toml:"max_reconnection_timeout"`
+
 	// WSS is the websocket endpoint for the provider.
 	WSS string `mapstructure:"wss" toml:"wss"`
 
+	// FallbackWSS are the websocket endpoints that are used, in order, if WSS is unhealthy
+	// (i.e. the provider fails to connect to it).
+	FallbackWSS []string `mapstructure:"fallback_wss" toml:"fallback_wss"`
+
+	// FailbackInterval is the interval after which the provider reconnects to WSS once it
+	// has failed over to a fallback endpoint. If zero, DefaultFailbackInterval is used.
+	FailbackInterval time.Duration `mapstructure:"failback_interval" toml:"failback_interval"`
+
 	// Name is the name of the provider that corresponds to this config.
 	Name string `mapstructure:"name" toml:"name"`
 
@@ -116,6 +137,12 @@ type WebSocketConfig struct {
 	// can be assigned to a single connection for this provider.  The null value (0),
 	// indicates that there is no limit per connection.
 	MaxSubscriptionsPerConnection int `mapstructure:"max_subscriptions_per_connection" toml:"max_subscriptions_per_connection"`
+
+	// MaxConnectionFailures is the number of consecutive failed connections after which
+	// the subscriptions of a connection are moved to the healthy connections of the
+	// provider, or to an idle or new connection if they are full. If zero,
+	// DefaultMaxConnectionFailures is used.
+	MaxConnectionFailures int `mapstructure:"max_connection_failures" toml:"max_connection_failures"`
 }
 
 // ValidateBasic performs basic validation of the websocket config.
@@ -132,6 +159,14 @@ func (c *WebSocketConfig) ValidateBasic() error {
 		return fmt.Errorf("websocket reconnection timeout must be greater than 0")
 	}
 
+	if c.MaxReconnectionTimeout < 0 {
+		return fmt.Errorf("websocket max reconnection timeout cannot be negative")
+	}
+
+	if c.MaxReconnectionTimeout > 0 && c.MaxReconnectionTimeout < c.ReconnectionTimeout {
+		return fmt.Errorf("websocket max reconnection timeout must be at least the reconnection timeout")
+	}
+
 	if len(c.WSS) == 0 {
 		return fmt.Errorf("websocket endpoint cannot be empty")
 	}
@@ -172,5 +207,40 @@ func (c *WebSocketConfig) ValidateBasic() error {
 		return fmt.Errorf("websocket max subscriptions per connection cannot be negative")
 	}
 
-	return nil
+	if c.MaxConnectionFailures < 0 {
+		return fmt.Errorf("websocket max connection failures cannot be negative")
+	}
+
+	return validateFallbacks("websocket", c.WSS, c.FallbackWSS, c.FailbackInterval)
+}
+
+// GetEndpoints returns the websocket endpoint and the fallback websocket endpoints of the
+// provider, in the order in which they are used.
+func (c *WebSocketConfig) GetEndpoints() []string {
+	return append([]string{c.WSS}, c.FallbackWSS...)
+}
+
+// GetFailbackInterval returns the interval after which the provider reconnects to WSS.
+func (c *WebSocketConfig) GetFailbackInterval() time.Duration {
+	return getFailbackInterval(c.FailbackInterval)
+}
+
+// GetMaxReconnectionTimeout returns the maximum delay between attempts to reconnect to the
+// websocket endpoint. This is never less than the reconnection timeout.
+func (c *WebSocketConfig) GetMaxReconnectionTimeout() time.Duration {
+	if c.MaxReconnectionTimeout == 0 {
+		return max(DefaultMaxReconnectionTimeout, c.ReconnectionTimeout)
+	}
+
+	return c.MaxReconnectionTimeout
+}
+
+// GetMaxConnectionFailures returns the number of consecutive failed connections after which
+// the subscriptions of a connection are moved to healthy connections.
+func (c *WebSocketConfig) GetMaxConnectionFailures() int {
+	if c.MaxConnectionFailures == 0 {
+		return DefaultMaxConnectionFailures
+	}
+
+	return c.MaxConnectionFailures
 }
@@ -275,6 +275,149 @@ func TestWebSocketConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with fallback endpoints",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				FallbackWSS:                   []string{"wss://test2.com"},
+				FailbackInterval:              time.Minute,
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a duplicate fallback endpoint",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				FallbackWSS:                   []string{"wss://test.com"},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a negative failback interval",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				FallbackWSS:                   []string{"wss://test2.com"},
+				FailbackInterval:              -time.Minute,
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with reconnection backoff",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        time.Minute,
+				MaxConnectionFailures:         5,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a negative max reconnection timeout",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        -time.Minute,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a max reconnection timeout below the reconnection timeout",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        config.DefaultReconnectionTimeout / 2,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative max connection failures",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxConnectionFailures:         -1,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -288,3 +431,33 @@ func TestWebSocketConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestWebSocketConfigReconnectionDefaults(t *testing.T) {
+	t.Run("uses the defaults if unset", func(t *testing.T) {
+		cfg := config.WebSocketConfig{
+			ReconnectionTimeout: config.DefaultReconnectionTimeout,
+		}
+
+		require.Equal(t, config.DefaultMaxReconnectionTimeout, cfg.GetMaxReconnectionTimeout())
+		require.Equal(t, config.DefaultMaxConnectionFailures, cfg.GetMaxConnectionFailures())
+	})
+
+	t.Run("max reconnection timeout is never below the reconnection timeout", func(t *testing.T) {
+		cfg := config.WebSocketConfig{
+			ReconnectionTimeout: config.DefaultMaxReconnectionTimeout * 2,
+		}
+
+		require.Equal(t, cfg.ReconnectionTimeout, cfg.GetMaxReconnectionTimeout())
+	})
+
+	t.Run("uses the configured values", func(t *testing.T) {
+		cfg := config.WebSocketConfig{
+			ReconnectionTimeout:    config.DefaultReconnectionTimeout,
+			MaxReconnectionTimeout: time.Minute,
+			MaxConnectionFailures:  5,
+		}
+
+		require.Equal(t, time.Minute, cfg.GetMaxReconnectionTimeout())
+		require.Equal(t, 5, cfg.GetMaxConnectionFailures())
+	})
+}
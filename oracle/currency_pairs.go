@@ -0,0 +1,165 @@
+package oracle
+
+import (
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// UpdateCurrencyPairs updates the set of currency pairs that the oracle fetches prices for i.e.
+// the set of currency pairs tracked by the x/oracle module on chain. Each provider is resubscribed
+// to the subset of currency pairs - and the feeds required to convert them to the desired
+// currency pair - that have a ticker mapping in the provider's market config. Providers whose set
+// of currency pairs did not change are not touched. This returns the set of currency pairs that
+// cannot be priced because no provider has a ticker mapping for them.
+//
+// The currency pairs are retained across config reloads.
+func (o *OracleImpl) UpdateCurrencyPairs(cps []oracletypes.CurrencyPair) []oracletypes.CurrencyPair {
+	o.reloadMtx.Lock()
+	defer o.reloadMtx.Unlock()
+
+	o.currencyPairs = make([]oracletypes.CurrencyPair, len(cps))
+	copy(o.currencyPairs, cps)
+
+	return o.applyCurrencyPairs()
+}
+
+// WithholdPrices withholds the prices of the given currency pairs, along with the reason each price
+// is withheld, from every subsequent oracle update. This replaces the currency pairs withheld by the
+// previous call. This is used to withhold the prices of currency pairs whose decimals do not match
+// the decimals on chain.
+func (o *OracleImpl) WithholdPrices(withheld map[oracletypes.CurrencyPair]string) {
+	pairs := make(map[oracletypes.CurrencyPair]string, len(withheld))
+	maps.Copy(pairs, withheld)
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.withheldPairs = pairs
+}
+
+// withholdPairs removes the prices of the currency pairs withheld with WithholdPrices from the given
+// prices, and adds them to the given withheld prices. Currency pairs that were already withheld keep
+// their reason. The given maps are not modified.
+func (o *OracleImpl) withholdPairs(
+	prices map[oracletypes.CurrencyPair]*big.Int,
+	withheld map[oracletypes.CurrencyPair]string,
+) (map[oracletypes.CurrencyPair]*big.Int, map[oracletypes.CurrencyPair]string) {
+	o.mtx.RLock()
+	pairs := o.withheldPairs
+	o.mtx.RUnlock()
+
+	if len(pairs) == 0 {
+		return prices, withheld
+	}
+
+	kept := make(map[oracletypes.CurrencyPair]*big.Int, len(prices))
+	for cp, price := range prices {
+		if _, ok := pairs[cp]; !ok {
+			kept[cp] = price
+		}
+	}
+
+	merged := make(map[oracletypes.CurrencyPair]string, len(withheld)+len(pairs))
+	maps.Copy(merged, withheld)
+	for cp, reason := range pairs {
+		if _, ok := merged[cp]; ok {
+			continue
+		}
+
+		merged[cp] = reason
+		o.metrics.AddWithheldPrice(cp.String(), reason)
+	}
+
+	return kept, merged
+}
+
+// applyCurrencyPairs resubscribes the providers to the currency pairs synced from on chain state
+// and returns the set of currency pairs that are not mapped by any provider. This must be called
+// with the reload lock held.
+func (o *OracleImpl) applyCurrencyPairs() []oracletypes.CurrencyPair {
+	if o.currencyPairs == nil {
+		return nil
+	}
+
+	// Determine the set of feeds that are required to price each of the currency pairs. This
+	// includes the currency pair itself as well as every feed used in its conversions.
+	required := make(map[oracletypes.CurrencyPair]struct{})
+	for _, cp := range o.currencyPairs {
+		required[cp] = struct{}{}
+
+		for _, conversions := range o.cfg.Market.GetConversions(cp) {
+			for _, conversion := range conversions {
+				required[conversion.CurrencyPair] = struct{}{}
+			}
+		}
+	}
+
+	// Determine the set of feeds that are mapped by at least one provider, as well as the
+	// subset of required feeds that each provider should fetch.
+	mapped := make(map[oracletypes.CurrencyPair]struct{})
+	providerIDs := make(map[string][]oracletypes.CurrencyPair, len(o.cfg.Providers))
+	for _, p := range o.cfg.Providers {
+		ids := make([]oracletypes.CurrencyPair, 0)
+		for _, market := range p.Market.CurrencyPairToMarketConfigs {
+			mapped[market.CurrencyPair] = struct{}{}
+
+			if _, ok := required[market.CurrencyPair]; ok {
+				ids = append(ids, market.CurrencyPair)
+			}
+		}
+
+		providerIDs[p.Name] = ids
+	}
+
+	o.providerMtx.Lock()
+	for _, p := range o.providers {
+		ids, ok := providerIDs[p.Name()]
+		if !ok || sameIDs(p.GetIDs(), ids) {
+			continue
+		}
+
+		o.logger.Info("resubscribing provider to on chain currency pairs", zap.String("provider", p.Name()), zap.Int("num_ids", len(ids)))
+		p.SetIDs(ids)
+	}
+	o.providerMtx.Unlock()
+
+	unmapped := make([]oracletypes.CurrencyPair, 0)
+	for _, cp := range o.currencyPairs {
+		if !isMapped(cp, o.cfg.Market, mapped) {
+			unmapped = append(unmapped, cp)
+		}
+	}
+
+	slices.SortFunc(unmapped, func(a, b oracletypes.CurrencyPair) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return unmapped
+}
+
+// isMapped returns true if the currency pair can be priced with the set of mapped feeds. A
+// currency pair can be priced if it is mapped directly, or if all of the feeds in at least one
+// of its conversions are mapped.
+func isMapped(
+	cp oracletypes.CurrencyPair,
+	market config.AggregateMarketConfig,
+	mapped map[oracletypes.CurrencyPair]struct{},
+) bool {
+	if _, ok := mapped[cp]; ok {
+		return true
+	}
+
+	return slices.ContainsFunc(market.GetConversions(cp), func(conversions config.Conversions) bool {
+		return !slices.ContainsFunc(conversions, func(conversion config.Conversion) bool {
+			_, ok := mapped[conversion.CurrencyPair]
+			return !ok
+		})
+	})
+}
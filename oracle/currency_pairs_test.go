@@ -0,0 +1,158 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	providermocks "github.com/skip-mev/slinky/providers/types/mocks"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func (s *OracleTestSuite) TestUpdateCurrencyPairs() {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	btcUSDT := oracletypes.NewCurrencyPair("BITCOIN", "USDT")
+	usdt := oracletypes.NewCurrencyPair("USDT", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+	atom := oracletypes.NewCurrencyPair("COSMOS", "USD")
+
+	cfg := config.OracleConfig{
+		UpdateInterval: time.Second,
+		Providers: []config.ProviderConfig{
+			reloadProviderConfig("provider1", time.Second, btc, eth),
+			reloadProviderConfig("provider2", time.Second, btcUSDT, usdt, eth),
+		},
+		Market: config.AggregateMarketConfig{
+			Feeds: map[string]config.FeedConfig{
+				btc.String():     {CurrencyPair: btc},
+				btcUSDT.String(): {CurrencyPair: btcUSDT},
+				usdt.String():    {CurrencyPair: usdt},
+				eth.String():     {CurrencyPair: eth},
+			},
+			AggregatedFeeds: map[string]config.AggregateFeedConfig{
+				btc.String(): {
+					CurrencyPair: btc,
+					Conversions: []config.Conversions{
+						{
+							{CurrencyPair: btc},
+						},
+						{
+							{CurrencyPair: btcUSDT},
+							{CurrencyPair: usdt},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	provider1 := s.subscribingProvider("provider1", btc, eth)
+	provider2 := s.subscribingProvider("provider2", btcUSDT, usdt, eth)
+
+	o, err := oracle.New(
+		oracle.WithConfig(cfg),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider1, provider2}),
+		oracle.WithLogger(s.logger),
+	)
+	s.Require().NoError(err)
+
+	s.Run("unchanged currency pairs do not resubscribe providers", func() {
+		unmapped := o.UpdateCurrencyPairs([]oracletypes.CurrencyPair{btc, eth})
+		s.Require().Empty(unmapped)
+
+		provider1.AssertNotCalled(s.T(), "SetIDs", mock.Anything)
+		provider2.AssertNotCalled(s.T(), "SetIDs", mock.Anything)
+	})
+
+	s.Run("removed currency pairs are dropped along with their conversions", func() {
+		unmapped := o.UpdateCurrencyPairs([]oracletypes.CurrencyPair{eth})
+		s.Require().Empty(unmapped)
+
+		s.Require().Equal([]oracletypes.CurrencyPair{eth}, provider1.GetIDs())
+		s.Require().Equal([]oracletypes.CurrencyPair{eth}, provider2.GetIDs())
+	})
+
+	s.Run("added currency pairs without a ticker mapping are reported", func() {
+		unmapped := o.UpdateCurrencyPairs([]oracletypes.CurrencyPair{btc, eth, atom})
+		s.Require().Equal([]oracletypes.CurrencyPair{atom}, unmapped)
+
+		s.Require().ElementsMatch([]oracletypes.CurrencyPair{btc, eth}, provider1.GetIDs())
+		s.Require().ElementsMatch([]oracletypes.CurrencyPair{btcUSDT, usdt, eth}, provider2.GetIDs())
+		provider1.AssertNumberOfCalls(s.T(), "SetIDs", 2)
+		provider2.AssertNumberOfCalls(s.T(), "SetIDs", 2)
+	})
+}
+
+func (s *OracleTestSuite) TestWithholdPrices() {
+	btc := s.currencyPairs[0]
+	eth := s.currencyPairs[1]
+
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		btc: providertypes.NewResult[*big.Int](big.NewInt(100), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)),
+		eth: providertypes.NewResult[*big.Int](big.NewInt(10), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}).Maybe()
+
+	o, err := oracle.New(
+		oracle.WithUpdateInterval(100*time.Millisecond),
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+	)
+	s.Require().NoError(err)
+
+	o.WithholdPrices(map[oracletypes.CurrencyPair]string{btc: "decimals_mismatch"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	details := o.GetPriceDetails()
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		eth: big.NewInt(10),
+	}, details.Prices)
+	s.Require().Equal(map[oracletypes.CurrencyPair]string{
+		btc: "decimals_mismatch",
+	}, details.Withheld)
+
+	// The price is reported again once it is no longer withheld.
+	o.WithholdPrices(nil)
+	s.Require().Eventually(func() bool {
+		_, ok := o.GetPrices()[btc]
+		return ok
+	}, 2*time.Second, 10*time.Millisecond)
+
+	o.Stop()
+}
+
+// subscribingProvider returns a mock provider that tracks the set of ids it is subscribed to.
+func (s *OracleTestSuite) subscribingProvider(
+	name string,
+	ids ...oracletypes.CurrencyPair,
+) *providermocks.Provider[oracletypes.CurrencyPair, *big.Int] {
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return(name).Maybe()
+	provider.On("GetIDs").Return(func() []oracletypes.CurrencyPair {
+		return ids
+	}).Maybe()
+	provider.On("SetIDs", mock.Anything).Run(func(args mock.Arguments) {
+		ids = args.Get(0).([]oracletypes.CurrencyPair)
+	}).Return().Maybe()
+
+	return provider
+}
@@ -13,6 +13,10 @@ const (
 	ProviderTypeLabel = "type"
 	// PairIDLabel is the.
 	PairIDLabel = "pair"
+	// ReasonLabel is a label for the reason a price was rejected (i.e. the outlier filter type).
+	ReasonLabel = "reason"
+	// EventLabel is a label for a circuit breaker event (i.e. tripped or reset).
+	EventLabel = "event"
 	// OracleSubsystem is a subsystem shared by all metrics exposed by this
 	// package.
 	OracleSubsystem = "oracle"
@@ -31,6 +35,22 @@ type Metrics interface {
 
 	// UpdateAggregatePrice updates the aggregated price for the given pairID.
 	UpdateAggregatePrice(pairID string, price float64)
+
+	// AddStalePrice increments the number of prices for the given pairID that were dropped
+	// from the provider's prices because they were older than the max price age.
+	AddStalePrice(name, handlerType, pairID string)
+
+	// AddRejectedPrice increments the number of prices for the given pairID that were rejected
+	// from the provider's prices as outliers for the given reason.
+	AddRejectedPrice(name, pairID, reason string)
+
+	// AddWithheldPrice increments the number of times the aggregated price for the given pairID
+	// was withheld for the given reason (i.e. insufficient provider quorum).
+	AddWithheldPrice(pairID, reason string)
+
+	// AddCircuitBreakerEvent increments the number of times the circuit breaker for the given
+	// pairID emitted the given event (i.e. tripped, confirmed or reset).
+	AddCircuitBreakerEvent(pairID, event string)
 }
 
 // OracleMetricsImpl is a Metrics implementation that does nothing.
@@ -38,6 +58,10 @@ type OracleMetricsImpl struct {
 	ticks           prometheus.Counter
 	prices          *prometheus.GaugeVec
 	aggregatePrices *prometheus.GaugeVec
+	stalePrices     *prometheus.CounterVec
+	rejectedPrices  *prometheus.CounterVec
+	withheldPrices  *prometheus.CounterVec
+	circuitBreakers *prometheus.CounterVec
 }
 
 // NewMetricsFromConfig returns a oracle Metrics implementation based on the provided
@@ -67,12 +91,36 @@ func NewMetrics() Metrics {
 			Name:      "aggregate_price",
 			Help:      "Aggregate price for a given currency pair",
 		}, []string{PairIDLabel}),
+		stalePrices: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_stale_prices_total",
+			Help:      "Number of prices for a given currency pair on a provider that were dropped for being older than the max price age",
+		}, []string{ProviderLabel, ProviderTypeLabel, PairIDLabel}),
+		rejectedPrices: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_rejected_prices_total",
+			Help:      "Number of prices for a given currency pair on a provider that were rejected as outliers",
+		}, []string{ProviderLabel, PairIDLabel, ReasonLabel}),
+		withheldPrices: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "withheld_prices_total",
+			Help:      "Number of times the aggregated price for a given currency pair was withheld",
+		}, []string{PairIDLabel, ReasonLabel}),
+		circuitBreakers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "circuit_breaker_events_total",
+			Help:      "Number of times the circuit breaker for a given currency pair tripped, was confirmed or was reset",
+		}, []string{PairIDLabel, EventLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.ticks)
 	prometheus.MustRegister(m.prices)
 	prometheus.MustRegister(m.aggregatePrices)
+	prometheus.MustRegister(m.stalePrices)
+	prometheus.MustRegister(m.rejectedPrices)
+	prometheus.MustRegister(m.withheldPrices)
+	prometheus.MustRegister(m.circuitBreakers)
 
 	return m
 }
@@ -96,6 +144,22 @@ func (m *noOpOracleMetrics) UpdatePrice(_, _, _ string, _ float64) {
 func (m *noOpOracleMetrics) UpdateAggregatePrice(_ string, _ float64) {
 }
 
+// AddStalePrice increments the number of stale prices for the given pairID on the provider.
+func (m *noOpOracleMetrics) AddStalePrice(_, _, _ string) {
+}
+
+// AddRejectedPrice increments the number of rejected prices for the given pairID on the provider.
+func (m *noOpOracleMetrics) AddRejectedPrice(_, _, _ string) {
+}
+
+// AddWithheldPrice increments the number of times the aggregated price for the given pairID was withheld.
+func (m *noOpOracleMetrics) AddWithheldPrice(_, _ string) {
+}
+
+// AddCircuitBreakerEvent increments the number of circuit breaker events for the given pairID.
+func (m *noOpOracleMetrics) AddCircuitBreakerEvent(_, _ string) {
+}
+
 // AddTick increments the total number of ticks that have been processed by the oracle.
 func (m *OracleMetricsImpl) AddTick() {
 	m.ticks.Add(1)
@@ -118,3 +182,41 @@ func (m *OracleMetricsImpl) UpdateAggregatePrice(pairID string, price float64) {
 	},
 	).Set(price)
 }
+
+// AddStalePrice increments the number of stale prices for the given pairID on the provider.
+func (m *OracleMetricsImpl) AddStalePrice(providerName, handlerType, pairID string) {
+	m.stalePrices.With(prometheus.Labels{
+		ProviderLabel:     providerName,
+		ProviderTypeLabel: handlerType,
+		PairIDLabel:       pairID,
+	},
+	).Add(1)
+}
+
+// AddRejectedPrice increments the number of rejected prices for the given pairID on the provider.
+func (m *OracleMetricsImpl) AddRejectedPrice(providerName, pairID, reason string) {
+	m.rejectedPrices.With(prometheus.Labels{
+		ProviderLabel: providerName,
+		PairIDLabel:   pairID,
+		ReasonLabel:   reason,
+	},
+	).Add(1)
+}
+
+// AddWithheldPrice increments the number of times the aggregated price for the given pairID was withheld.
+func (m *OracleMetricsImpl) AddWithheldPrice(pairID, reason string) {
+	m.withheldPrices.With(prometheus.Labels{
+		PairIDLabel: pairID,
+		ReasonLabel: reason,
+	},
+	).Add(1)
+}
+
+// AddCircuitBreakerEvent increments the number of circuit breaker events for the given pairID.
+func (m *OracleMetricsImpl) AddCircuitBreakerEvent(pairID, event string) {
+	m.circuitBreakers.With(prometheus.Labels{
+		PairIDLabel: pairID,
+		EventLabel:  event,
+	},
+	).Add(1)
+}
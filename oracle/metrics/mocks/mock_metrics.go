@@ -9,11 +9,31 @@ type Metrics struct {
 	mock.Mock
 }
 
+// AddCircuitBreakerEvent provides a mock function with given fields: pairID, event
+func (_m *Metrics) AddCircuitBreakerEvent(pairID string, event string) {
+	_m.Called(pairID, event)
+}
+
+// AddRejectedPrice provides a mock function with given fields: name, pairID, reason
+func (_m *Metrics) AddRejectedPrice(name string, pairID string, reason string) {
+	_m.Called(name, pairID, reason)
+}
+
+// AddStalePrice provides a mock function with given fields: name, handlerType, pairID
+func (_m *Metrics) AddStalePrice(name string, handlerType string, pairID string) {
+	_m.Called(name, handlerType, pairID)
+}
+
 // AddTick provides a mock function with given fields:
 func (_m *Metrics) AddTick() {
 	_m.Called()
 }
 
+// AddWithheldPrice provides a mock function with given fields: pairID, reason
+func (_m *Metrics) AddWithheldPrice(pairID string, reason string) {
+	_m.Called(pairID, reason)
+}
+
 // UpdateAggregatePrice provides a mock function with given fields: pairID, price
 func (_m *Metrics) UpdateAggregatePrice(pairID string, price float64) {
 	_m.Called(pairID, price)
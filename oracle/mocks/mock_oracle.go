@@ -8,8 +8,12 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracle "github.com/skip-mev/slinky/oracle"
+
 	time "time"
 
+	providertypes "github.com/skip-mev/slinky/providers/types"
+
 	types "github.com/skip-mev/slinky/x/oracle/types"
 )
 
@@ -18,6 +22,80 @@ type Oracle struct {
 	mock.Mock
 }
 
+// DisableProvider provides a mock function with given fields: name, d
+func (_m *Oracle) DisableProvider(name string, d time.Duration) error {
+	ret := _m.Called(name, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableProvider")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Duration) error); ok {
+		r0 = rf(name, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableProvider provides a mock function with given fields: name
+func (_m *Oracle) EnableProvider(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableProvider")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExcludePair provides a mock function with given fields: name, cp, d
+func (_m *Oracle) ExcludePair(name string, cp types.CurrencyPair, d time.Duration) error {
+	ret := _m.Called(name, cp, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExcludePair")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.CurrencyPair, time.Duration) error); ok {
+		r0 = rf(name, cp, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDecimals provides a mock function with given fields:
+func (_m *Oracle) GetDecimals() map[types.CurrencyPair]uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDecimals")
+	}
+
+	var r0 map[types.CurrencyPair]uint64
+	if rf, ok := ret.Get(0).(func() map[types.CurrencyPair]uint64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.CurrencyPair]uint64)
+		}
+	}
+
+	return r0
+}
+
 // GetLastSyncTime provides a mock function with given fields:
 func (_m *Oracle) GetLastSyncTime() time.Time {
 	ret := _m.Called()
@@ -36,6 +114,44 @@ func (_m *Oracle) GetLastSyncTime() time.Time {
 	return r0
 }
 
+// GetPriceDetails provides a mock function with given fields:
+func (_m *Oracle) GetPriceDetails() oracle.PriceDetails {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDetails")
+	}
+
+	var r0 oracle.PriceDetails
+	if rf, ok := ret.Get(0).(func() oracle.PriceDetails); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(oracle.PriceDetails)
+	}
+
+	return r0
+}
+
+// GetPriceHistory provides a mock function with given fields: start, end
+func (_m *Oracle) GetPriceHistory(start time.Time, end time.Time) []oracle.PriceHistoryEntry {
+	ret := _m.Called(start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []oracle.PriceHistoryEntry
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) []oracle.PriceHistoryEntry); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracle.PriceHistoryEntry)
+		}
+	}
+
+	return r0
+}
+
 // GetPrices provides a mock function with given fields:
 func (_m *Oracle) GetPrices() map[types.CurrencyPair]*big.Int {
 	ret := _m.Called()
@@ -56,6 +172,124 @@ func (_m *Oracle) GetPrices() map[types.CurrencyPair]*big.Int {
 	return r0
 }
 
+// GetProviderNames provides a mock function with given fields:
+func (_m *Oracle) GetProviderNames() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderNames")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// GetProviderPrices provides a mock function with given fields:
+func (_m *Oracle) GetProviderPrices() map[string]map[types.CurrencyPair]providertypes.Result[*big.Int] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderPrices")
+	}
+
+	var r0 map[string]map[types.CurrencyPair]providertypes.Result[*big.Int]
+	if rf, ok := ret.Get(0).(func() map[string]map[types.CurrencyPair]providertypes.Result[*big.Int]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[types.CurrencyPair]providertypes.Result[*big.Int])
+		}
+	}
+
+	return r0
+}
+
+// GetProviderStatuses provides a mock function with given fields:
+func (_m *Oracle) GetProviderStatuses() []oracle.ProviderStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderStatuses")
+	}
+
+	var r0 []oracle.ProviderStatus
+	if rf, ok := ret.Get(0).(func() []oracle.ProviderStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracle.ProviderStatus)
+		}
+	}
+
+	return r0
+}
+
+// GetRawPrices provides a mock function with given fields:
+func (_m *Oracle) GetRawPrices() map[types.CurrencyPair]*big.Int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRawPrices")
+	}
+
+	var r0 map[types.CurrencyPair]*big.Int
+	if rf, ok := ret.Get(0).(func() map[types.CurrencyPair]*big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.CurrencyPair]*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// GetWithheldPrices provides a mock function with given fields:
+func (_m *Oracle) GetWithheldPrices() map[types.CurrencyPair]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithheldPrices")
+	}
+
+	var r0 map[types.CurrencyPair]string
+	if rf, ok := ret.Get(0).(func() map[types.CurrencyPair]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.CurrencyPair]string)
+		}
+	}
+
+	return r0
+}
+
+// IncludePair provides a mock function with given fields: name, cp
+func (_m *Oracle) IncludePair(name string, cp types.CurrencyPair) error {
+	ret := _m.Called(name, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncludePair")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.CurrencyPair) error); ok {
+		r0 = rf(name, cp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IsRunning provides a mock function with given fields:
 func (_m *Oracle) IsRunning() bool {
 	ret := _m.Called()
@@ -97,6 +331,26 @@ func (_m *Oracle) Stop() {
 	_m.Called()
 }
 
+// SubscribePrices provides a mock function with given fields: ctx
+func (_m *Oracle) SubscribePrices(ctx context.Context) <-chan struct{} {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePrices")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan struct{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
 // NewOracle creates a new instance of Oracle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracle(t interface {
@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
@@ -69,6 +70,57 @@ func WithAggregateFunction(fn aggregator.AggregateFn[string, map[oracletypes.Cur
 	}
 }
 
+// WithVolumeWeighter sets the component that is given the 24 hour volumes reported alongside the
+// provider prices before each aggregation i.e. the MedianAggregator, which weights the prices of
+// volume-weighted feeds by them.
+func WithVolumeWeighter(weighter VolumeWeighter) Option {
+	return func(o *OracleImpl) {
+		if weighter == nil {
+			panic("cannot set nil volume weighter")
+		}
+
+		o.volumeWeighter = weighter
+	}
+}
+
+// WithConversionPathResolver sets the component that reports the conversion paths used to aggregate
+// the price of each currency pair i.e. the MedianAggregator.
+func WithConversionPathResolver(resolver ConversionPathResolver) Option {
+	return func(o *OracleImpl) {
+		if resolver == nil {
+			panic("cannot set nil conversion path resolver")
+		}
+
+		o.conversionPathResolver = resolver
+	}
+}
+
+// WithPriceSmoother sets the component that smooths the aggregated prices before they are reported
+// by the Oracle. The smoother's market config is updated when the oracle config is reloaded.
+func WithPriceSmoother(smoother PriceSmoother) Option {
+	return func(o *OracleImpl) {
+		if smoother == nil {
+			panic("cannot set nil price smoother")
+		}
+
+		o.priceSmoother = smoother
+	}
+}
+
+// WithCircuitBreaker sets the component that holds the reported price of a currency pair when its
+// aggregated price moves too far between ticks. The circuit breaker is applied to the aggregated
+// prices before the price smoother, and its market config is updated when the oracle config is
+// reloaded.
+func WithCircuitBreaker(breaker CircuitBreaker) Option {
+	return func(o *OracleImpl) {
+		if breaker == nil {
+			panic("cannot set nil circuit breaker")
+		}
+
+		o.circuitBreaker = breaker
+	}
+}
+
 // WithDataAggregator sets the data aggregator on the Oracle.
 func WithDataAggregator(agg *aggregator.DataAggregator[string, map[oracletypes.CurrencyPair]*big.Int]) Option {
 	return func(o *OracleImpl) {
@@ -86,3 +138,55 @@ func WithProviders(providers []providertypes.Provider[oracletypes.CurrencyPair,
 		o.providers = providers
 	}
 }
+
+// WithConfig sets the config that the oracle is running with. This is used to determine which
+// providers must be updated when the oracle config is reloaded. This also sets the update
+// interval and the price history config on the Oracle.
+func WithConfig(cfg config.OracleConfig) Option {
+	return func(o *OracleImpl) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid oracle config: %s", err))
+		}
+
+		o.cfg = cfg
+		o.updateInterval = cfg.UpdateInterval
+		o.setProviderConfigs(cfg.Providers)
+		o.priceHistory.setConfig(cfg.PriceHistory)
+	}
+}
+
+// WithProviderFactory sets the provider factory on the Oracle. The factory is used to create
+// new providers when the oracle config is reloaded.
+func WithProviderFactory(factory providertypes.ProviderFactory[oracletypes.CurrencyPair, *big.Int]) Option {
+	return func(o *OracleImpl) {
+		if factory == nil {
+			panic("cannot set nil provider factory")
+		}
+
+		o.providerFactory = factory
+	}
+}
+
+// WithMarketConfigUpdater sets the component that is updated with the new market config when
+// the oracle config is reloaded i.e. the MedianAggregator.
+func WithMarketConfigUpdater(updater MarketConfigUpdater) Option {
+	return func(o *OracleImpl) {
+		if updater == nil {
+			panic("cannot set nil market config updater")
+		}
+
+		o.marketConfigUpdater = updater
+	}
+}
+
+// WithPriceWithholder sets the component that reports the currency pairs whose prices were
+// withheld during aggregation i.e. the MedianAggregator.
+func WithPriceWithholder(withholder PriceWithholder) Option {
+	return func(o *OracleImpl) {
+		if withholder == nil {
+			panic("cannot set nil price withholder")
+		}
+
+		o.priceWithholder = withholder
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/aggregator"
+	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/metrics"
 	ssync "github.com/skip-mev/slinky/pkg/sync"
 	providertypes "github.com/skip-mev/slinky/providers/types"
@@ -26,9 +27,99 @@ var _ Oracle = (*OracleImpl)(nil)
 type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
+	GetDecimals() map[oracletypes.CurrencyPair]uint64
 	GetPrices() map[oracletypes.CurrencyPair]*big.Int
+	GetRawPrices() map[oracletypes.CurrencyPair]*big.Int
+	GetProviderNames() []string
+	GetProviderPrices() map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]
+	GetWithheldPrices() map[oracletypes.CurrencyPair]string
+	GetPriceDetails() PriceDetails
+	GetPriceHistory(start, end time.Time) []PriceHistoryEntry
+	GetProviderStatuses() []ProviderStatus
+	DisableProvider(name string, d time.Duration) error
+	EnableProvider(name string) error
+	ExcludePair(name string, cp oracletypes.CurrencyPair, d time.Duration) error
+	IncludePair(name string, cp oracletypes.CurrencyPair) error
 	Start(ctx context.Context) error
 	Stop()
+
+	// SubscribePrices returns a channel that is signalled every time the oracle updates its
+	// prices. The subscription is removed once the context is cancelled.
+	SubscribePrices(ctx context.Context) <-chan struct{}
+}
+
+// PriceWithholder defines an interface for components that withhold the prices of currency pairs
+// during aggregation i.e. the MedianAggregator withholds prices that do not meet the provider quorum.
+type PriceWithholder interface {
+	// GetWithheldPrices returns the currency pairs whose prices were withheld in the latest
+	// aggregation, along with the reason each price was withheld.
+	GetWithheldPrices() map[oracletypes.CurrencyPair]string
+}
+
+// ConversionPathResolver defines an interface for components that resolve the conversion paths used to
+// aggregate the price of each currency pair i.e. the MedianAggregator.
+type ConversionPathResolver interface {
+	// GetConversionPaths returns the conversion paths currently used to aggregate the price of each
+	// currency pair.
+	GetConversionPaths() map[oracletypes.CurrencyPair][]config.Conversions
+}
+
+// VolumeWeighter defines an interface for components that weight the provider prices by the 24 hour
+// volume reported alongside them during aggregation i.e. the MedianAggregator.
+type VolumeWeighter interface {
+	// SetProviderVolumes sets the 24 hour volumes reported by each provider for the prices that
+	// are about to be aggregated.
+	SetProviderVolumes(volumes aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float])
+}
+
+// PriceDetails is a consistent view of the latest oracle update, i.e. every field is taken from the
+// same update.
+type PriceDetails struct {
+	// Timestamp is the time of the oracle update.
+	Timestamp time.Time
+
+	// Prices is the set of prices reported by the oracle i.e. the prices returned by GetPrices.
+	Prices map[oracletypes.CurrencyPair]*big.Int
+
+	// RawPrices is the set of aggregated prices before smoothing and circuit breaking.
+	RawPrices map[oracletypes.CurrencyPair]*big.Int
+
+	// ProviderPrices is the set of prices reported by each provider that were used to compute
+	// the prices.
+	ProviderPrices map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]
+
+	// Withheld is the set of currency pairs whose prices were withheld, along with the reason
+	// each price was withheld.
+	Withheld map[oracletypes.CurrencyPair]string
+
+	// Decimals is the number of decimals that the price of each currency pair is reported with.
+	Decimals map[oracletypes.CurrencyPair]uint64
+
+	// ConversionPaths is the set of conversion paths used to aggregate the price of each currency
+	// pair. This is empty unless a conversion path resolver is configured.
+	ConversionPaths map[oracletypes.CurrencyPair][]config.Conversions
+}
+
+// PriceSmoother defines an interface for components that smooth the aggregated prices before they
+// are reported by the oracle i.e. a time-weighted or exponential moving average per currency pair.
+type PriceSmoother interface {
+	MarketConfigUpdater
+
+	// Smooth records the latest aggregated prices and returns the smoothed prices.
+	Smooth(prices map[oracletypes.CurrencyPair]*big.Int) map[oracletypes.CurrencyPair]*big.Int
+}
+
+// CircuitBreaker defines an interface for components that hold the reported price of a currency
+// pair when its aggregated price moves too far between ticks, until the move is confirmed.
+type CircuitBreaker interface {
+	MarketConfigUpdater
+
+	// Apply records the latest prices, along with the provider prices they were aggregated from,
+	// and returns the prices to report.
+	Apply(
+		prices map[oracletypes.CurrencyPair]*big.Int,
+		providerPrices aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
+	) map[oracletypes.CurrencyPair]*big.Int
 }
 
 // OracleImpl implements the core component responsible for fetching exchange rates
@@ -40,6 +131,9 @@ type OracleImpl struct { //nolint
 	closer *ssync.Closer
 
 	// --------------------- Provider Config --------------------- //
+	// providerMtx guards the set of providers and their routines.
+	providerMtx sync.Mutex
+
 	// Providers is the set of providers that the oracle will fetch prices from.
 	// Each provider is responsible for fetching prices for a given set of
 	// currency pairs (base, quote). The oracle will fetch prices from each
@@ -50,10 +144,76 @@ type OracleImpl struct { //nolint
 	// providers are running or not.
 	providerCh chan error
 
+	// snapshotCh is closed once the snapshot process has written its final snapshot and exited,
+	// or immediately if snapshots are disabled.
+	snapshotCh chan struct{}
+
+	// providerCtx is the context that the providers were started with. Providers that
+	// are added while the oracle is running are started with this context.
+	providerCtx context.Context
+
+	// providerRoutines is the set of running providers keyed by provider name.
+	providerRoutines map[string]providerRoutine
+
+	// providerWg is used to wait for all provider routines to exit.
+	providerWg sync.WaitGroup
+
+	// providerFactory is used to create new providers when the oracle config is reloaded.
+	providerFactory providertypes.ProviderFactory[oracletypes.CurrencyPair, *big.Int]
+
 	// --------------------- Oracle Config --------------------- //
 	// lastPriceSync is the last time the oracle successfully updated its prices.
 	lastPriceSync time.Time
 
+	// providerPrices is the set of prices (along with their timestamps) reported by each
+	// provider that were used to compute the latest aggregated prices.
+	providerPrices map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]
+
+	// withheldPrices is the set of currency pairs whose prices were withheld in the latest
+	// aggregation, along with the reason each price was withheld.
+	withheldPrices map[oracletypes.CurrencyPair]string
+
+	// withheldPairs is the set of currency pairs whose prices are withheld regardless of the
+	// aggregation i.e. because their decimals do not match the decimals on chain, along with the
+	// reason each price is withheld.
+	withheldPairs map[oracletypes.CurrencyPair]string
+
+	// volumeWeighter is given the 24 hour volumes reported alongside the provider prices before
+	// each aggregation. If nil, the volumes are not used.
+	volumeWeighter VolumeWeighter
+
+	// priceWithholder reports the currency pairs whose prices were withheld during aggregation.
+	priceWithholder PriceWithholder
+
+	// conversionPathResolver reports the conversion paths used to aggregate the price of each
+	// currency pair. If nil, no conversion paths are reported.
+	conversionPathResolver ConversionPathResolver
+
+	// conversionPaths is the set of conversion paths used to compute the latest aggregated prices.
+	conversionPaths map[oracletypes.CurrencyPair][]config.Conversions
+
+	// priceSmoother smooths the aggregated prices before they are reported. If nil, the
+	// aggregated prices are reported as is.
+	priceSmoother PriceSmoother
+
+	// circuitBreaker holds the reported price of a currency pair when its aggregated price moves
+	// too far between ticks. If nil, the aggregated prices are reported as is.
+	circuitBreaker CircuitBreaker
+
+	// rawPrices is the latest set of aggregated prices before smoothing and circuit breaking.
+	rawPrices map[oracletypes.CurrencyPair]*big.Int
+
+	// reportedPrices is the latest set of prices after smoothing and circuit breaking.
+	reportedPrices map[oracletypes.CurrencyPair]*big.Int
+
+	// restoredPrices is the set of provider prices restored from the snapshot that have not
+	// yet been superseded by a new price from the provider or exceeded the max price age.
+	restoredPrices map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]
+
+	// priceHistory is the bounded history of the prices reported by the oracle. Nothing is
+	// recorded unless the price history is enabled in the oracle config.
+	priceHistory *priceHistory
+
 	// running is the current status of the main oracle process (running or not).
 	running atomic.Bool
 
@@ -67,6 +227,49 @@ type OracleImpl struct { //nolint
 	// updateInterval is the interval at which the oracle will fetch prices from
 	// each provider.
 	updateInterval time.Duration
+
+	// providerConfigs is the config of each provider keyed by provider name. This is used to
+	// determine the max age of the prices reported by each provider. Providers without a config
+	// use the update interval as the max price age.
+	providerConfigs map[string]config.ProviderConfig
+
+	// resetCh is used to signal the main loop that the update interval has changed.
+	resetCh chan struct{}
+
+	// subscriberMtx guards the set of price subscribers.
+	subscriberMtx sync.Mutex
+
+	// subscribers is the set of channels that are signalled every time the oracle
+	// updates its prices.
+	subscribers map[chan struct{}]struct{}
+
+	// --------------------- Admin Config --------------------- //
+	// adminMtx guards the operator overrides.
+	adminMtx sync.Mutex
+
+	// disabledProviders is the set of providers whose prices are ignored, keyed by provider name.
+	disabledProviders map[string]override
+
+	// excludedPairs is the set of currency pairs whose prices are ignored on each provider, keyed
+	// by provider name.
+	excludedPairs map[string]map[oracletypes.CurrencyPair]override
+
+	// --------------------- Reload Config --------------------- //
+	// reloadMtx serializes config reloads.
+	reloadMtx sync.Mutex
+
+	// cfg is the oracle config that the oracle is currently running with. This is
+	// used to determine what has changed when the config is reloaded. The config is
+	// only updated while holding both the reload lock and the general lock.
+	cfg config.OracleConfig
+
+	// marketConfigUpdater is updated with the new market config when the oracle
+	// config is reloaded.
+	marketConfigUpdater MarketConfigUpdater
+
+	// currencyPairs is the set of currency pairs synced from on chain state. If nil, each
+	// provider fetches prices for all of the currency pairs in its market config.
+	currencyPairs []oracletypes.CurrencyPair
 }
 
 // New returns a new instance of an Oracle. The oracle inputs providers that are
@@ -85,7 +288,13 @@ func New(opts ...Option) (*OracleImpl, error) {
 		priceAggregator: aggregator.NewDataAggregator[string, map[oracletypes.CurrencyPair]*big.Int](
 			aggregator.WithAggregateFn(aggregator.ComputeMedian()),
 		),
-		updateInterval: 1 * time.Second,
+		updateInterval:    1 * time.Second,
+		resetCh:           make(chan struct{}, 1),
+		providerRoutines:  make(map[string]providerRoutine),
+		subscribers:       make(map[chan struct{}]struct{}),
+		priceHistory:      newPriceHistory(config.PriceHistoryConfig{}),
+		disabledProviders: make(map[string]override),
+		excludedPairs:     make(map[string]map[oracletypes.CurrencyPair]override),
 	}
 
 	for _, opt := range opts {
@@ -111,13 +320,29 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Restore the latest prices from the snapshot, if configured, before the providers start.
+	snapshotCh := make(chan struct{})
+	o.snapshotCh = snapshotCh
+	if snapshotCfg := o.getSnapshotConfig(); snapshotCfg.Enabled {
+		if err := o.RestoreSnapshot(snapshotCfg.Path); err != nil {
+			o.logger.Error("failed to restore price snapshot", zap.String("path", snapshotCfg.Path), zap.Error(err))
+		}
+
+		go func() {
+			defer close(snapshotCh)
+			o.runSnapshots(ctx, snapshotCfg)
+		}()
+	} else {
+		close(snapshotCh)
+	}
+
 	o.providerCh = make(chan error)
 	go o.StartProviders(ctx)
 
 	o.running.Store(true)
 	defer o.running.Store(false)
 
-	ticker := time.NewTicker(o.updateInterval)
+	ticker := time.NewTicker(o.getUpdateInterval())
 	defer ticker.Stop()
 
 	for {
@@ -131,6 +356,11 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 			o.logger.Info("oracle stopped via closer")
 			return nil
 
+		case <-o.resetCh:
+			interval := o.getUpdateInterval()
+			o.logger.Info("resetting oracle update interval", zap.Duration("interval", interval))
+			ticker.Reset(interval)
+
 		case <-ticker.C:
 			o.tick()
 		}
@@ -147,6 +377,9 @@ func (o *OracleImpl) Stop() {
 	// Wait for the providers to exit.
 	err := <-o.providerCh
 	o.logger.Info("providers exited", zap.Error(err))
+
+	// Wait for the final snapshot to be written.
+	<-o.snapshotCh
 }
 
 // tick executes a single oracle tick. It fetches prices from each provider's
@@ -164,25 +397,126 @@ func (o *OracleImpl) tick() {
 	o.priceAggregator.ResetProviderData()
 
 	// Retrieve the latest prices from each provider.
-	for _, priceProvider := range o.providers {
-		o.fetchPrices(priceProvider)
+	providerPrices := make(map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
+	for _, priceProvider := range o.getProviders() {
+		// Skip the providers that were disabled by an operator.
+		if o.isProviderDisabled(priceProvider.Name()) {
+			o.logger.Info("skipping disabled provider", zap.String("provider", priceProvider.Name()))
+			continue
+		}
+
+		if prices := o.fetchPrices(priceProvider); prices != nil {
+			providerPrices[priceProvider.Name()] = prices
+		}
 	}
 
 	o.logger.Info("oracle fetched prices from providers")
 
+	// Pass the volumes reported alongside the prices to the volume weighter, if configured, so
+	// that they are available to the aggregate function.
+	if o.volumeWeighter != nil {
+		o.volumeWeighter.SetProviderVolumes(providerVolumes(providerPrices))
+	}
+
 	// Compute aggregated prices and update the oracle.
 	o.priceAggregator.AggregateData()
-	o.setLastSyncTime(time.Now().UTC())
 
-	// update the last sync time
+	// Hold any prices that moved too far and smooth the aggregated prices, if configured. The
+	// circuit breaker runs on the raw prices so that smoothing cannot mask or delay a spike, and
+	// held prices are smoothed in place of the spike.
+	rawPrices := o.priceAggregator.GetAggregatedData()
+	prices := rawPrices
+	if o.circuitBreaker != nil {
+		prices = o.circuitBreaker.Apply(prices, o.priceAggregator.GetProviderData())
+	}
+
+	if o.priceSmoother != nil {
+		prices = o.priceSmoother.Smooth(prices)
+	}
+
+	var withheldPrices map[oracletypes.CurrencyPair]string
+	if o.priceWithholder != nil {
+		withheldPrices = o.priceWithholder.GetWithheldPrices()
+	}
+	prices, withheldPrices = o.withholdPairs(prices, withheldPrices)
+
+	var conversionPaths map[oracletypes.CurrencyPair][]config.Conversions
+	if o.conversionPathResolver != nil {
+		conversionPaths = o.conversionPathResolver.GetConversionPaths()
+	}
+
+	now := time.Now().UTC()
+	o.setLastSync(PriceDetails{
+		Timestamp:       now,
+		Prices:          prices,
+		RawPrices:       rawPrices,
+		ProviderPrices:  providerPrices,
+		Withheld:        withheldPrices,
+		ConversionPaths: conversionPaths,
+	})
+
+	// Record the reported prices in the price history, if enabled.
+	o.priceHistory.record(PriceHistoryEntry{
+		Timestamp:      now,
+		Prices:         prices,
+		ProviderPrices: providerPrices,
+	})
+
+	// update the last sync time and the aggregated price of each currency pair
 	o.metrics.AddTick()
+	for cp, price := range prices {
+		o.metrics.UpdateAggregatePrice(strings.ToLower(cp.String()), float64(price.Int64()))
+	}
+
+	// Notify any subscribers that the prices have been updated.
+	o.notifySubscribers()
 
 	o.logger.Info("oracle updated prices", zap.Time("last_sync", o.GetLastSyncTime()), zap.Int("num_prices", len(o.GetPrices())))
 }
 
+// SubscribePrices returns a channel that is signalled every time the oracle updates its prices.
+// The channel is buffered such that a slow subscriber only misses intermediate updates rather
+// than blocking the oracle. The subscription is removed and the channel is closed once the
+// context is cancelled.
+func (o *OracleImpl) SubscribePrices(ctx context.Context) <-chan struct{} {
+	ch := make(chan struct{}, 1)
+
+	o.subscriberMtx.Lock()
+	o.subscribers[ch] = struct{}{}
+	o.subscriberMtx.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		o.subscriberMtx.Lock()
+		delete(o.subscribers, ch)
+		close(ch)
+		o.subscriberMtx.Unlock()
+	}()
+
+	return ch
+}
+
+// notifySubscribers signals all of the price subscribers that the prices have been updated.
+func (o *OracleImpl) notifySubscribers() {
+	o.subscriberMtx.Lock()
+	defer o.subscriberMtx.Unlock()
+
+	for ch := range o.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
 // fetchPrices retrieves the latest prices from a given provider and updates the aggregator
-// iff the price age is less than the update interval.
-func (o *OracleImpl) fetchPrices(provider providertypes.Provider[oracletypes.CurrencyPair, *big.Int]) {
+// iff the price age is less than the max price age configured for the provider and currency
+// pair (defaulting to the update interval). The prices that were added to the
+// aggregator are returned.
+func (o *OracleImpl) fetchPrices(
+	provider providertypes.Provider[oracletypes.CurrencyPair, *big.Int],
+) map[oracletypes.CurrencyPair]providertypes.Result[*big.Int] {
 	defer func() {
 		if r := recover(); r != nil {
 			o.logger.Error("provider panicked", zap.Error(fmt.Errorf("%v", r)))
@@ -191,30 +525,56 @@ func (o *OracleImpl) fetchPrices(provider providertypes.Provider[oracletypes.Cur
 
 	o.logger.Info("retrieving prices", zap.String("provider", provider.Name()), zap.String("data handler type", string(provider.Type())))
 
-	// Fetch and set prices from the provider.
+	// Fetch and set prices from the provider, along with any prices restored from the snapshot.
 	prices := provider.GetData()
+	if prices != nil {
+		prices = o.withRestoredPrices(provider.Name(), prices)
+	}
 	if prices == nil {
 		o.logger.Info("provider returned nil prices", zap.String("provider", provider.Name()), zap.String("data handler type", string(provider.Type())))
-		return
+		return nil
 	}
 
+	updateInterval := o.getUpdateInterval()
+	providerCfg := o.getProviderConfig(provider.Name())
+	excludedPairs := o.getExcludedPairs(provider.Name())
+
+	timeFilteredResults := make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
 	timeFilteredPrices := make(map[oracletypes.CurrencyPair]*big.Int)
 	for pair, result := range prices {
+		// Skip the currency pairs that were excluded on the provider by an operator.
+		if _, ok := excludedPairs[pair]; ok {
+			o.logger.Debug(
+				"skipping excluded pair",
+				zap.String("provider", provider.Name()),
+				zap.String("pair", pair.String()),
+			)
+			continue
+		}
+
 		floatValue, _ := result.Value.Float64() // we ignore the accuracy in this conversion
 
 		// update price metric
 		o.metrics.UpdatePrice(provider.Name(), string(provider.Type()), pair.String(), floatValue)
 
-		// If the price is older than the update interval, skip it.
+		// If the price is older than the max price age, skip it. Restored prices are dropped
+		// once they exceed the max price age.
 		diff := time.Now().UTC().Sub(result.Timestamp)
-		if diff > o.updateInterval {
+		if maxAge := providerCfg.GetMaxPriceAge(pair, updateInterval); diff > maxAge {
+			if result.Restored {
+				o.dropRestoredPrice(provider.Name(), pair)
+				continue
+			}
+
 			o.logger.Debug(
-				"skipping price",
+				"skipping stale price",
 				zap.String("provider", provider.Name()),
 				zap.String("data handler type", string(provider.Type())),
 				zap.String("pair", pair.String()),
 				zap.Duration("diff", diff),
+				zap.Duration("max_age", maxAge),
 			)
+			o.metrics.AddStalePrice(provider.Name(), string(provider.Type()), pair.String())
 			continue
 		}
 
@@ -226,6 +586,7 @@ func (o *OracleImpl) fetchPrices(provider providertypes.Provider[oracletypes.Cur
 			zap.String("price", result.Value.String()),
 			zap.Duration("diff", diff),
 		)
+		timeFilteredResults[pair] = result
 		timeFilteredPrices[pair] = result.Value
 	}
 
@@ -235,6 +596,74 @@ func (o *OracleImpl) fetchPrices(provider providertypes.Provider[oracletypes.Cur
 		zap.Int("prices", len(prices)),
 	)
 	o.priceAggregator.SetProviderData(provider.Name(), timeFilteredPrices)
+
+	return timeFilteredResults
+}
+
+// getProviders returns a copy of the current set of providers.
+func (o *OracleImpl) getProviders() []providertypes.Provider[oracletypes.CurrencyPair, *big.Int] {
+	o.providerMtx.Lock()
+	defer o.providerMtx.Unlock()
+
+	providers := make([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int], len(o.providers))
+	copy(providers, o.providers)
+
+	return providers
+}
+
+// GetProviderNames returns the names of the providers that the oracle fetches prices from.
+func (o *OracleImpl) GetProviderNames() []string {
+	providers := o.getProviders()
+
+	names := make([]string, len(providers))
+	for i, p := range providers {
+		names[i] = p.Name()
+	}
+
+	return names
+}
+
+// getProviderConfig returns the config of the provider with the given name. The zero value is
+// returned if the oracle has no config for the provider.
+func (o *OracleImpl) getProviderConfig(name string) config.ProviderConfig {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.providerConfigs[name]
+}
+
+// setProviderConfigs sets the config of each provider.
+func (o *OracleImpl) setProviderConfigs(providers []config.ProviderConfig) {
+	providerConfigs := make(map[string]config.ProviderConfig, len(providers))
+	for _, p := range providers {
+		providerConfigs[p.Name] = p
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.providerConfigs = providerConfigs
+}
+
+// getUpdateInterval returns the interval at which the oracle updates prices.
+func (o *OracleImpl) getUpdateInterval() time.Duration {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.updateInterval
+}
+
+// setUpdateInterval sets the interval at which the oracle updates prices and signals the
+// main loop to reset its ticker.
+func (o *OracleImpl) setUpdateInterval(interval time.Duration) {
+	o.mtx.Lock()
+	o.updateInterval = interval
+	o.mtx.Unlock()
+
+	select {
+	case o.resetCh <- struct{}{}:
+	default:
+	}
 }
 
 // GetLastSyncTime returns the last time the oracle successfully updated prices.
@@ -245,24 +674,139 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 	return o.lastPriceSync
 }
 
-// setLastSyncTime sets the last time the oracle successfully updated prices.
-func (o *OracleImpl) setLastSyncTime(t time.Time) {
+// setLastSync sets the last time the oracle successfully updated prices along with the prices,
+// the provider prices that were used to compute them, the prices that were withheld and the
+// conversion paths, such that readers never observe a mix of two updates. The decimals of the
+// update are ignored.
+func (o *OracleImpl) setLastSync(update PriceDetails) {
 	o.mtx.Lock()
 	defer o.mtx.Unlock()
 
-	o.lastPriceSync = t
+	o.lastPriceSync = update.Timestamp
+	o.reportedPrices = update.Prices
+	o.rawPrices = update.RawPrices
+	o.providerPrices = update.ProviderPrices
+	o.withheldPrices = update.Withheld
+	o.conversionPaths = update.ConversionPaths
 }
 
-// GetPrices returns the aggregate prices from the oracle.
-func (o *OracleImpl) GetPrices() map[oracletypes.CurrencyPair]*big.Int {
-	prices := o.priceAggregator.GetAggregatedData()
+// GetPriceDetails returns the prices, raw prices, provider prices, withheld prices and conversion
+// paths of the latest oracle update, along with the decimals of each currency pair, all read under
+// a single lock such that they are consistent with one another.
+func (o *OracleImpl) GetPriceDetails() PriceDetails {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
 
-	// set metrics in background
-	go func() {
-		for cp, price := range prices {
-			o.metrics.UpdateAggregatePrice(strings.ToLower(cp.String()), float64(price.Int64()))
+	return PriceDetails{
+		Timestamp:       o.lastPriceSync,
+		Prices:          o.reportedPrices,
+		RawPrices:       o.rawPrices,
+		ProviderPrices:  o.providerPrices,
+		Withheld:        o.withheldPrices,
+		Decimals:        o.getDecimals(),
+		ConversionPaths: o.conversionPaths,
+	}
+}
+
+// providerVolumes returns the 24 hour volumes reported alongside the given provider prices.
+// Prices without a reported volume are omitted.
+func providerVolumes(
+	providerPrices map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int],
+) aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float] {
+	volumes := make(aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float])
+	for provider, prices := range providerPrices {
+		for cp, result := range prices {
+			if result.Volume == nil {
+				continue
+			}
+
+			if _, ok := volumes[provider]; !ok {
+				volumes[provider] = make(map[oracletypes.CurrencyPair]*big.Float)
+			}
+
+			volumes[provider][cp] = result.Volume
 		}
-	}()
+	}
+
+	return volumes
+}
+
+// GetWithheldPrices returns the currency pairs whose prices were withheld in the latest oracle
+// update, along with the reason each price was withheld i.e. insufficient provider quorum.
+func (o *OracleImpl) GetWithheldPrices() map[oracletypes.CurrencyPair]string {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.withheldPrices
+}
+
+// GetProviderPrices returns the prices (along with their timestamps) reported by each provider
+// that were used to compute the latest aggregated prices. Prices that were too old to be included
+// in the aggregation are omitted.
+func (o *OracleImpl) GetProviderPrices() map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int] {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.providerPrices
+}
+
+// GetDecimals returns the number of decimals that the aggregated price of each currency pair in the
+// market config is reported with.
+func (o *OracleImpl) GetDecimals() map[oracletypes.CurrencyPair]uint64 {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.getDecimals()
+}
 
-	return prices
+// getDecimals returns the decimals of each currency pair in the market config. The caller must hold
+// the general lock.
+func (o *OracleImpl) getDecimals() map[oracletypes.CurrencyPair]uint64 {
+	decimals := make(map[oracletypes.CurrencyPair]uint64, len(o.cfg.Market.AggregatedFeeds))
+	for _, feed := range o.cfg.Market.AggregatedFeeds {
+		decimals[feed.CurrencyPair] = o.cfg.Market.GetDecimals(feed.CurrencyPair)
+	}
+
+	return decimals
+}
+
+// GetRawPrices returns the latest aggregate prices from the oracle before smoothing and circuit
+// breaking. This is mostly used for debugging the price smoother and circuit breaker.
+func (o *OracleImpl) GetRawPrices() map[oracletypes.CurrencyPair]*big.Int {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.rawPrices
+}
+
+// getReportedPrices returns the latest prices reported by the oracle i.e. the prices after smoothing
+// and circuit breaking, if configured, and the aggregated prices otherwise.
+func (o *OracleImpl) getReportedPrices() map[oracletypes.CurrencyPair]*big.Int {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.reportedPrices
+}
+
+// GetPriceHistory returns the prices reported by the oracle, along with the provider prices they
+// were derived from, for each oracle update within the given time range (inclusive), oldest first.
+// A zero start or end leaves the range unbounded on that side. Nothing is returned unless the price
+// history is enabled.
+func (o *OracleImpl) GetPriceHistory(start, end time.Time) []PriceHistoryEntry {
+	return o.priceHistory.query(start, end)
+}
+
+// getSnapshotConfig returns the snapshot config that the oracle is running with.
+func (o *OracleImpl) getSnapshotConfig() config.SnapshotConfig {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.cfg.Snapshot
+}
+
+// GetPrices returns the aggregate prices from the oracle. If a price smoother or circuit breaker
+// is configured, the smoothed prices - with the held price of any tripped circuit breaker - are
+// returned.
+func (o *OracleImpl) GetPrices() map[oracletypes.CurrencyPair]*big.Int {
+	return o.getReportedPrices()
 }
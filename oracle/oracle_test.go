@@ -2,19 +2,26 @@ package oracle_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/aggregator"
 	"github.com/skip-mev/slinky/oracle"
 	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
+	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
 	"github.com/skip-mev/slinky/providers/base/testutils"
 	providertypes "github.com/skip-mev/slinky/providers/types"
+	providermocks "github.com/skip-mev/slinky/providers/types/mocks"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
 
@@ -129,6 +136,7 @@ func (s *OracleTestSuite) TestStopWithContextCancel() {
 					time.Second,
 					providerCfg2,
 					s.logger,
+					s.currencyPairs,
 					nil,
 				)
 
@@ -228,6 +236,7 @@ func (s *OracleTestSuite) TestStopWithContextDeadline() {
 					time.Second,
 					providerCfg2,
 					s.logger,
+					s.currencyPairs,
 					nil,
 				)
 
@@ -315,6 +324,7 @@ func (s *OracleTestSuite) TestStop() {
 					time.Second,
 					providerCfg2,
 					s.logger,
+					s.currencyPairs,
 					nil,
 				)
 
@@ -359,6 +369,404 @@ func (s *OracleTestSuite) TestStop() {
 	}
 }
 
+func (s *OracleTestSuite) TestSubscribePrices() {
+	o, err := oracle.New(
+		oracle.WithUpdateInterval(100*time.Millisecond),
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{s.noStartProvider("provider1")}),
+	)
+	s.Require().NoError(err)
+
+	subCtx, subCancel := context.WithCancel(context.Background())
+	updates := o.SubscribePrices(subCtx)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go o.Start(ctx)
+
+	// The subscriber should be notified after every tick.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-updates:
+			s.Require().False(o.GetLastSyncTime().IsZero())
+		case <-time.After(2 * time.Second):
+			s.T().Fatal("timed out waiting for price update")
+		}
+	}
+
+	// The channel should be closed once the subscription is cancelled.
+	subCancel()
+	s.Require().Eventually(func() bool {
+		select {
+		case _, ok := <-updates:
+			return !ok
+		default:
+			return false
+		}
+	}, 2*time.Second, 10*time.Millisecond)
+
+	o.Stop()
+}
+
+func (s *OracleTestSuite) TestGetProviderPrices() {
+	fresh := providertypes.Result[*big.Int]{
+		Value:     big.NewInt(100),
+		Timestamp: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	stale := providertypes.Result[*big.Int]{
+		Value:     big.NewInt(200),
+		Timestamp: time.Date(1738, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		s.currencyPairs[0]: fresh,
+		s.currencyPairs[1]: stale,
+	}).Maybe()
+
+	o, err := oracle.New(
+		oracle.WithUpdateInterval(100*time.Millisecond),
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{
+			provider,
+			s.noStartProvider("provider2"),
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Nil(o.GetProviderPrices())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	// Only the prices that were used to compute the aggregated prices should be returned.
+	s.Require().Equal(map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		"provider1": {
+			s.currencyPairs[0]: fresh,
+		},
+	}, o.GetProviderPrices())
+
+	o.Stop()
+}
+
+func (s *OracleTestSuite) TestMaxPriceAge() {
+	btc := s.currencyPairs[0]
+	eth := s.currencyPairs[1]
+	atom := s.currencyPairs[2]
+
+	// The provider allows prices that are up to 10 seconds old, except for ETHEREUM/USD which
+	// must be at most 1 second old.
+	providerCfg := reloadProviderConfig("provider1", time.Second, btc, eth, atom)
+	providerCfg.MaxPriceAge = 10 * time.Second
+	ethCfg := providerCfg.Market.CurrencyPairToMarketConfigs[eth.String()]
+	ethCfg.MaxPriceAge = time.Second
+	providerCfg.Market.CurrencyPairToMarketConfigs[eth.String()] = ethCfg
+
+	cfg := config.OracleConfig{
+		UpdateInterval: 100 * time.Millisecond,
+		Providers:      []config.ProviderConfig{providerCfg},
+		Market:         reloadMarketConfig(btc, eth, atom),
+	}
+
+	old := providertypes.Result[*big.Int]{
+		Value:     big.NewInt(100),
+		Timestamp: time.Now().UTC().Add(-5 * time.Second),
+	}
+	veryOld := providertypes.Result[*big.Int]{
+		Value:     big.NewInt(100),
+		Timestamp: time.Now().UTC().Add(-time.Minute),
+	}
+
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		btc:  old,
+		eth:  old,
+		atom: veryOld,
+	}).Maybe()
+
+	metrics := metricmocks.NewMetrics(s.T())
+	metrics.On("AddTick").Maybe()
+	metrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	metrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything).Maybe()
+	metrics.On("AddStalePrice", "provider1", string(providertypes.API), eth.String())
+	metrics.On("AddStalePrice", "provider1", string(providertypes.API), atom.String())
+
+	o, err := oracle.New(
+		oracle.WithConfig(cfg),
+		oracle.WithLogger(s.logger),
+		oracle.WithMetrics(metrics),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	// Only the price that is within the provider's max price age is kept.
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+	}, o.GetPrices())
+
+	o.Stop()
+}
+
+func (s *OracleTestSuite) TestVolumeWeightedMedian() {
+	btc := s.currencyPairs[0]
+	eth := s.currencyPairs[1]
+	ethbtc := oracletypes.NewCurrencyPair("ETHEREUM", "BITCOIN")
+	timestamp := time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newProvider := func(name string, prices map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]) *providermocks.Provider[oracletypes.CurrencyPair, *big.Int] {
+		provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+		provider.On("Name").Return(name).Maybe()
+		provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+		provider.On("Type").Return(providertypes.WebSockets).Maybe()
+		provider.On("GetData").Return(prices).Maybe()
+
+		return provider
+	}
+
+	// BITCOIN/USD is volume-weighted, and ETHEREUM/USD is converted from ETHEREUM/BITCOIN and
+	// BITCOIN/USD.
+	median, err := oraclemath.NewMedianAggregator(s.logger, config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btc.String():    {CurrencyPair: btc, VolumeWeighted: true},
+			ethbtc.String(): {CurrencyPair: ethbtc, VolumeWeighted: true},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btc.String(): {
+				CurrencyPair: btc,
+				Conversions:  []config.Conversions{{{CurrencyPair: btc}}},
+			},
+			eth.String(): {
+				CurrencyPair: eth,
+				Conversions:  []config.Conversions{{{CurrencyPair: ethbtc}, {CurrencyPair: btc}}},
+			},
+		},
+	}, oraclemetrics.NewNopMetrics())
+	s.Require().NoError(err)
+
+	// The deep venue reports a higher BITCOIN/USD price with far more volume than the two illiquid
+	// venues. The provider of ETHEREUM/BITCOIN does not report a volume, so it is not dropped.
+	o, err := oracle.New(
+		oracle.WithUpdateInterval(100*time.Millisecond),
+		oracle.WithLogger(s.logger),
+		oracle.WithAggregateFunction(median.AggregateFn()),
+		oracle.WithVolumeWeighter(median),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{
+			newProvider("illiquid1", map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+				btc: providertypes.NewResult[*big.Int](big.NewInt(100e8), timestamp).WithVolume(big.NewFloat(1)),
+			}),
+			newProvider("illiquid2", map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+				btc: providertypes.NewResult[*big.Int](big.NewInt(101e8), timestamp).WithVolume(big.NewFloat(1)),
+			}),
+			newProvider("deep", map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+				btc:    providertypes.NewResult[*big.Int](big.NewInt(110e8), timestamp).WithVolume(big.NewFloat(1000)),
+				ethbtc: providertypes.NewResult[*big.Int](big.NewInt(5e6), timestamp),
+			}),
+		}),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(110e8),
+		eth: big.NewInt(55e7),
+	}, o.GetPrices())
+
+	o.Stop()
+}
+
+// doublingSmoother is a price smoother that doubles every price.
+type doublingSmoother struct{}
+
+func (doublingSmoother) UpdateMarketConfig(config.AggregateMarketConfig) error {
+	return nil
+}
+
+func (doublingSmoother) Smooth(prices map[oracletypes.CurrencyPair]*big.Int) map[oracletypes.CurrencyPair]*big.Int {
+	smoothed := make(map[oracletypes.CurrencyPair]*big.Int, len(prices))
+	for cp, price := range prices {
+		smoothed[cp] = new(big.Int).Mul(price, big.NewInt(2))
+	}
+
+	return smoothed
+}
+
+// staticConversionPaths is a conversion path resolver that always reports the same conversion paths.
+type staticConversionPaths map[oracletypes.CurrencyPair][]config.Conversions
+
+func (p staticConversionPaths) GetConversionPaths() map[oracletypes.CurrencyPair][]config.Conversions {
+	return p
+}
+
+func (s *OracleTestSuite) TestPriceSmoother() {
+	btc := s.currencyPairs[0]
+	paths := staticConversionPaths{
+		btc: {{{CurrencyPair: btc}}},
+	}
+
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		btc: providertypes.NewResult[*big.Int](big.NewInt(100), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}).Maybe()
+
+	o, err := oracle.New(
+		oracle.WithUpdateInterval(100*time.Millisecond),
+		oracle.WithLogger(s.logger),
+		oracle.WithPriceSmoother(doublingSmoother{}),
+		oracle.WithConversionPathResolver(paths),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	// The smoothed prices are reported, while the raw prices remain available.
+	details := o.GetPriceDetails()
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(200),
+	}, details.Prices)
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+	}, details.RawPrices)
+
+	// The price details are taken from a single update.
+	s.Require().Equal(o.GetPrices(), details.Prices)
+	s.Require().Equal(o.GetRawPrices(), details.RawPrices)
+	s.Require().Equal(o.GetLastSyncTime(), details.Timestamp)
+	s.Require().Equal(o.GetProviderPrices(), details.ProviderPrices)
+	s.Require().Equal(map[oracletypes.CurrencyPair][]config.Conversions(paths), details.ConversionPaths)
+
+	o.Stop()
+}
+
+// holdingCircuitBreaker is a circuit breaker that holds every price at a fixed price, and records
+// the prices it was applied to.
+type holdingCircuitBreaker struct {
+	mtx            sync.Mutex
+	held           *big.Int
+	prices         map[oracletypes.CurrencyPair]*big.Int
+	providerPrices map[string]map[oracletypes.CurrencyPair]*big.Int
+}
+
+func (b *holdingCircuitBreaker) UpdateMarketConfig(config.AggregateMarketConfig) error {
+	return nil
+}
+
+func (b *holdingCircuitBreaker) Apply(
+	prices map[oracletypes.CurrencyPair]*big.Int,
+	providerPrices aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
+) map[oracletypes.CurrencyPair]*big.Int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.prices = prices
+	b.providerPrices = providerPrices
+
+	held := make(map[oracletypes.CurrencyPair]*big.Int, len(prices))
+	for cp := range prices {
+		held[cp] = b.held
+	}
+
+	return held
+}
+
+func (s *OracleTestSuite) TestCircuitBreaker() {
+	btc := s.currencyPairs[0]
+
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		btc: providertypes.NewResult[*big.Int](big.NewInt(100), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}).Maybe()
+
+	breaker := &holdingCircuitBreaker{held: big.NewInt(42)}
+	o, err := oracle.New(
+		oracle.WithUpdateInterval(100*time.Millisecond),
+		oracle.WithLogger(s.logger),
+		oracle.WithPriceSmoother(doublingSmoother{}),
+		oracle.WithCircuitBreaker(breaker),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	// The held prices are smoothed and reported, while the raw prices remain available.
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(84),
+	}, o.GetPrices())
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+	}, o.GetRawPrices())
+
+	o.Stop()
+
+	// The circuit breaker is applied to the raw prices before smoothing, along with the provider
+	// prices.
+	breaker.mtx.Lock()
+	defer breaker.mtx.Unlock()
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+	}, breaker.prices)
+	s.Require().Equal(big.NewInt(100), breaker.providerPrices["provider1"][btc])
+}
+
 func checkFn(o oracle.Oracle) func() bool {
 	return func() bool {
 		return !o.IsRunning()
@@ -0,0 +1,241 @@
+package pairsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// WithheldDecimalsMismatch is the reason given for withholding the price of a currency pair when
+// the oracle is configured to report it with different decimals than the x/oracle module stores.
+const WithheldDecimalsMismatch = "decimals_mismatch"
+
+// CurrencyPairUpdater defines the interface for a component whose set of currency pairs can be
+// updated i.e. the oracle.
+type CurrencyPairUpdater interface {
+	// UpdateCurrencyPairs updates the set of currency pairs and returns the currency pairs that
+	// cannot be priced because no provider has a ticker mapping for them.
+	UpdateCurrencyPairs([]oracletypes.CurrencyPair) []oracletypes.CurrencyPair
+
+	// GetDecimals returns the number of decimals that the price of each currency pair is
+	// reported with.
+	GetDecimals() map[oracletypes.CurrencyPair]uint64
+
+	// WithholdPrices withholds the prices of the given currency pairs, along with the reason
+	// each price is withheld. This replaces the currency pairs withheld by the previous call.
+	WithholdPrices(map[oracletypes.CurrencyPair]string)
+}
+
+// Syncer periodically queries a node for all of the currency pairs tracked by the x/oracle
+// module and updates the oracle's set of currency pairs accordingly. This keeps the oracle in
+// sync with on chain state after currency pairs are added or removed via governance. If syncing
+// is disabled, the syncer only checks the decimals of the oracle's currency pairs against the
+// decimals on chain.
+type Syncer struct {
+	logger *zap.Logger
+
+	// cfg is the config for the syncer.
+	cfg config.CurrencyPairSyncConfig
+
+	// client is the x/oracle query client used to fetch the on chain currency pairs.
+	client oracletypes.QueryClient
+
+	// conn is the underlying grpc connection. This is only set if the syncer created the
+	// connection itself, in which case it is closed when the syncer exits.
+	conn *grpc.ClientConn
+
+	// updater is updated with the on chain currency pairs.
+	updater CurrencyPairUpdater
+
+	// unmapped is the set of currency pairs that were not mapped by any provider on the
+	// last sync.
+	unmapped []oracletypes.CurrencyPair
+
+	// mismatched is the set of currency pairs whose decimals did not match the decimals on
+	// chain on the last sync.
+	mismatched []oracletypes.CurrencyPair
+}
+
+// NewSyncerFromConfig returns a new Syncer that connects to the node configured in the given
+// config. The node address must be set, but syncing may be disabled.
+func NewSyncerFromConfig(
+	logger *zap.Logger,
+	cfg config.CurrencyPairSyncConfig,
+	updater CurrencyPairUpdater,
+) (*Syncer, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair sync config: %w", err)
+	}
+
+	if len(cfg.Address) == 0 {
+		return nil, fmt.Errorf("currency pair sync node address is not set")
+	}
+
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial node gRPC server: %w", err)
+	}
+
+	s, err := NewSyncer(logger, cfg, oracletypes.NewQueryClient(conn), updater)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	s.conn = conn
+	return s, nil
+}
+
+// NewSyncer returns a new Syncer that queries the on chain currency pairs with the given client.
+func NewSyncer(
+	logger *zap.Logger,
+	cfg config.CurrencyPairSyncConfig,
+	client oracletypes.QueryClient,
+	updater CurrencyPairUpdater,
+) (*Syncer, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair sync config: %w", err)
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+
+	if updater == nil {
+		return nil, fmt.Errorf("currency pair updater cannot be nil")
+	}
+
+	return &Syncer{
+		logger:  logger.With(zap.String("process", "currency_pair_sync")),
+		cfg:     cfg,
+		client:  client,
+		updater: updater,
+	}, nil
+}
+
+// Start syncs the currency pairs every interval until the context is cancelled. Failed syncs
+// are logged and retried on the next interval; the oracle keeps fetching prices for the last
+// set of currency pairs in the meantime.
+func (s *Syncer) Start(ctx context.Context) error {
+	s.logger.Info(
+		"starting currency pair syncer",
+		zap.String("address", s.cfg.Address),
+		zap.Duration("interval", s.cfg.Interval),
+		zap.Bool("sync_currency_pairs", s.cfg.Enabled),
+	)
+
+	if s.conn != nil {
+		defer s.conn.Close()
+	}
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sync(ctx); err != nil {
+			s.logger.Error("failed to sync currency pairs", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping currency pair syncer")
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync queries the node for the on chain currency pairs and, if syncing is enabled, updates
+// the oracle's set of currency pairs. Currency pairs that have no ticker mapping in any provider
+// are logged. The prices of currency pairs whose configured decimals do not match their decimals
+// on chain are withheld, since the chain would misinterpret them.
+func (s *Syncer) Sync(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	resp, err := s.client.GetAllCurrencyPairs(ctx, &oracletypes.GetAllCurrencyPairsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to query on chain currency pairs: %w", err)
+	}
+
+	if s.cfg.Enabled {
+		s.syncCurrencyPairs(resp.CurrencyPairs)
+	}
+
+	s.syncDecimals(ctx, resp.CurrencyPairs)
+	return nil
+}
+
+// syncCurrencyPairs updates the oracle's set of currency pairs with the given on chain currency
+// pairs.
+func (s *Syncer) syncCurrencyPairs(cps []oracletypes.CurrencyPair) {
+	unmapped := s.updater.UpdateCurrencyPairs(cps)
+	s.logger.Debug("synced currency pairs", zap.Int("num_currency_pairs", len(cps)))
+
+	// Only report the unmapped currency pairs when they change to avoid flooding the logs.
+	if !slices.Equal(unmapped, s.unmapped) && len(unmapped) > 0 {
+		s.logger.Warn("on chain currency pairs have no ticker mapping in any provider", zap.Stringers("currency_pairs", unmapped))
+	}
+	s.unmapped = unmapped
+}
+
+// syncDecimals queries the node for the decimals of the given on chain currency pairs that the
+// oracle reports a price for, and withholds the prices of the currency pairs whose configured
+// decimals do not match. Each currency pair is queried on its own, so that a currency pair the
+// node fails to return (i.e. one without a price yet) does not prevent the others from being
+// checked. A currency pair that fails to be queried keeps the result of its last check.
+func (s *Syncer) syncDecimals(ctx context.Context, cps []oracletypes.CurrencyPair) {
+	decimals := s.updater.GetDecimals()
+	withheld := make(map[oracletypes.CurrencyPair]string)
+	mismatched := make([]oracletypes.CurrencyPair, 0)
+	for _, cp := range cps {
+		configured, ok := decimals[cp]
+		if !ok {
+			continue
+		}
+
+		resp, err := s.client.GetPrice(ctx, &oracletypes.GetPriceRequest{
+			CurrencyPairSelector: &oracletypes.GetPriceRequest_CurrencyPairId{CurrencyPairId: cp.String()},
+		})
+		switch {
+		case err != nil:
+			s.logger.Debug(
+				"failed to query on chain decimals; keeping the last result",
+				zap.String("currency_pair", cp.String()),
+				zap.Error(err),
+			)
+
+			if !slices.Contains(s.mismatched, cp) {
+				continue
+			}
+		case configured == resp.Decimals:
+			continue
+		case !slices.Contains(s.mismatched, cp):
+			// Only report the mismatched currency pairs when they change to avoid flooding the logs.
+			s.logger.Error(
+				"withholding price; configured decimals do not match the decimals on chain",
+				zap.String("currency_pair", cp.String()),
+				zap.Uint64("decimals", configured),
+				zap.Uint64("chain_decimals", resp.Decimals),
+			)
+		}
+
+		withheld[cp] = WithheldDecimalsMismatch
+		mismatched = append(mismatched, cp)
+	}
+
+	s.updater.WithholdPrices(withheld)
+	s.mismatched = mismatched
+}
@@ -0,0 +1,341 @@
+package pairsync_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/pairsync"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+var (
+	logger = zap.NewExample()
+	cfg    = config.CurrencyPairSyncConfig{
+		Enabled:  true,
+		Address:  "bufnet",
+		Interval: 50 * time.Millisecond,
+		Timeout:  50 * time.Millisecond,
+	}
+
+	btc = oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth = oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+)
+
+// node is an in-process stand-in for the x/oracle query server of a node.
+type node struct {
+	oracletypes.UnimplementedQueryServer
+
+	mtx      sync.Mutex
+	pairs    []oracletypes.CurrencyPair
+	decimals map[oracletypes.CurrencyPair]uint64
+	unpriced map[oracletypes.CurrencyPair]bool
+	err      error
+}
+
+func (n *node) GetAllCurrencyPairs(
+	context.Context,
+	*oracletypes.GetAllCurrencyPairsRequest,
+) (*oracletypes.GetAllCurrencyPairsResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if n.err != nil {
+		return nil, n.err
+	}
+
+	return &oracletypes.GetAllCurrencyPairsResponse{CurrencyPairs: n.pairs}, nil
+}
+
+func (n *node) GetPrice(
+	_ context.Context,
+	req *oracletypes.GetPriceRequest,
+) (*oracletypes.GetPriceResponse, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	cp, err := oracletypes.CurrencyPairFromString(req.GetCurrencyPairId())
+	if err != nil {
+		return nil, err
+	}
+
+	if n.unpriced[cp] {
+		return nil, fmt.Errorf("no price / nonce reported for CurrencyPair: %v", cp)
+	}
+
+	decimals, ok := n.decimals[cp]
+	if !ok {
+		decimals = cp.LegacyDecimals()
+	}
+
+	return &oracletypes.GetPriceResponse{Decimals: decimals}, nil
+}
+
+func (n *node) setUnpriced(unpriced map[oracletypes.CurrencyPair]bool) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.unpriced = unpriced
+}
+
+func (n *node) setDecimals(decimals map[oracletypes.CurrencyPair]uint64) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.decimals = decimals
+}
+
+func (n *node) set(pairs []oracletypes.CurrencyPair, err error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.pairs = pairs
+	n.err = err
+}
+
+// updater records the currency pairs it is updated with, along with the prices it withholds.
+type updater struct {
+	mtx      sync.Mutex
+	pairs    []oracletypes.CurrencyPair
+	updates  int
+	withheld map[oracletypes.CurrencyPair]string
+}
+
+func (u *updater) GetDecimals() map[oracletypes.CurrencyPair]uint64 {
+	// Bitcoin is reported with 10 decimals, while ethereum is not an aggregated feed.
+	return map[oracletypes.CurrencyPair]uint64{btc: 10}
+}
+
+func (u *updater) WithholdPrices(withheld map[oracletypes.CurrencyPair]string) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	u.withheld = withheld
+}
+
+func (u *updater) getWithheld() map[oracletypes.CurrencyPair]string {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	return u.withheld
+}
+
+func (u *updater) UpdateCurrencyPairs(cps []oracletypes.CurrencyPair) []oracletypes.CurrencyPair {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	u.pairs = cps
+	u.updates++
+
+	// Only bitcoin has a ticker mapping.
+	unmapped := make([]oracletypes.CurrencyPair, 0)
+	for _, cp := range cps {
+		if cp != btc {
+			unmapped = append(unmapped, cp)
+		}
+	}
+
+	return unmapped
+}
+
+func (u *updater) get() ([]oracletypes.CurrencyPair, int) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	return u.pairs, u.updates
+}
+
+// startNode starts the given node on an in-memory listener and returns a client connected to it.
+func startNode(t *testing.T, n *node) oracletypes.QueryClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	oracletypes.RegisterQueryServer(srv, n)
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return oracletypes.NewQueryClient(conn)
+}
+
+func TestNewSyncer(t *testing.T) {
+	client := startNode(t, &node{})
+
+	t.Run("valid syncer", func(t *testing.T) {
+		_, err := pairsync.NewSyncer(logger, cfg, client, &updater{})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		invalid := cfg
+		invalid.Address = ""
+
+		_, err := pairsync.NewSyncer(logger, invalid, client, &updater{})
+		require.Error(t, err)
+	})
+
+	t.Run("nil client", func(t *testing.T) {
+		_, err := pairsync.NewSyncer(logger, cfg, nil, &updater{})
+		require.Error(t, err)
+	})
+
+	t.Run("nil updater", func(t *testing.T) {
+		_, err := pairsync.NewSyncer(logger, cfg, client, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("no node address from config", func(t *testing.T) {
+		_, err := pairsync.NewSyncerFromConfig(logger, config.CurrencyPairSyncConfig{}, &updater{})
+		require.Error(t, err)
+	})
+}
+
+func TestSync(t *testing.T) {
+	n := &node{
+		pairs:    []oracletypes.CurrencyPair{btc},
+		decimals: map[oracletypes.CurrencyPair]uint64{btc: 10},
+	}
+	u := &updater{}
+
+	syncer, err := pairsync.NewSyncer(logger, cfg, startNode(t, n), u)
+	require.NoError(t, err)
+
+	t.Run("syncs the on chain currency pairs", func(t *testing.T) {
+		require.NoError(t, syncer.Sync(context.Background()))
+
+		pairs, updates := u.get()
+		require.Equal(t, []oracletypes.CurrencyPair{btc}, pairs)
+		require.Equal(t, 1, updates)
+	})
+
+	t.Run("query errors do not update the currency pairs", func(t *testing.T) {
+		n.set(nil, fmt.Errorf("node is unavailable"))
+		require.Error(t, syncer.Sync(context.Background()))
+
+		pairs, updates := u.get()
+		require.Equal(t, []oracletypes.CurrencyPair{btc}, pairs)
+		require.Equal(t, 1, updates)
+	})
+
+	t.Run("syncs added currency pairs", func(t *testing.T) {
+		n.set([]oracletypes.CurrencyPair{btc, eth}, nil)
+		require.NoError(t, syncer.Sync(context.Background()))
+
+		pairs, updates := u.get()
+		require.Equal(t, []oracletypes.CurrencyPair{btc, eth}, pairs)
+		require.Equal(t, 2, updates)
+		require.Empty(t, u.getWithheld())
+	})
+
+	t.Run("withholds prices whose decimals do not match the decimals on chain", func(t *testing.T) {
+		n.setDecimals(map[oracletypes.CurrencyPair]uint64{btc: 8})
+		require.NoError(t, syncer.Sync(context.Background()))
+		require.Equal(t, map[oracletypes.CurrencyPair]string{
+			btc: pairsync.WithheldDecimalsMismatch,
+		}, u.getWithheld())
+
+		// The price is reported again once the decimals match.
+		n.setDecimals(map[oracletypes.CurrencyPair]uint64{btc: 10})
+		require.NoError(t, syncer.Sync(context.Background()))
+		require.Empty(t, u.getWithheld())
+	})
+
+	t.Run("currency pairs without a price keep the result of their last check", func(t *testing.T) {
+		n.setDecimals(map[oracletypes.CurrencyPair]uint64{btc: 8})
+		require.NoError(t, syncer.Sync(context.Background()))
+		require.Equal(t, map[oracletypes.CurrencyPair]string{
+			btc: pairsync.WithheldDecimalsMismatch,
+		}, u.getWithheld())
+
+		// The node fails to return the decimals of a currency pair without a price, which
+		// neither fails the sync nor releases the withheld price.
+		n.setUnpriced(map[oracletypes.CurrencyPair]bool{btc: true, eth: true})
+		n.setDecimals(map[oracletypes.CurrencyPair]uint64{btc: 10})
+		require.NoError(t, syncer.Sync(context.Background()))
+		require.Equal(t, map[oracletypes.CurrencyPair]string{
+			btc: pairsync.WithheldDecimalsMismatch,
+		}, u.getWithheld())
+
+		n.setUnpriced(nil)
+		require.NoError(t, syncer.Sync(context.Background()))
+		require.Empty(t, u.getWithheld())
+	})
+}
+
+func TestSyncDecimalsOnly(t *testing.T) {
+	n := &node{
+		pairs:    []oracletypes.CurrencyPair{btc, eth},
+		decimals: map[oracletypes.CurrencyPair]uint64{btc: 8},
+	}
+	u := &updater{}
+
+	decimalsOnly := cfg
+	decimalsOnly.Enabled = false
+
+	syncer, err := pairsync.NewSyncer(logger, decimalsOnly, startNode(t, n), u)
+	require.NoError(t, err)
+
+	// The decimals are checked, but the oracle's currency pairs are left unchanged.
+	require.NoError(t, syncer.Sync(context.Background()))
+	require.Equal(t, map[oracletypes.CurrencyPair]string{
+		btc: pairsync.WithheldDecimalsMismatch,
+	}, u.getWithheld())
+
+	pairs, updates := u.get()
+	require.Empty(t, pairs)
+	require.Zero(t, updates)
+}
+
+func TestStart(t *testing.T) {
+	n := &node{pairs: []oracletypes.CurrencyPair{btc, eth}}
+	u := &updater{}
+
+	syncer, err := pairsync.NewSyncer(logger, cfg, startNode(t, n), u)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- syncer.Start(ctx)
+	}()
+
+	// The currency pairs are synced immediately on start.
+	require.Eventually(t, func() bool {
+		pairs, _ := u.get()
+		return len(pairs) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Currency pairs removed on chain are picked up on the next interval.
+	n.set([]oracletypes.CurrencyPair{btc}, nil)
+	require.Eventually(t, func() bool {
+		pairs, _ := u.get()
+		return len(pairs) == 1 && pairs[0] == btc
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+}
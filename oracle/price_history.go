@@ -0,0 +1,119 @@
+package oracle
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// PriceHistoryEntry is the set of prices reported by the oracle in a single update, along with the
+// provider prices that they were derived from.
+type PriceHistoryEntry struct {
+	// Timestamp is the time of the oracle update.
+	Timestamp time.Time
+
+	// Prices is the set of prices reported by the oracle i.e. the prices returned by GetPrices.
+	Prices map[oracletypes.CurrencyPair]*big.Int
+
+	// ProviderPrices is the set of prices reported by each provider that were used to compute
+	// the prices i.e. the prices returned by GetProviderPrices.
+	ProviderPrices map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]
+}
+
+// priceHistory is a ring buffer of the latest oracle updates. The history is bounded by both the
+// number of entries and the age of the entries.
+type priceHistory struct {
+	mtx sync.RWMutex
+	cfg config.PriceHistoryConfig
+
+	// entries is the ring buffer of entries. The oldest entry is at index head, and the buffer
+	// holds size entries.
+	entries []PriceHistoryEntry
+	head    int
+	size    int
+}
+
+// newPriceHistory returns a new price history with the given config.
+func newPriceHistory(cfg config.PriceHistoryConfig) *priceHistory {
+	h := &priceHistory{}
+	h.setConfig(cfg)
+	return h
+}
+
+// setConfig updates the config of the price history. The latest entries that fit within the new
+// config are kept. All entries are discarded if the price history is disabled.
+func (h *priceHistory) setConfig(cfg config.PriceHistoryConfig) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	var entries []PriceHistoryEntry
+	if cfg.Enabled {
+		entries = make([]PriceHistoryEntry, cfg.MaxEntries)
+	}
+
+	// Copy over the latest entries, oldest first.
+	size := min(h.size, len(entries))
+	for i := 0; i < size; i++ {
+		entries[i] = h.entries[(h.head+h.size-size+i)%len(h.entries)]
+	}
+
+	h.cfg = cfg
+	h.entries = entries
+	h.head = 0
+	h.size = size
+}
+
+// record adds the given entry to the price history, evicting the oldest entry if the history is
+// full along with any entries that are older than the max age.
+func (h *priceHistory) record(entry PriceHistoryEntry) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if !h.cfg.Enabled {
+		return
+	}
+
+	if h.size == len(h.entries) {
+		h.entries[h.head] = PriceHistoryEntry{}
+		h.head = (h.head + 1) % len(h.entries)
+		h.size--
+	}
+
+	h.entries[(h.head+h.size)%len(h.entries)] = entry
+	h.size++
+
+	cutoff := entry.Timestamp.Add(-h.cfg.MaxAge)
+	for h.size > 0 && h.entries[h.head].Timestamp.Before(cutoff) {
+		h.entries[h.head] = PriceHistoryEntry{}
+		h.head = (h.head + 1) % len(h.entries)
+		h.size--
+	}
+}
+
+// query returns the entries whose timestamps are within the given time range (inclusive), oldest
+// first. A zero start or end leaves the range unbounded on that side. Entries that are older than
+// the max age are omitted.
+func (h *priceHistory) query(start, end time.Time) []PriceHistoryEntry {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	cutoff := time.Now().UTC().Add(-h.cfg.MaxAge)
+
+	entries := make([]PriceHistoryEntry, 0, h.size)
+	for i := 0; i < h.size; i++ {
+		entry := h.entries[(h.head+i)%len(h.entries)]
+		switch {
+		case entry.Timestamp.Before(cutoff):
+		case !start.IsZero() && entry.Timestamp.Before(start):
+		case !end.IsZero() && entry.Timestamp.After(end):
+		default:
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,121 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	providermocks "github.com/skip-mev/slinky/providers/types/mocks"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func (s *OracleTestSuite) TestPriceHistory() {
+	btc := s.currencyPairs[0]
+
+	testCases := []struct {
+		name          string
+		history       config.PriceHistoryConfig
+		updates       int
+		wait          time.Duration
+		expectedCount int
+	}{
+		{
+			name:          "price history is not recorded if disabled",
+			history:       config.PriceHistoryConfig{},
+			updates:       2,
+			expectedCount: 0,
+		},
+		{
+			name: "price history is bounded by the max number of entries",
+			history: config.PriceHistoryConfig{
+				Enabled:    true,
+				MaxEntries: 3,
+				MaxAge:     time.Minute,
+			},
+			updates:       5,
+			expectedCount: 3,
+		},
+		{
+			name: "price history is bounded by the max age",
+			history: config.PriceHistoryConfig{
+				Enabled:    true,
+				MaxEntries: 100,
+				MaxAge:     250 * time.Millisecond,
+			},
+			updates:       2,
+			wait:          500 * time.Millisecond,
+			expectedCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result := providertypes.NewResult[*big.Int](big.NewInt(100), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+			provider.On("Name").Return("provider1").Maybe()
+			provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+			provider.On("Type").Return(providertypes.API).Maybe()
+			provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+				btc: result,
+			}).Maybe()
+
+			cfg := config.OracleConfig{
+				UpdateInterval: 50 * time.Millisecond,
+				Providers:      []config.ProviderConfig{reloadProviderConfig("provider1", time.Second, btc)},
+				Market:         reloadMarketConfig(btc),
+				PriceHistory:   tc.history,
+			}
+
+			o, err := oracle.New(
+				oracle.WithConfig(cfg),
+				oracle.WithLogger(s.logger),
+				oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+			)
+			s.Require().NoError(err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			updates := o.SubscribePrices(ctx)
+			go o.Start(ctx)
+
+			for i := 0; i < tc.updates; i++ {
+				select {
+				case <-updates:
+				case <-time.After(2 * time.Second):
+					s.T().Fatal("timed out waiting for price update")
+				}
+			}
+
+			o.Stop()
+			time.Sleep(tc.wait)
+
+			entries := o.GetPriceHistory(time.Time{}, time.Time{})
+			s.Require().Len(entries, tc.expectedCount)
+			if tc.expectedCount == 0 {
+				return
+			}
+
+			// The entries are ordered oldest first and hold the reported and provider prices.
+			for i, entry := range entries {
+				if i > 0 {
+					s.Require().True(entry.Timestamp.After(entries[i-1].Timestamp))
+				}
+
+				s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{btc: big.NewInt(100)}, entry.Prices)
+				s.Require().Equal(result, entry.ProviderPrices["provider1"][btc])
+			}
+
+			// The entries can be filtered by time range (inclusive).
+			s.Require().Equal(entries[1:], o.GetPriceHistory(entries[1].Timestamp, time.Time{}))
+			s.Require().Equal(entries[:2], o.GetPriceHistory(time.Time{}, entries[1].Timestamp))
+			s.Require().Equal(entries[1:2], o.GetPriceHistory(entries[1].Timestamp, entries[1].Timestamp))
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"math/big"
 
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 
 	providertypes "github.com/skip-mev/slinky/providers/types"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
@@ -17,19 +16,76 @@ var CtxErrors = map[error]struct{}{
 	context.DeadlineExceeded: {},
 }
 
-// StartProviders starts all providers.
-func (o *OracleImpl) StartProviders(ctx context.Context) {
-	providerGroup, ctx := errgroup.WithContext(ctx)
-	providerGroup.SetLimit(len(o.providers))
+// providerRoutine tracks a provider that is currently running so that it can be stopped
+// independently of the other providers i.e. when the oracle config is reloaded.
+type providerRoutine struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
 
+// StartProviders starts all providers. Each provider runs in its own routine until either
+// the context is cancelled or the provider is stopped. This blocks until all providers
+// have exited.
+func (o *OracleImpl) StartProviders(ctx context.Context) {
+	o.providerMtx.Lock()
+	o.providerCtx = ctx
 	for _, p := range o.providers {
-		providerGroup.Go(o.execProviderFn(ctx, p))
+		o.startProvider(p)
 	}
+	o.providerMtx.Unlock()
+
+	<-ctx.Done()
+
+	// Acquire the provider lock to ensure that any provider that is concurrently being started
+	// is tracked before waiting. No new providers are started once the context is cancelled.
+	o.providerMtx.Lock()
+	o.providerMtx.Unlock() //nolint:staticcheck
 
-	o.providerCh <- providerGroup.Wait()
+	// Wait for all of the providers to exit.
+	o.providerWg.Wait()
+
+	o.providerCh <- ctx.Err()
 	close(o.providerCh)
 }
 
+// startProvider starts the given provider in a new routine. This must be called with the
+// provider lock held. If the providers have not been started yet, this is a no-op; the
+// provider will be started along with the remaining providers.
+func (o *OracleImpl) startProvider(p providertypes.Provider[oracletypes.CurrencyPair, *big.Int]) {
+	if o.providerCtx == nil || o.providerCtx.Err() != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(o.providerCtx)
+	routine := providerRoutine{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	o.providerRoutines[p.Name()] = routine
+
+	o.providerWg.Add(1)
+	go func() {
+		defer o.providerWg.Done()
+		defer close(routine.done)
+		defer cancel()
+
+		_ = o.execProviderFn(ctx, p)()
+	}()
+}
+
+// stopProvider stops the provider with the given name and blocks until it has exited. This
+// must be called with the provider lock held.
+func (o *OracleImpl) stopProvider(name string) {
+	routine, ok := o.providerRoutines[name]
+	if !ok {
+		return
+	}
+
+	routine.cancel()
+	<-routine.done
+	delete(o.providerRoutines, name)
+}
+
 // execProvider executes a given provider. The provider continues
 // to concurrently run until the context is canceled.
 func (o *OracleImpl) execProviderFn(
@@ -48,8 +104,7 @@ func (o *OracleImpl) execProviderFn(
 		o.logger.Info("provider exiting", zap.String("name", p.Name()), zap.Error(err))
 
 		// If the context is canceled, or the deadline is exceeded,
-		// we want to exit the provider and trigger the error group
-		// to exit for all providers.
+		// we want to exit the provider.
 		if _, ok := CtxErrors[err]; ok {
 			return err
 		}
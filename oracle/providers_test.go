@@ -110,6 +110,7 @@ func (s *OracleTestSuite) TestProviders() {
 					time.Second*2,
 					providerCfg2,
 					s.logger,
+					s.currencyPairs,
 					responses2,
 				)
 
@@ -0,0 +1,227 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// MarketConfigUpdater defines an interface for components whose market configuration can be
+// updated while the oracle is running i.e. the MedianAggregator.
+type MarketConfigUpdater interface {
+	// UpdateMarketConfig validates and atomically swaps the market config.
+	UpdateMarketConfig(config.AggregateMarketConfig) error
+}
+
+// UpdateConfig applies a new oracle config to the oracle without restarting it. The new config is
+// validated and diffed against the config the oracle is currently running with, which must have
+// been set with WithConfig. Only the affected providers are touched:
+//   - providers that were removed from the config are stopped.
+//   - providers that were added to the config are created and started.
+//   - providers whose configuration changed are recreated and restarted.
+//   - providers whose configuration did not change, but whose set of currency pairs changed (i.e.
+//     a feed was added to or removed from the market config) are resubscribed.
+//
+// Prices for providers that are not affected keep flowing throughout the update. The market
+// config is swapped atomically on the configured MarketConfigUpdater, PriceSmoother and
+// CircuitBreaker. If any of them rejects the market config, the others are rolled back and the
+// current config is kept.
+func (o *OracleImpl) UpdateConfig(cfg config.OracleConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid oracle config: %w", err)
+	}
+
+	if o.providerFactory == nil {
+		return fmt.Errorf("oracle must be configured with a provider factory to update its config")
+	}
+
+	o.reloadMtx.Lock()
+	defer o.reloadMtx.Unlock()
+
+	// The new config is diffed against the config the oracle was created with. Without it, every
+	// provider would be treated as added and the running providers would be started twice. A
+	// validated config always has a non-zero update interval.
+	if o.cfg.UpdateInterval == 0 {
+		return fmt.Errorf("oracle must be configured with a base config to update its config")
+	}
+
+	diff := config.DiffOracleConfig(o.cfg, cfg)
+	if len(diff.UnsupportedUpdates) > 0 {
+		return fmt.Errorf(
+			"%s config cannot be updated without restarting the oracle",
+			strings.Join(diff.UnsupportedUpdates, ", "),
+		)
+	}
+
+	if diff.IsEmpty() {
+		o.logger.Info("oracle config unchanged; skipping update")
+		return nil
+	}
+
+	// Create the full set of providers from the new config. Only the providers that are affected
+	// by the update are used; the remaining providers are discarded without being started.
+	providers, err := o.providerFactory(o.logger, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create providers from config: %w", err)
+	}
+
+	newProviders := make(map[string]providertypes.Provider[oracletypes.CurrencyPair, *big.Int], len(providers))
+	for _, p := range providers {
+		newProviders[p.Name()] = p
+	}
+
+	for _, p := range append(diff.AddedProviders, diff.UpdatedProviders...) {
+		if _, ok := newProviders[p.Name]; !ok {
+			return fmt.Errorf("provider factory did not create provider %s", p.Name)
+		}
+	}
+
+	// Update the market config before the providers so that prices for new currency pairs are
+	// aggregated as soon as they are available.
+	if diff.MarketUpdated {
+		if err := o.updateMarketConfig(cfg.Market); err != nil {
+			return err
+		}
+	}
+
+	o.updateProviders(diff, newProviders)
+
+	if diff.UpdateIntervalUpdated {
+		o.setUpdateInterval(cfg.UpdateInterval)
+	}
+
+	if diff.PriceHistoryUpdated {
+		o.priceHistory.setConfig(cfg.PriceHistory)
+	}
+
+	o.mtx.Lock()
+	o.cfg = cfg
+	o.mtx.Unlock()
+	o.setProviderConfigs(cfg.Providers)
+
+	// Restrict the providers to the currency pairs synced from on chain state, if any.
+	if unmapped := o.applyCurrencyPairs(); len(unmapped) > 0 {
+		o.logger.Warn("on chain currency pairs have no ticker mapping in any provider", zap.Stringers("currency_pairs", unmapped))
+	}
+
+	o.logger.Info(
+		"updated oracle config",
+		zap.Int("added_providers", len(diff.AddedProviders)),
+		zap.Int("removed_providers", len(diff.RemovedProviders)),
+		zap.Int("updated_providers", len(diff.UpdatedProviders)),
+		zap.Bool("market_updated", diff.MarketUpdated),
+		zap.Duration("update_interval", cfg.UpdateInterval),
+		zap.Bool("price_history_updated", diff.PriceHistoryUpdated),
+	)
+
+	return nil
+}
+
+// updateMarketConfig updates the market config of the configured MarketConfigUpdater, PriceSmoother
+// and CircuitBreaker. If any of them rejects the market config, the components that were already
+// updated are rolled back to the current market config, such that the components never run with
+// different market configs. This must be called with the reload lock held.
+func (o *OracleImpl) updateMarketConfig(market config.AggregateMarketConfig) error {
+	components := []struct {
+		name    string
+		updater MarketConfigUpdater
+	}{
+		{"market config updater", o.marketConfigUpdater},
+		{"price smoother", o.priceSmoother},
+		{"circuit breaker", o.circuitBreaker},
+	}
+
+	updated := make([]MarketConfigUpdater, 0, len(components))
+	for _, component := range components {
+		if component.updater == nil {
+			continue
+		}
+
+		if err := component.updater.UpdateMarketConfig(market); err != nil {
+			for _, updater := range updated {
+				if rollbackErr := updater.UpdateMarketConfig(o.cfg.Market); rollbackErr != nil {
+					o.logger.Error("failed to roll back market config", zap.Error(rollbackErr))
+				}
+			}
+
+			return fmt.Errorf("failed to update %s market config: %w", component.name, err)
+		}
+
+		updated = append(updated, component.updater)
+	}
+
+	return nil
+}
+
+// updateProviders stops, starts, restarts and resubscribes providers based on the given diff.
+func (o *OracleImpl) updateProviders(
+	diff config.OracleConfigDiff,
+	newProviders map[string]providertypes.Provider[oracletypes.CurrencyPair, *big.Int],
+) {
+	o.providerMtx.Lock()
+	defer o.providerMtx.Unlock()
+
+	replaced := make(map[string]struct{})
+	for _, p := range diff.RemovedProviders {
+		replaced[p.Name] = struct{}{}
+	}
+	for _, p := range diff.UpdatedProviders {
+		replaced[p.Name] = struct{}{}
+	}
+
+	// Stop all of the providers that were removed or updated. Resubscribe any provider whose
+	// config did not change but whose set of currency pairs did.
+	providers := make([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int], 0, len(o.providers))
+	for _, p := range o.providers {
+		if _, ok := replaced[p.Name()]; ok {
+			o.logger.Info("stopping provider", zap.String("provider", p.Name()))
+			o.stopProvider(p.Name())
+			continue
+		}
+
+		if newProvider, ok := newProviders[p.Name()]; ok {
+			ids := newProvider.GetIDs()
+			if !sameIDs(p.GetIDs(), ids) {
+				o.logger.Info("resubscribing provider", zap.String("provider", p.Name()), zap.Int("num_ids", len(ids)))
+				p.SetIDs(ids)
+			}
+		}
+
+		providers = append(providers, p)
+	}
+
+	// Start all of the providers that were added or updated.
+	for _, p := range append(diff.UpdatedProviders, diff.AddedProviders...) {
+		provider := newProviders[p.Name]
+
+		o.logger.Info("starting provider", zap.String("provider", p.Name))
+		providers = append(providers, provider)
+		o.startProvider(provider)
+	}
+
+	o.providers = providers
+}
+
+// sameIDs returns true if the two sets of ids contain the same elements, irrespective of order.
+func sameIDs(a, b []oracletypes.CurrencyPair) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[oracletypes.CurrencyPair]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+
+	return !slices.ContainsFunc(b, func(id oracletypes.CurrencyPair) bool {
+		_, ok := set[id]
+		return !ok
+	})
+}
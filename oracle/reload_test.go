@@ -0,0 +1,353 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/aggregator"
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	providermocks "github.com/skip-mev/slinky/providers/types/mocks"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// reloadTracker tracks the providers created by a provider factory and how many of them are
+// currently running.
+type reloadTracker struct {
+	mtx       sync.Mutex
+	running   map[string]int
+	starts    map[string]int
+	providers map[string]*providermocks.Provider[oracletypes.CurrencyPair, *big.Int]
+}
+
+func newReloadTracker() *reloadTracker {
+	return &reloadTracker{
+		running:   make(map[string]int),
+		starts:    make(map[string]int),
+		providers: make(map[string]*providermocks.Provider[oracletypes.CurrencyPair, *big.Int]),
+	}
+}
+
+func (t *reloadTracker) counts(name string) (int, int) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.running[name], t.starts[name]
+}
+
+// reloadFactory returns a provider factory that creates a mock provider for each provider in the
+// config. The mock providers block until their context is cancelled. The provider named
+// "resubscribed" derives its currency pairs from the aggregate market config rather than its own
+// market config so that its set of currency pairs can change without its config changing.
+func (s *OracleTestSuite) reloadFactory(t *reloadTracker) providertypes.ProviderFactory[oracletypes.CurrencyPair, *big.Int] {
+	return func(
+		_ *zap.Logger,
+		cfg config.OracleConfig,
+	) ([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int], error) {
+		t.mtx.Lock()
+		defer t.mtx.Unlock()
+
+		providers := make([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int], 0, len(cfg.Providers))
+		for _, p := range cfg.Providers {
+			name := p.Name
+			ids := make([]oracletypes.CurrencyPair, 0)
+			for _, cp := range p.Market.TickerToMarketConfigs {
+				ids = append(ids, cp.CurrencyPair)
+			}
+			if name == "resubscribed" {
+				ids = ids[:0]
+				for _, feed := range cfg.Market.Feeds {
+					ids = append(ids, feed.CurrencyPair)
+				}
+			}
+
+			provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+			provider.On("Name").Return(name).Maybe()
+			provider.On("GetIDs").Return(ids).Maybe()
+			provider.On("SetIDs", mock.Anything).Return().Maybe()
+			provider.On("GetData").Return(nil).Maybe()
+			provider.On("Type").Return(providertypes.API).Maybe()
+			provider.On("Start", mock.Anything).Return(context.Canceled).Run(func(args mock.Arguments) {
+				t.mtx.Lock()
+				t.running[name]++
+				t.starts[name]++
+				t.mtx.Unlock()
+
+				<-args.Get(0).(context.Context).Done()
+
+				t.mtx.Lock()
+				t.running[name]--
+				t.mtx.Unlock()
+			}).Maybe()
+
+			// Only track the first instance of each provider so that resubscriptions of running
+			// providers can be verified.
+			if _, ok := t.providers[name]; !ok {
+				t.providers[name] = provider
+			}
+
+			providers = append(providers, provider)
+		}
+
+		return providers, nil
+	}
+}
+
+// recordingMarketConfigUpdater records the market config it is updated with.
+type recordingMarketConfigUpdater struct {
+	mtx    sync.Mutex
+	market config.AggregateMarketConfig
+}
+
+func (u *recordingMarketConfigUpdater) UpdateMarketConfig(market config.AggregateMarketConfig) error {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	u.market = market
+	return nil
+}
+
+func (u *recordingMarketConfigUpdater) get() config.AggregateMarketConfig {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	return u.market
+}
+
+// rejectingCircuitBreaker is a circuit breaker that rejects every market config update.
+type rejectingCircuitBreaker struct{}
+
+func (rejectingCircuitBreaker) UpdateMarketConfig(config.AggregateMarketConfig) error {
+	return fmt.Errorf("market config rejected")
+}
+
+func (rejectingCircuitBreaker) Apply(
+	prices map[oracletypes.CurrencyPair]*big.Int,
+	_ aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
+) map[oracletypes.CurrencyPair]*big.Int {
+	return prices
+}
+
+func (s *OracleTestSuite) TestUpdateConfigRollsBackMarketConfig() {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	initial := config.OracleConfig{
+		UpdateInterval: time.Second,
+		Providers: []config.ProviderConfig{
+			reloadProviderConfig("resubscribed", time.Second, btc),
+		},
+		Market: reloadMarketConfig(btc),
+	}
+
+	tracker := newReloadTracker()
+	factory := s.reloadFactory(tracker)
+	providers, err := factory(s.logger, initial)
+	s.Require().NoError(err)
+
+	updater := &recordingMarketConfigUpdater{market: initial.Market}
+	o, err := oracle.New(
+		oracle.WithConfig(initial),
+		oracle.WithProviders(providers),
+		oracle.WithProviderFactory(factory),
+		oracle.WithMarketConfigUpdater(updater),
+		oracle.WithCircuitBreaker(rejectingCircuitBreaker{}),
+		oracle.WithLogger(s.logger),
+	)
+	s.Require().NoError(err)
+
+	updated := initial
+	updated.Market = reloadMarketConfig(btc, eth)
+	s.Require().ErrorContains(o.UpdateConfig(updated), "circuit breaker")
+
+	// The market config updater is rolled back to the current market config, and the providers
+	// are not resubscribed.
+	s.Require().Equal(initial.Market, updater.get())
+	tracker.providers["resubscribed"].AssertNotCalled(s.T(), "SetIDs", mock.Anything)
+
+	// The current config is kept.
+	s.Require().NoError(o.UpdateConfig(initial))
+}
+
+func reloadProviderConfig(name string, interval time.Duration, cps ...oracletypes.CurrencyPair) config.ProviderConfig {
+	market := config.MarketConfig{
+		Name:                        name,
+		CurrencyPairToMarketConfigs: make(map[string]config.CurrencyPairMarketConfig),
+	}
+	for _, cp := range cps {
+		market.CurrencyPairToMarketConfigs[cp.String()] = config.CurrencyPairMarketConfig{
+			Ticker:       cp.String(),
+			CurrencyPair: cp,
+		}
+	}
+
+	return config.ProviderConfig{
+		Name: name,
+		API: config.APIConfig{
+			Interval:   interval,
+			Timeout:    interval / 2,
+			MaxQueries: 1,
+			Enabled:    true,
+			Name:       name,
+			URL:        "http://test.com",
+		},
+		Market: market,
+	}
+}
+
+func reloadMarketConfig(cps ...oracletypes.CurrencyPair) config.AggregateMarketConfig {
+	market := config.AggregateMarketConfig{
+		Feeds: make(map[string]config.FeedConfig),
+	}
+	for _, cp := range cps {
+		market.Feeds[cp.String()] = config.FeedConfig{
+			CurrencyPair: cp,
+		}
+	}
+
+	return market
+}
+
+func (s *OracleTestSuite) TestUpdateConfig() {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	initial := config.OracleConfig{
+		UpdateInterval: time.Second,
+		Providers: []config.ProviderConfig{
+			reloadProviderConfig("unchanged", time.Second, btc),
+			reloadProviderConfig("resubscribed", time.Second, btc),
+			reloadProviderConfig("updated", time.Second, btc),
+			reloadProviderConfig("removed", time.Second, btc),
+		},
+		Market: reloadMarketConfig(btc),
+	}
+
+	tracker := newReloadTracker()
+	factory := s.reloadFactory(tracker)
+	providers, err := factory(s.logger, initial)
+	s.Require().NoError(err)
+
+	o, err := oracle.New(
+		oracle.WithConfig(initial),
+		oracle.WithProviders(providers),
+		oracle.WithProviderFactory(factory),
+		oracle.WithLogger(s.logger),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go o.Start(ctx)
+	s.Require().Eventually(o.IsRunning, 5*time.Second, 10*time.Millisecond)
+
+	// Wait for all of the providers to start.
+	for _, p := range initial.Providers {
+		s.Require().Eventually(func() bool {
+			running, _ := tracker.counts(p.Name)
+			return running == 1
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	updated := config.OracleConfig{
+		UpdateInterval: 2 * time.Second,
+		Providers: []config.ProviderConfig{
+			reloadProviderConfig("unchanged", time.Second, btc),
+			reloadProviderConfig("resubscribed", time.Second, btc),
+			reloadProviderConfig("updated", 2*time.Second, btc),
+			reloadProviderConfig("added", time.Second, btc),
+		},
+		Market: reloadMarketConfig(btc),
+	}
+
+	s.Run("adds, removes and restarts providers", func() {
+		s.Require().NoError(o.UpdateConfig(updated))
+
+		expected := map[string]struct{ running, starts int }{
+			"unchanged":    {1, 1},
+			"resubscribed": {1, 1},
+			"updated":      {1, 2},
+			"removed":      {0, 1},
+			"added":        {1, 1},
+		}
+		for name, exp := range expected {
+			s.Require().Eventually(func() bool {
+				running, starts := tracker.counts(name)
+				return running == exp.running && starts == exp.starts
+			}, 5*time.Second, 10*time.Millisecond, name)
+		}
+	})
+
+	s.Run("unchanged config is a no-op", func() {
+		s.Require().NoError(o.UpdateConfig(updated))
+
+		_, starts := tracker.counts("updated")
+		s.Require().Equal(2, starts)
+	})
+
+	s.Run("resubscribes providers whose currency pairs changed", func() {
+		resubscribed := updated
+		resubscribed.Market = reloadMarketConfig(btc, eth)
+		s.Require().NoError(o.UpdateConfig(resubscribed))
+
+		// The resubscribed provider is updated in place rather than restarted.
+		running, starts := tracker.counts("resubscribed")
+		s.Require().Equal(1, running)
+		s.Require().Equal(1, starts)
+		tracker.providers["resubscribed"].AssertCalled(s.T(), "SetIDs", mock.MatchedBy(func(ids []oracletypes.CurrencyPair) bool {
+			return len(ids) == 2
+		}))
+
+		// Providers whose currency pairs did not change must not be touched.
+		running, starts = tracker.counts("unchanged")
+		s.Require().Equal(1, running)
+		s.Require().Equal(1, starts)
+		tracker.providers["unchanged"].AssertNotCalled(s.T(), "SetIDs", mock.Anything)
+	})
+
+	s.Run("config that cannot be applied while running is rejected", func() {
+		snapshot := updated
+		snapshot.Snapshot = config.SnapshotConfig{
+			Enabled:  true,
+			Path:     filepath.Join(s.T().TempDir(), "snapshot.json"),
+			Interval: time.Minute,
+		}
+		s.Require().ErrorContains(o.UpdateConfig(snapshot), "snapshot config cannot be updated")
+
+		// The current config is kept.
+		s.Require().NoError(o.UpdateConfig(updated))
+		_, starts := tracker.counts("updated")
+		s.Require().Equal(2, starts)
+	})
+
+	s.Run("invalid config is rejected", func() {
+		s.Require().Error(o.UpdateConfig(config.OracleConfig{}))
+	})
+
+	s.Run("update without a base config is rejected", func() {
+		noCfg, err := oracle.New(
+			oracle.WithProviders(providers),
+			oracle.WithProviderFactory(factory),
+			oracle.WithLogger(s.logger),
+		)
+		s.Require().NoError(err)
+		s.Require().ErrorContains(noCfg.UpdateConfig(updated), "base config")
+	})
+
+	o.Stop()
+	s.Require().False(o.IsRunning())
+
+	for _, name := range []string{"unchanged", "resubscribed", "updated", "added"} {
+		running, _ := tracker.counts(name)
+		s.Require().Equal(0, running, name)
+	}
+}
@@ -0,0 +1,352 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// Snapshot is the on-disk snapshot of the latest provider prices and aggregated prices of the
+// oracle. The snapshot is restored when the oracle starts, such that prices are available before
+// the providers have fetched new prices.
+type Snapshot struct {
+	// Timestamp is the time of the oracle update that the snapshot was taken from.
+	Timestamp time.Time `json:"timestamp"`
+
+	// ProviderPrices is the set of prices reported by each provider, keyed by provider name and
+	// currency pair.
+	ProviderPrices map[string]map[string]SnapshotResult `json:"provider_prices"`
+
+	// Prices is the set of reported prices keyed by currency pair.
+	Prices map[string]string `json:"prices"`
+}
+
+// SnapshotResult is the on-disk representation of a price reported by a provider.
+type SnapshotResult struct {
+	Price     string    `json:"price"`
+	Timestamp time.Time `json:"timestamp"`
+	Volume    string    `json:"volume,omitempty"`
+	Bid       string    `json:"bid,omitempty"`
+	Ask       string    `json:"ask,omitempty"`
+}
+
+// TakeSnapshot returns a snapshot of the latest provider prices and reported prices.
+func (o *OracleImpl) TakeSnapshot() Snapshot {
+	o.mtx.RLock()
+	timestamp := o.lastPriceSync
+	providerPrices := o.providerPrices
+	o.mtx.RUnlock()
+
+	snapshot := Snapshot{
+		Timestamp:      timestamp,
+		ProviderPrices: make(map[string]map[string]SnapshotResult, len(providerPrices)),
+		Prices:         make(map[string]string),
+	}
+
+	for provider, results := range providerPrices {
+		snapshot.ProviderPrices[provider] = make(map[string]SnapshotResult, len(results))
+		for cp, result := range results {
+			if result.Value == nil {
+				continue
+			}
+
+			snapshot.ProviderPrices[provider][cp.String()] = toSnapshotResult(result)
+		}
+	}
+
+	for cp, price := range o.getReportedPrices() {
+		if price != nil {
+			snapshot.Prices[cp.String()] = price.String()
+		}
+	}
+
+	return snapshot
+}
+
+// WriteSnapshot writes a snapshot of the latest prices to the given path. The snapshot is written
+// to a temporary file that is renamed to the given path, such that a partially written snapshot is
+// never restored. No snapshot is written if the oracle has not updated its prices yet.
+func (o *OracleImpl) WriteSnapshot(path string) error {
+	snapshot := o.TakeSnapshot()
+	if snapshot.Timestamp.IsZero() {
+		return nil
+	}
+
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write snapshot file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot file: %w", err)
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// RestoreSnapshot restores the prices in the snapshot at the given path. Restored provider prices
+// are marked as restored and are used alongside the prices fetched by the providers until either
+// the provider reports a new price for the currency pair or the restored price exceeds the max
+// price age. Restored aggregated prices are served until the next oracle update. Prices that are
+// already older than the max price age are discarded. No prices are restored if the snapshot does
+// not exist.
+func (o *OracleImpl) RestoreSnapshot(path string) error {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		o.logger.Info("no price snapshot to restore", zap.String("path", path))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	now := time.Now().UTC()
+	updateInterval := o.getUpdateInterval()
+
+	restored := make(map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
+	for provider, results := range snapshot.ProviderPrices {
+		providerCfg := o.getProviderConfig(provider)
+		for cpStr, snapshotResult := range results {
+			cp, err := oracletypes.CurrencyPairFromString(cpStr)
+			if err != nil {
+				continue
+			}
+
+			result, ok := snapshotResult.toResult()
+			if !ok || now.Sub(result.Timestamp) > providerCfg.GetMaxPriceAge(cp, updateInterval) {
+				continue
+			}
+
+			if _, ok := restored[provider]; !ok {
+				restored[provider] = make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
+			}
+			restored[provider][cp] = result
+		}
+	}
+
+	prices := make(map[oracletypes.CurrencyPair]*big.Int)
+	for cpStr, priceStr := range snapshot.Prices {
+		cp, err := oracletypes.CurrencyPairFromString(cpStr)
+		if err != nil {
+			continue
+		}
+
+		price, ok := new(big.Int).SetString(priceStr, 10)
+		if !ok || now.Sub(snapshot.Timestamp) > o.getMaxPriceAge(cp) {
+			continue
+		}
+
+		prices[cp] = price
+	}
+
+	// The restored prices are tracked separately from the published provider and aggregated
+	// prices, which are handed out to readers and must never be mutated.
+	o.priceAggregator.SetAggregatedData(maps.Clone(prices))
+
+	providerPrices := make(map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int], len(restored))
+	for provider, results := range restored {
+		providerPrices[provider] = maps.Clone(results)
+	}
+
+	o.mtx.Lock()
+	o.restoredPrices = restored
+	o.providerPrices = providerPrices
+	o.reportedPrices = prices
+	o.rawPrices = maps.Clone(prices)
+	o.lastPriceSync = snapshot.Timestamp
+	o.mtx.Unlock()
+
+	o.logger.Info(
+		"restored price snapshot",
+		zap.String("path", path),
+		zap.Time("timestamp", snapshot.Timestamp),
+		zap.Int("num_providers", len(restored)),
+		zap.Int("num_prices", len(prices)),
+	)
+
+	return nil
+}
+
+// runSnapshots periodically writes a snapshot of the latest prices until the context is cancelled
+// or the oracle is stopped. A final snapshot is written on exit, which Stop waits for. The snapshot
+// is restored by Start before this is called.
+func (o *OracleImpl) runSnapshots(ctx context.Context, cfg config.SnapshotConfig) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	write := func() {
+		if err := o.WriteSnapshot(cfg.Path); err != nil {
+			o.logger.Error("failed to write price snapshot", zap.String("path", cfg.Path), zap.Error(err))
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			write()
+			return
+		case <-o.closer.Done():
+			write()
+			return
+		case <-ticker.C:
+			write()
+		}
+	}
+}
+
+// withRestoredPrices returns the given provider prices along with the prices restored from the
+// snapshot for the provider. Restored prices are dropped once the provider reports a new price
+// for the currency pair.
+func (o *OracleImpl) withRestoredPrices(
+	provider string,
+	prices map[oracletypes.CurrencyPair]providertypes.Result[*big.Int],
+) map[oracletypes.CurrencyPair]providertypes.Result[*big.Int] {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	restored, ok := o.restoredPrices[provider]
+	if !ok {
+		return prices
+	}
+
+	merged := make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int], len(prices)+len(restored))
+	remaining := make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int], len(restored))
+	for cp, result := range restored {
+		if _, ok := prices[cp]; !ok {
+			merged[cp] = result
+			remaining[cp] = result
+		}
+	}
+
+	for cp, result := range prices {
+		merged[cp] = result
+	}
+
+	o.setRestoredPrices(provider, remaining)
+	return merged
+}
+
+// dropRestoredPrice drops the price restored from the snapshot for the given provider and currency
+// pair i.e. once it exceeds the max price age.
+func (o *OracleImpl) dropRestoredPrice(provider string, cp oracletypes.CurrencyPair) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	restored, ok := o.restoredPrices[provider]
+	if !ok {
+		return
+	}
+
+	remaining := maps.Clone(restored)
+	delete(remaining, cp)
+	o.setRestoredPrices(provider, remaining)
+}
+
+// setRestoredPrices replaces the prices restored from the snapshot for the given provider. The
+// restored prices are replaced rather than updated in place, such that a map that has been
+// handed out is never mutated. This must be called with the oracle's lock held.
+func (o *OracleImpl) setRestoredPrices(
+	provider string,
+	remaining map[oracletypes.CurrencyPair]providertypes.Result[*big.Int],
+) {
+	restored := make(map[string]map[oracletypes.CurrencyPair]providertypes.Result[*big.Int], len(o.restoredPrices))
+	for name, results := range o.restoredPrices {
+		if name != provider {
+			restored[name] = results
+		}
+	}
+
+	if len(remaining) > 0 {
+		restored[provider] = remaining
+	}
+
+	o.restoredPrices = restored
+}
+
+// getMaxPriceAge returns the longest max price age of the given currency pair across all of the
+// providers. The update interval is returned if no provider sets a longer max price age.
+func (o *OracleImpl) getMaxPriceAge(cp oracletypes.CurrencyPair) time.Duration {
+	maxAge := o.getUpdateInterval()
+
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	for _, providerCfg := range o.providerConfigs {
+		if age := providerCfg.GetMaxPriceAge(cp, maxAge); age > maxAge {
+			maxAge = age
+		}
+	}
+
+	return maxAge
+}
+
+// toSnapshotResult converts a provider result into its on-disk representation.
+func toSnapshotResult(result providertypes.Result[*big.Int]) SnapshotResult {
+	snapshotResult := SnapshotResult{
+		Price:     result.Value.String(),
+		Timestamp: result.Timestamp,
+	}
+
+	if result.Volume != nil {
+		snapshotResult.Volume = result.Volume.Text('g', -1)
+	}
+
+	if result.Bid != nil && result.Ask != nil {
+		snapshotResult.Bid = result.Bid.String()
+		snapshotResult.Ask = result.Ask.String()
+	}
+
+	return snapshotResult
+}
+
+// toResult converts the on-disk representation of a provider result into a restored result. This
+// returns false if the price cannot be parsed. Market data that cannot be parsed is left unset.
+func (r SnapshotResult) toResult() (providertypes.Result[*big.Int], bool) {
+	price, ok := new(big.Int).SetString(r.Price, 10)
+	if !ok {
+		return providertypes.Result[*big.Int]{}, false
+	}
+
+	result := providertypes.NewResult[*big.Int](price, r.Timestamp)
+	result.Restored = true
+
+	if volume, ok := new(big.Float).SetString(r.Volume); ok {
+		result = result.WithVolume(volume)
+	}
+
+	bid, bidOK := new(big.Int).SetString(r.Bid, 10)
+	ask, askOK := new(big.Int).SetString(r.Ask, 10)
+	if bidOK && askOK {
+		result = result.WithBidAsk(bid, ask)
+	}
+
+	return result, true
+}
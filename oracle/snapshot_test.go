@@ -0,0 +1,263 @@
+package oracle_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	providermocks "github.com/skip-mev/slinky/providers/types/mocks"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// snapshotConfig returns an oracle config with a single provider that allows prices that are up
+// to 10 seconds old, except for ETHEREUM/USD which must be at most 1 second old.
+func (s *OracleTestSuite) snapshotConfig(path string) config.OracleConfig {
+	btc, eth, atom := s.currencyPairs[0], s.currencyPairs[1], s.currencyPairs[2]
+
+	providerCfg := reloadProviderConfig("provider1", time.Second, btc, eth, atom)
+	providerCfg.MaxPriceAge = 10 * time.Second
+	ethCfg := providerCfg.Market.CurrencyPairToMarketConfigs[eth.String()]
+	ethCfg.MaxPriceAge = time.Second
+	providerCfg.Market.CurrencyPairToMarketConfigs[eth.String()] = ethCfg
+
+	return config.OracleConfig{
+		UpdateInterval: 100 * time.Millisecond,
+		Providers:      []config.ProviderConfig{providerCfg},
+		Market:         reloadMarketConfig(btc, eth, atom),
+		Snapshot: config.SnapshotConfig{
+			Enabled:  true,
+			Path:     path,
+			Interval: time.Hour,
+		},
+	}
+}
+
+func (s *OracleTestSuite) TestWriteAndRestoreSnapshot() {
+	btc, eth := s.currencyPairs[0], s.currencyPairs[1]
+	path := filepath.Join(s.T().TempDir(), "snapshot.json")
+	cfg := s.snapshotConfig(path)
+
+	now := time.Now().UTC()
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		btc: providertypes.NewResult[*big.Int](big.NewInt(100), now).WithBidAsk(big.NewInt(99), big.NewInt(101)),
+		eth: providertypes.NewResult[*big.Int](big.NewInt(200), now),
+	}).Maybe()
+
+	o, err := oracle.New(
+		oracle.WithConfig(cfg),
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+	)
+	s.Require().NoError(err)
+
+	// No snapshot is written before the oracle has updated its prices.
+	s.Require().NoError(o.WriteSnapshot(path))
+	s.Require().NoFileExists(path)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	s.Require().NoError(o.WriteSnapshot(path))
+	o.Stop()
+
+	// The snapshot is restored by a new oracle before any provider has reported a price.
+	restored, err := oracle.New(
+		oracle.WithConfig(cfg),
+		oracle.WithLogger(s.logger),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(restored.RestoreSnapshot(path))
+
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+		eth: big.NewInt(200),
+	}, restored.GetPrices())
+
+	result := restored.GetProviderPrices()["provider1"][btc]
+	s.Require().True(result.Restored)
+	s.Require().Equal(big.NewInt(100), result.Value)
+	s.Require().Equal(big.NewInt(99), result.Bid)
+	s.Require().Equal(big.NewInt(101), result.Ask)
+	s.Require().True(now.Equal(result.Timestamp))
+}
+
+func (s *OracleTestSuite) TestRestoreSnapshot() {
+	btc, eth, atom := s.currencyPairs[0], s.currencyPairs[1], s.currencyPairs[2]
+	path := filepath.Join(s.T().TempDir(), "snapshot.json")
+	cfg := s.snapshotConfig(path)
+
+	// A missing snapshot is not an error.
+	o, err := oracle.New(oracle.WithConfig(cfg), oracle.WithLogger(s.logger))
+	s.Require().NoError(err)
+	s.Require().NoError(o.RestoreSnapshot(path))
+	s.Require().Empty(o.GetPrices())
+
+	// A corrupted snapshot is an error.
+	s.Require().NoError(os.WriteFile(path, []byte("not json"), 0o600))
+	s.Require().Error(o.RestoreSnapshot(path))
+
+	// The ETHEREUM/USD prices in the snapshot are older than their max price age.
+	timestamp := time.Now().UTC().Add(-2 * time.Second)
+	bz, err := json.Marshal(oracle.Snapshot{
+		Timestamp: timestamp,
+		ProviderPrices: map[string]map[string]oracle.SnapshotResult{
+			"provider1": {
+				btc.String(): {Price: "100", Timestamp: timestamp},
+				eth.String(): {Price: "200", Timestamp: timestamp},
+			},
+		},
+		Prices: map[string]string{
+			btc.String(): "100",
+			eth.String(): "200",
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(path, bz, 0o600))
+
+	// The provider only reports COSMOS/USD, so BITCOIN/USD is served from the snapshot.
+	fresh := providertypes.NewResult[*big.Int](big.NewInt(300), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC))
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		atom: fresh,
+	}).Maybe()
+
+	o, err = oracle.New(
+		oracle.WithConfig(cfg),
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	select {
+	case <-updates:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("timed out waiting for price update")
+	}
+
+	s.Require().Equal(map[oracletypes.CurrencyPair]*big.Int{
+		btc:  big.NewInt(100),
+		atom: big.NewInt(300),
+	}, o.GetPrices())
+
+	providerPrices := o.GetProviderPrices()["provider1"]
+	s.Require().Len(providerPrices, 2)
+	s.Require().True(providerPrices[btc].Restored)
+	s.Require().Equal(fresh, providerPrices[atom])
+
+	o.Stop()
+}
+
+func (s *OracleTestSuite) TestRestoreSnapshotConcurrentReads() {
+	btc, atom := s.currencyPairs[0], s.currencyPairs[2]
+	path := filepath.Join(s.T().TempDir(), "snapshot.json")
+	cfg := s.snapshotConfig(path)
+
+	timestamp := time.Now().UTC()
+	bz, err := json.Marshal(oracle.Snapshot{
+		Timestamp: timestamp,
+		ProviderPrices: map[string]map[string]oracle.SnapshotResult{
+			"provider1": {
+				btc.String():  {Price: "100", Timestamp: timestamp},
+				atom.String(): {Price: "300", Timestamp: timestamp},
+			},
+		},
+		Prices: map[string]string{
+			btc.String():  "100",
+			atom.String(): "300",
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(path, bz, 0o600))
+
+	// The provider supersedes the restored COSMOS/USD price on the first tick.
+	provider := providermocks.NewProvider[oracletypes.CurrencyPair, *big.Int](s.T())
+	provider.On("Name").Return("provider1").Maybe()
+	provider.On("Start", mock.Anything).Return(fmt.Errorf("no rizz error")).Maybe()
+	provider.On("Type").Return(providertypes.API).Maybe()
+	provider.On("GetData").Return(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+		atom: providertypes.NewResult[*big.Int](big.NewInt(301), time.Now().UTC()),
+	}).Maybe()
+
+	o, err := oracle.New(
+		oracle.WithConfig(cfg),
+		oracle.WithLogger(s.logger),
+		oracle.WithProviders([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int]{provider}),
+	)
+	s.Require().NoError(err)
+
+	// Readers iterate the provider prices while the snapshot is restored and superseded by the
+	// oracle ticks, which the race detector flags if a published map is mutated.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			for _, results := range o.GetProviderPrices() {
+				for _, result := range results {
+					_ = result.Value
+				}
+			}
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := o.SubscribePrices(ctx)
+	go o.Start(ctx)
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-updates:
+		case <-time.After(2 * time.Second):
+			s.T().Fatal("timed out waiting for price update")
+		}
+	}
+
+	close(done)
+	wg.Wait()
+
+	providerPrices := o.GetProviderPrices()["provider1"]
+	s.Require().True(providerPrices[btc].Restored)
+	s.Require().Equal(big.NewInt(301), providerPrices[atom].Value)
+
+	// Stop waits for the final snapshot to be written.
+	o.Stop()
+	s.Require().FileExists(path)
+}
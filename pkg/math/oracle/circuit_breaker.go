@@ -0,0 +1,243 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/aggregator"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/metrics"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+const (
+	// CircuitBreakerTripped is the event emitted when the aggregated price of a currency pair
+	// moves by more than the threshold and the last reported price is held.
+	CircuitBreakerTripped = "tripped"
+
+	// CircuitBreakerConfirmed is the event emitted when the move that tripped the circuit
+	// breaker is confirmed and the new price is reported.
+	CircuitBreakerConfirmed = "confirmed"
+
+	// CircuitBreakerReset is the event emitted when the aggregated price moves back within the
+	// threshold of the last reported price while the circuit breaker is tripped.
+	CircuitBreakerReset = "reset"
+)
+
+// CircuitBreaker holds the reported price of each currency pair when its aggregated price moves
+// by more than the configured threshold from the last reported price. The last reported price is
+// held until the move is confirmed over a number of consecutive ticks, or by the prices of a
+// number of distinct providers, at which point the new price is reported. Currency pairs without
+// a circuit breaker are reported as is.
+type CircuitBreaker struct {
+	mtx     sync.Mutex
+	logger  *zap.Logger
+	metrics metrics.Metrics
+	cfg     config.AggregateMarketConfig
+
+	// states is the circuit breaker state of each currency pair with a circuit breaker.
+	states map[oracletypes.CurrencyPair]*breakerState
+}
+
+// breakerState is the circuit breaker state of a single currency pair.
+type breakerState struct {
+	// reported is the last reported price of the currency pair.
+	reported *big.Int
+
+	// tripped is true if the circuit breaker is holding the reported price.
+	tripped bool
+
+	// direction is the direction of the move that tripped the circuit breaker i.e. 1 if the
+	// price moved up and -1 if the price moved down.
+	direction int
+
+	// ticks is the number of consecutive ticks that the move has persisted for.
+	ticks uint64
+}
+
+// NewCircuitBreaker returns a new circuit breaker for the given market config. The metrics are
+// used to record the circuit breaker events of each currency pair.
+func NewCircuitBreaker(
+	logger *zap.Logger,
+	cfg config.AggregateMarketConfig,
+	metrics metrics.Metrics,
+) (*CircuitBreaker, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics cannot be nil")
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return &CircuitBreaker{
+		logger:  logger,
+		metrics: metrics,
+		cfg:     cfg,
+		states:  make(map[oracletypes.CurrencyPair]*breakerState),
+	}, nil
+}
+
+// UpdateMarketConfig validates and atomically swaps the market config used by the circuit breaker.
+// The state of any currency pair whose circuit breaker was disabled is discarded.
+func (b *CircuitBreaker) UpdateMarketConfig(cfg config.AggregateMarketConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.cfg = cfg
+	for cp := range b.states {
+		if breaker := cfg.GetCircuitBreaker(cp); !breaker.IsEnabled() {
+			delete(b.states, cp)
+		}
+	}
+
+	return nil
+}
+
+// Apply records the latest prices, along with the provider prices they were aggregated from, and
+// returns the prices to report. The price of a currency pair whose circuit breaker is tripped is
+// replaced by the last reported price. The given prices are not modified.
+func (b *CircuitBreaker) Apply(
+	prices map[oracletypes.CurrencyPair]*big.Int,
+	providerPrices aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
+) map[oracletypes.CurrencyPair]*big.Int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	reported := make(map[oracletypes.CurrencyPair]*big.Int, len(prices))
+	for cp, price := range prices {
+		cfg := b.cfg.GetCircuitBreaker(cp)
+		if !cfg.IsEnabled() || price == nil {
+			reported[cp] = price
+			continue
+		}
+
+		reported[cp] = b.apply(cp, cfg, price, providerPrices)
+	}
+
+	return reported
+}
+
+// apply runs the circuit breaker of a single currency pair and returns the price to report.
+func (b *CircuitBreaker) apply(
+	cp oracletypes.CurrencyPair,
+	cfg config.CircuitBreakerConfig,
+	price *big.Int,
+	providerPrices aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
+) *big.Int {
+	state, ok := b.states[cp]
+	if !ok || state.reported.Sign() == 0 {
+		b.states[cp] = &breakerState{reported: price}
+		return price
+	}
+
+	direction := moveDirection(state.reported, price, cfg.Threshold)
+	switch {
+	case direction == 0:
+		// The price is within the threshold of the last reported price.
+		if state.tripped {
+			b.logger.Info(
+				"circuit breaker reset",
+				zap.String("currency_pair", cp.String()),
+				zap.String("held_price", state.reported.String()),
+				zap.String("price", price.String()),
+			)
+			b.metrics.AddCircuitBreakerEvent(cp.String(), CircuitBreakerReset)
+		}
+
+		b.states[cp] = &breakerState{reported: price}
+		return price
+	case !state.tripped || state.direction != direction:
+		// The price moved too far from the last reported price; start holding it.
+		state.tripped = true
+		state.direction = direction
+		state.ticks = 1
+
+		b.logger.Warn(
+			"circuit breaker tripped; holding price",
+			zap.String("currency_pair", cp.String()),
+			zap.String("held_price", state.reported.String()),
+			zap.String("price", price.String()),
+			zap.Float64("threshold", cfg.Threshold),
+		)
+		b.metrics.AddCircuitBreakerEvent(cp.String(), CircuitBreakerTripped)
+	default:
+		state.ticks++
+	}
+
+	providers := confirmingProviders(cp, state, cfg.Threshold, providerPrices)
+	if state.ticks < cfg.ConfirmationTicks && (cfg.ConfirmationProviders == 0 || providers < cfg.ConfirmationProviders) {
+		b.logger.Debug(
+			"circuit breaker holding price",
+			zap.String("currency_pair", cp.String()),
+			zap.String("held_price", state.reported.String()),
+			zap.String("price", price.String()),
+			zap.Uint64("ticks", state.ticks),
+			zap.Uint64("confirming_providers", providers),
+		)
+
+		return new(big.Int).Set(state.reported)
+	}
+
+	b.logger.Info(
+		"circuit breaker confirmed; releasing price",
+		zap.String("currency_pair", cp.String()),
+		zap.String("held_price", state.reported.String()),
+		zap.String("price", price.String()),
+		zap.Uint64("ticks", state.ticks),
+		zap.Uint64("confirming_providers", providers),
+	)
+	b.metrics.AddCircuitBreakerEvent(cp.String(), CircuitBreakerConfirmed)
+
+	b.states[cp] = &breakerState{reported: price}
+	return price
+}
+
+// confirmingProviders returns the number of distinct providers whose price for the currency pair
+// moved beyond the threshold in the same direction as the move that tripped the circuit breaker.
+func confirmingProviders(
+	cp oracletypes.CurrencyPair,
+	state *breakerState,
+	threshold float64,
+	providerPrices aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
+) uint64 {
+	var count uint64
+	for _, prices := range providerPrices {
+		price, ok := prices[cp]
+		if !ok || price == nil {
+			continue
+		}
+
+		if moveDirection(state.reported, price, threshold) == state.direction {
+			count++
+		}
+	}
+
+	return count
+}
+
+// moveDirection returns 1 if the price moved up from the reference price by more than the
+// threshold (as a fraction of the reference price), -1 if it moved down by more than the
+// threshold, and 0 otherwise. The reference price must be non-zero.
+func moveDirection(reference, price *big.Int, threshold float64) int {
+	diff := new(big.Float).SetInt(new(big.Int).Sub(price, reference))
+	move := new(big.Float).Quo(diff, new(big.Float).SetInt(reference))
+	move.Abs(move)
+
+	if move.Cmp(big.NewFloat(threshold)) <= 0 {
+		return 0
+	}
+
+	return price.Cmp(reference)
+}
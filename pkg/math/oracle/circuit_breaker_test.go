@@ -0,0 +1,196 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/aggregator"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/metrics"
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
+	"github.com/skip-mev/slinky/pkg/math/oracle"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func circuitBreakerConfig(cp oracletypes.CurrencyPair, breaker config.CircuitBreakerConfig) config.AggregateMarketConfig {
+	return config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			cp.String(): {
+				CurrencyPair: cp,
+			},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			cp.String(): {
+				CurrencyPair: cp,
+				Conversions: []config.Conversions{
+					{
+						{CurrencyPair: cp},
+					},
+				},
+				CircuitBreaker: breaker,
+			},
+		},
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	ethUSD := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	breaker := config.CircuitBreakerConfig{
+		Threshold:             0.1,
+		ConfirmationTicks:     3,
+		ConfirmationProviders: 2,
+	}
+
+	type update struct {
+		price          int64
+		providerPrices map[string]int64
+		expected       int64
+		events         []string
+	}
+
+	testCases := []struct {
+		name    string
+		updates []update
+	}{
+		{
+			name: "prices within the threshold are reported",
+			updates: []update{
+				{price: 100, expected: 100},
+				{price: 109, expected: 109},
+				{price: 100, expected: 100},
+			},
+		},
+		{
+			name: "move is confirmed over consecutive ticks",
+			updates: []update{
+				{price: 100, expected: 100},
+				{price: 150, expected: 100, events: []string{oracle.CircuitBreakerTripped}},
+				{price: 151, expected: 100},
+				{price: 152, expected: 152, events: []string{oracle.CircuitBreakerConfirmed}},
+				{price: 153, expected: 153},
+			},
+		},
+		{
+			name: "breaker resets when the price moves back within the threshold",
+			updates: []update{
+				{price: 100, expected: 100},
+				{price: 150, expected: 100, events: []string{oracle.CircuitBreakerTripped}},
+				{price: 101, expected: 101, events: []string{oracle.CircuitBreakerReset}},
+				{price: 150, expected: 101, events: []string{oracle.CircuitBreakerTripped}},
+			},
+		},
+		{
+			name: "move is confirmed by providers",
+			updates: []update{
+				{price: 100, expected: 100},
+				{
+					price:          150,
+					providerPrices: map[string]int64{"coinbase": 150, "kraken": 149},
+					expected:       150,
+					events:         []string{oracle.CircuitBreakerTripped, oracle.CircuitBreakerConfirmed},
+				},
+			},
+		},
+		{
+			name: "providers that did not move do not confirm the move",
+			updates: []update{
+				{price: 100, expected: 100},
+				{
+					price:          150,
+					providerPrices: map[string]int64{"coinbase": 150, "kraken": 100, "okx": 50},
+					expected:       100,
+					events:         []string{oracle.CircuitBreakerTripped},
+				},
+			},
+		},
+		{
+			name: "move in the opposite direction trips the breaker again",
+			updates: []update{
+				{price: 100, expected: 100},
+				{price: 150, expected: 100, events: []string{oracle.CircuitBreakerTripped}},
+				{price: 50, expected: 100, events: []string{oracle.CircuitBreakerTripped}},
+				{price: 50, expected: 100},
+				{price: 50, expected: 50, events: []string{oracle.CircuitBreakerConfirmed}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := metricmocks.NewMetrics(t)
+			cb, err := oracle.NewCircuitBreaker(logger, circuitBreakerConfig(btcUSD, breaker), m)
+			require.NoError(t, err)
+
+			for i, u := range tc.updates {
+				for _, event := range u.events {
+					m.On("AddCircuitBreakerEvent", btcUSD.String(), event).Once()
+				}
+
+				providerPrices := make(aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int])
+				for provider, price := range u.providerPrices {
+					providerPrices[provider] = map[oracletypes.CurrencyPair]*big.Int{
+						btcUSD: big.NewInt(price),
+					}
+				}
+
+				// Currency pairs without a circuit breaker are always reported as is.
+				reported := cb.Apply(map[oracletypes.CurrencyPair]*big.Int{
+					btcUSD: big.NewInt(u.price),
+					ethUSD: big.NewInt(u.price),
+				}, providerPrices)
+				require.Equal(t, big.NewInt(u.expected), reported[btcUSD], "update %d", i)
+				require.Equal(t, big.NewInt(u.price), reported[ethUSD], "update %d", i)
+
+				m.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerUpdateMarketConfig(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	breaker := config.CircuitBreakerConfig{
+		Threshold:         0.1,
+		ConfirmationTicks: 2,
+	}
+
+	cb, err := oracle.NewCircuitBreaker(logger, circuitBreakerConfig(btcUSD, breaker), metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	apply := func(price int64) *big.Int {
+		return cb.Apply(map[oracletypes.CurrencyPair]*big.Int{
+			btcUSD: big.NewInt(price),
+		}, nil)[btcUSD]
+	}
+
+	require.Equal(t, big.NewInt(100), apply(100))
+	require.Equal(t, big.NewInt(100), apply(200))
+
+	// Disabling the circuit breaker reports the prices as is.
+	require.NoError(t, cb.UpdateMarketConfig(circuitBreakerConfig(btcUSD, config.CircuitBreakerConfig{})))
+	require.Equal(t, big.NewInt(200), apply(200))
+
+	// Enabling the circuit breaker again starts from the next price.
+	require.NoError(t, cb.UpdateMarketConfig(circuitBreakerConfig(btcUSD, breaker)))
+	require.Equal(t, big.NewInt(300), apply(300))
+	require.Equal(t, big.NewInt(300), apply(600))
+
+	// Invalid configs are rejected.
+	breaker.ConfirmationTicks = 1
+	require.Error(t, cb.UpdateMarketConfig(circuitBreakerConfig(btcUSD, breaker)))
+}
+
+func TestNewCircuitBreaker(t *testing.T) {
+	_, err := oracle.NewCircuitBreaker(nil, cfg, metrics.NewNopMetrics())
+	require.Error(t, err)
+
+	_, err = oracle.NewCircuitBreaker(logger, cfg, nil)
+	require.Error(t, err)
+
+	_, err = oracle.NewCircuitBreaker(logger, cfg, metrics.NewNopMetrics())
+	require.NoError(t, err)
+}
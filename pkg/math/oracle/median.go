@@ -3,40 +3,171 @@ package oracle
 import (
 	"fmt"
 	"math/big"
+	"sync"
 
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/aggregator"
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/metrics"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
 
+// WithheldInsufficientQuorum is the reason given for withholding the price of a currency pair
+// when none of its conversion paths have prices from the minimum number of providers.
+const WithheldInsufficientQuorum = "insufficient_quorum"
+
 // MedianAggregator is an aggregator that calculates the median price for each currency pair,
 // resolved from the median prices of all price feeds.
 type MedianAggregator struct {
-	logger *zap.Logger
-	cfg    config.AggregateMarketConfig
+	mtx     sync.RWMutex
+	logger  *zap.Logger
+	metrics metrics.Metrics
+	cfg     config.AggregateMarketConfig
+
+	// conversions is the set of conversion paths used to aggregate the price of each currency
+	// pair. These are resolved from the market config whenever it is updated.
+	conversions map[oracletypes.CurrencyPair][]config.Conversions
+
+	// withheld is the set of currency pairs whose prices were withheld in the latest
+	// aggregation, along with the reason each price was withheld.
+	withheld map[oracletypes.CurrencyPair]string
+
+	// volumes is the set of 24 hour volumes reported by each provider for the prices that are
+	// about to be aggregated. These weight the provider prices of volume-weighted feeds.
+	volumes aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float]
 }
 
-// NewMedianAggregator returns a new Median aggregator.
-func NewMedianAggregator(logger *zap.Logger, cfg config.AggregateMarketConfig) (*MedianAggregator, error) {
+// NewMedianAggregator returns a new Median aggregator. The metrics are used to record the
+// provider prices that are rejected as outliers.
+func NewMedianAggregator(
+	logger *zap.Logger,
+	cfg config.AggregateMarketConfig,
+	metrics metrics.Metrics,
+) (*MedianAggregator, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("logger cannot be nil")
 	}
 
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics cannot be nil")
+	}
+
 	if err := cfg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
-	return &MedianAggregator{
-		logger: logger,
-		cfg:    cfg,
-	}, nil
+	m := &MedianAggregator{
+		logger:  logger,
+		metrics: metrics,
+		cfg:     cfg,
+	}
+	m.conversions = m.resolveConversions(cfg)
+
+	return m, nil
+}
+
+// UpdateMarketConfig validates and atomically swaps the market config used by the aggregator.
+// Aggregations that are in progress complete with the previous config.
+func (m *MedianAggregator) UpdateMarketConfig(cfg config.AggregateMarketConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	conversions := m.resolveConversions(cfg)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.cfg = cfg
+	m.conversions = conversions
+	return nil
+}
+
+// resolveConversions returns the conversion paths of each aggregated feed in the market config,
+// discovering them from the feeds if path discovery is enabled for the aggregated feed. The
+// discovered paths are logged at the info level, and the configured paths at the debug level.
+func (m *MedianAggregator) resolveConversions(
+	cfg config.AggregateMarketConfig,
+) map[oracletypes.CurrencyPair][]config.Conversions {
+	conversions := make(map[oracletypes.CurrencyPair][]config.Conversions, len(cfg.AggregatedFeeds))
+	for _, feedCfg := range cfg.AggregatedFeeds {
+		cp := feedCfg.CurrencyPair
+		conversions[cp] = cfg.GetConversions(cp)
+
+		paths := make([]string, len(conversions[cp]))
+		for i, path := range conversions[cp] {
+			paths[i] = path.String()
+		}
+
+		log := m.logger.Debug
+		if feedCfg.PathDiscovery.IsEnabled() {
+			log = m.logger.Info
+		}
+
+		log(
+			"resolved conversion paths",
+			zap.String("currency_pair", cp.String()),
+			zap.Bool("discovered", feedCfg.PathDiscovery.IsEnabled()),
+			zap.Strings("paths", paths),
+		)
+	}
+
+	return conversions
+}
+
+// GetConversionPaths returns the conversion paths currently used to aggregate the price of each
+// currency pair.
+func (m *MedianAggregator) GetConversionPaths() map[oracletypes.CurrencyPair][]config.Conversions {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	conversions := make(map[oracletypes.CurrencyPair][]config.Conversions, len(m.conversions))
+	for cp, paths := range m.conversions {
+		conversions[cp] = paths
+	}
+
+	return conversions
+}
+
+// GetWithheldPrices returns the currency pairs whose prices were withheld in the latest aggregation,
+// along with the reason each price was withheld.
+func (m *MedianAggregator) GetWithheldPrices() map[oracletypes.CurrencyPair]string {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	withheld := make(map[oracletypes.CurrencyPair]string, len(m.withheld))
+	for cp, reason := range m.withheld {
+		withheld[cp] = reason
+	}
+
+	return withheld
+}
+
+// SetProviderVolumes sets the 24 hour volumes reported by each provider for the prices that are
+// about to be aggregated. The volumes are used to weight the provider prices of the feeds that are
+// configured to be volume-weighted.
+func (m *MedianAggregator) SetProviderVolumes(
+	volumes aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float],
+) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.volumes = volumes
+}
+
+// GetMarketConfig returns the market config currently used by the aggregator.
+func (m *MedianAggregator) GetMarketConfig() config.AggregateMarketConfig {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.cfg
 }
 
 // AggregateFn returns the aggregate function for the median price calculation. Specifically, this
-// aggregation function first resolves the median prices for all price feeds, and then calculates
-// the median price for each currency pair using the conversion markets.
+// aggregation function first resolves the median prices for all price feeds (after rejecting any
+// outlier provider prices), and then calculates the median price for each currency pair using the
+// conversion markets.
 //
 // For example, if the oracle receives price updates for
 //   - BTC/USDT
@@ -53,25 +184,31 @@ func NewMedianAggregator(logger *zap.Logger, cfg config.AggregateMarketConfig) (
 //  3. BTC/USD = BTC/USD
 //
 // The final median price for BTC/USD will be the median of the prices calculated from the above
-// calculations.
+// calculations. Only the conversion paths whose feeds have prices from the minimum number of
+// providers are used. If none of the paths meet the quorum, the price of BTC/USD is withheld.
 func (m *MedianAggregator) AggregateFn() aggregator.AggregateFn[string, map[oracletypes.CurrencyPair]*big.Int] {
 	return func(
 		feedsPerProvider aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
 	) map[oracletypes.CurrencyPair]*big.Int {
+		m.mtx.RLock()
+		cfg, conversions, volumes := m.cfg, m.conversions, m.volumes
+		m.mtx.RUnlock()
+
 		// Calculate the median price for each price feed.
-		feedMedians := aggregator.ComputeMedian()(feedsPerProvider)
+		feedMedians, numProviders := m.CalculateFeedMedians(cfg, feedsPerProvider, volumes)
 		m.logger.Info("calculated median prices for raw price feeds", zap.Int("num_prices", len(feedMedians)))
 
 		// Scale all of the medians to a common number of decimals. This does not lose precision.
 		scaledMedians := make(map[oracletypes.CurrencyPair]*big.Int)
 		for cp, price := range feedMedians {
-			scaledPrice, err := ScaleUpCurrencyPairPrice(int64(cp.Decimals()), price)
+			decimals := cfg.GetDecimals(cp)
+			scaledPrice, err := ScaleUpCurrencyPairPrice(int64(decimals), price)
 			if err != nil {
 				m.logger.Error(
 					"failed to scale price",
 					zap.Error(err),
 					zap.String("currency_pair", cp.String()),
-					zap.Int("decimals", cp.Decimals()),
+					zap.Uint64("decimals", decimals),
 					zap.String("price", price.String()),
 				)
 
@@ -83,14 +220,30 @@ func (m *MedianAggregator) AggregateFn() aggregator.AggregateFn[string, map[orac
 
 		// Determine the final aggregated price for each currency pair.
 		aggregatedMedians := make(map[oracletypes.CurrencyPair]*big.Int)
-		for _, cfg := range m.cfg.AggregatedFeeds {
+		withheld := make(map[oracletypes.CurrencyPair]string)
+		for _, feedCfg := range cfg.AggregatedFeeds {
+			cp := feedCfg.CurrencyPair
+			feedCfg.MinProviders = cfg.GetMinProviders(cp)
+			feedCfg.Conversions = conversions[cp]
+
 			// Get the converted prices for set of convertable markets.
 			// ex. BTC/USDT * USDT/USD = BTC/USD
 			//     BTC/USDC * USDC/USD = BTC/USD
-			convertedPrices := m.CalculateConvertedPrices(cfg, scaledMedians)
+			convertedPrices, quorum := m.CalculateConvertedPrices(feedCfg, scaledMedians, numProviders)
+
+			// If none of the conversion paths met the quorum, withhold the price.
+			if !quorum {
+				m.logger.Warn(
+					"withholding price; insufficient provider quorum",
+					zap.String("currency_pair", cp.String()),
+					zap.Uint64("min_providers", feedCfg.MinProviders),
+				)
+				withheld[cp] = WithheldInsufficientQuorum
+				m.metrics.AddWithheldPrice(cp.String(), WithheldInsufficientQuorum)
+				continue
+			}
 
 			// If there were no converted prices, log an error and continue.
-			cp := cfg.CurrencyPair
 			if len(convertedPrices) == 0 {
 				m.logger.Error("no converted prices", zap.String("currency_pair", cp.String()))
 				continue
@@ -108,13 +261,14 @@ func (m *MedianAggregator) AggregateFn() aggregator.AggregateFn[string, map[orac
 
 		// Scale all of the aggregated medians back to the original number of decimals.
 		for cp, price := range aggregatedMedians {
-			unscaledPrice, err := ScaleDownCurrencyPairPrice(int64(cp.Decimals()), price)
+			decimals := cfg.GetDecimals(cp)
+			unscaledPrice, err := ScaleDownCurrencyPairPrice(int64(decimals), price)
 			if err != nil {
 				m.logger.Error(
 					"failed to scale price",
 					zap.Error(err),
 					zap.String("currency_pair", cp.String()),
-					zap.Int("decimals", cp.Decimals()),
+					zap.Uint64("decimals", decimals),
 					zap.String("price", price.String()),
 				)
 
@@ -129,10 +283,100 @@ func (m *MedianAggregator) AggregateFn() aggregator.AggregateFn[string, map[orac
 			aggregatedMedians[cp] = unscaledPrice
 		}
 
+		m.mtx.Lock()
+		m.withheld = withheld
+		m.mtx.Unlock()
+
 		return aggregatedMedians
 	}
 }
 
+// CalculateFeedMedians calculates the median price for each price feed across all providers. Prior
+// to calculating the median, the outlier filter configured for the feed (if any) rejects provider
+// prices that deviate too far from the rest. Rejected prices are logged and recorded in metrics.
+// The remaining prices of volume-weighted feeds are weighted by the given provider volumes. The
+// number of distinct providers whose prices were used for each median is also returned.
+func (m *MedianAggregator) CalculateFeedMedians(
+	cfg config.AggregateMarketConfig,
+	feedsPerProvider aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Int],
+	volumes aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float],
+) (map[oracletypes.CurrencyPair]*big.Int, map[oracletypes.CurrencyPair]int) {
+	// Group the provider prices by feed.
+	pricesByFeed := make(map[oracletypes.CurrencyPair]map[string]*big.Int)
+	for provider, prices := range feedsPerProvider {
+		for cp, price := range prices {
+			// Only include prices that are not nil
+			if price == nil {
+				continue
+			}
+
+			if _, ok := pricesByFeed[cp]; !ok {
+				pricesByFeed[cp] = make(map[string]*big.Int)
+			}
+
+			pricesByFeed[cp][provider] = price
+		}
+	}
+
+	medians := make(map[oracletypes.CurrencyPair]*big.Int, len(pricesByFeed))
+	numProviders := make(map[oracletypes.CurrencyPair]int, len(pricesByFeed))
+	for cp, prices := range pricesByFeed {
+		filter, err := NewOutlierFilter(cfg.GetOutlierFilter(cp))
+		if err != nil {
+			m.logger.Error("invalid outlier filter", zap.Error(err), zap.String("currency_pair", cp.String()))
+		}
+
+		if filter != nil {
+			kept, rejected := filter.Filter(prices)
+			for _, r := range rejected {
+				m.logger.Warn(
+					"rejected outlier price",
+					zap.String("currency_pair", cp.String()),
+					zap.String("provider", r.Provider),
+					zap.String("price", r.Price.String()),
+					zap.String("filter", filter.Name()),
+					zap.String("reason", r.Reason),
+				)
+				m.metrics.AddRejectedPrice(r.Provider, cp.String(), filter.Name())
+			}
+
+			prices = kept
+		}
+
+		if len(prices) == 0 {
+			continue
+		}
+
+		if cfg.IsVolumeWeighted(cp) {
+			medians[cp] = volumeWeightedMedianOf(cp, prices, volumes)
+		} else {
+			medians[cp] = medianOf(prices)
+		}
+		numProviders[cp] = len(prices)
+	}
+
+	return medians, numProviders
+}
+
+// volumeWeightedMedianOf returns the median of the given provider prices of the currency pair,
+// weighted by the volume reported by each provider alongside its price. A provider that did not
+// report a volume carries the median of the reported volumes.
+func volumeWeightedMedianOf(
+	cp oracletypes.CurrencyPair,
+	prices map[string]*big.Int,
+	volumes aggregator.AggregatedProviderData[string, map[oracletypes.CurrencyPair]*big.Float],
+) *big.Int {
+	weighted := make([]aggregator.WeightedPrice, 0, len(prices))
+	for provider, price := range prices {
+		weighted = append(weighted, aggregator.WeightedPrice{
+			Price:  price,
+			Weight: volumes[provider][cp],
+		})
+	}
+
+	return aggregator.CalculateVolumeWeightedMedian(weighted)
+}
+
 // CalculateConvertedPrices calculates the converted prices for each currency pair using the
 // provided median prices and the conversion markets.
 //
@@ -140,14 +384,34 @@ func (m *MedianAggregator) AggregateFn() aggregator.AggregateFn[string, map[orac
 // market to convert the BTC/USDT price to BTC/USD. In this case, the medians map would contain
 // the median prices for BTC/USDT and USDT/USD, and the conversions would contain a sorted list of
 // operations to convert the price of BTC/USDT to BTC/USD i.e. BTC/USDT * USDT/USD = BTC/USD.
+//
+// A conversion path is only used if each of its feeds has a median price from at least the
+// configured minimum number of providers. The returned boolean is false if none of the conversion
+// paths meet the quorum.
 func (m *MedianAggregator) CalculateConvertedPrices(
 	cfg config.AggregateFeedConfig,
 	medians map[oracletypes.CurrencyPair]*big.Int,
-) []*big.Int {
+	numProviders map[oracletypes.CurrencyPair]int,
+) ([]*big.Int, bool) {
 	convertedPrices := make([]*big.Int, 0)
 	cp := cfg.CurrencyPair
+	quorum := false
 
 	for _, conversion := range cfg.Conversions {
+		// Skip the conversion path if any of its feeds do not meet the quorum.
+		if feed, ok := lacksQuorum(conversion, numProviders, cfg.MinProviders); ok {
+			m.logger.Debug(
+				"skipping conversion; insufficient provider quorum",
+				zap.String("currency_pair", cp.String()),
+				zap.String("feed", feed.String()),
+				zap.Int("num_providers", numProviders[feed]),
+				zap.Uint64("min_providers", cfg.MinProviders),
+			)
+
+			continue
+		}
+		quorum = true
+
 		// Calculate the converted price.
 		convertedPrice, err := m.CalculateConvertedPrice(cp, conversion, medians)
 		if err != nil {
@@ -164,7 +428,27 @@ func (m *MedianAggregator) CalculateConvertedPrices(
 		convertedPrices = append(convertedPrices, convertedPrice)
 	}
 
-	return convertedPrices
+	return convertedPrices, quorum
+}
+
+// lacksQuorum returns the first feed in the conversion path that has prices from fewer than the
+// minimum number of providers, if any.
+func lacksQuorum(
+	conversion config.Conversions,
+	numProviders map[oracletypes.CurrencyPair]int,
+	minProviders uint64,
+) (oracletypes.CurrencyPair, bool) {
+	if minProviders == 0 {
+		return oracletypes.CurrencyPair{}, false
+	}
+
+	for _, feed := range conversion {
+		if uint64(numProviders[feed.CurrencyPair]) < minProviders {
+			return feed.CurrencyPair, true
+		}
+	}
+
+	return oracletypes.CurrencyPair{}, false
 }
 
 // CalculateConvertedPrice converts a set of median prices to a target currency pair using a set of
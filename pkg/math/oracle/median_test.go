@@ -8,6 +8,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/metrics"
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
 	"github.com/skip-mev/slinky/pkg/math/oracle"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
@@ -203,7 +205,7 @@ func TestMedian(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			median, err := oracle.NewMedianAggregator(logger, cfg)
+			median, err := oracle.NewMedianAggregator(logger, cfg, metrics.NewNopMetrics())
 			require.NoError(t, err)
 
 			aggFn := median.AggregateFn()
@@ -218,6 +220,61 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+func TestUpdateMarketConfig(t *testing.T) {
+	median, err := oracle.NewMedianAggregator(logger, cfg, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	prices := map[string]map[oracletypes.CurrencyPair]*big.Int{
+		"coinbase": {
+			oracletypes.NewCurrencyPair("BITCOIN", "USD"): createPrice(40_000, 8),
+		},
+	}
+
+	aggFn := median.AggregateFn()
+	require.Len(t, aggFn(prices), 1)
+
+	t.Run("invalid config is rejected", func(t *testing.T) {
+		invalid := config.AggregateMarketConfig{
+			AggregatedFeeds: map[string]config.AggregateFeedConfig{
+				"BITCOIN/USD": {
+					CurrencyPair: oracletypes.NewCurrencyPair("BITCOIN", "USD"),
+				},
+			},
+		}
+
+		require.Error(t, median.UpdateMarketConfig(invalid))
+		require.Equal(t, cfg, median.GetMarketConfig())
+	})
+
+	t.Run("valid config is used by the aggregate function", func(t *testing.T) {
+		updated := config.AggregateMarketConfig{
+			Feeds: map[string]config.FeedConfig{
+				"ETHEREUM/USD": {
+					CurrencyPair: oracletypes.NewCurrencyPair("ETHEREUM", "USD"),
+				},
+			},
+			AggregatedFeeds: map[string]config.AggregateFeedConfig{
+				"ETHEREUM/USD": {
+					CurrencyPair: oracletypes.NewCurrencyPair("ETHEREUM", "USD"),
+					Conversions: []config.Conversions{
+						{
+							{
+								CurrencyPair: oracletypes.NewCurrencyPair("ETHEREUM", "USD"),
+							},
+						},
+					},
+				},
+			},
+		}
+
+		require.NoError(t, median.UpdateMarketConfig(updated))
+		require.Equal(t, updated, median.GetMarketConfig())
+
+		// Bitcoin is no longer part of the market config so it should not be aggregated.
+		require.Empty(t, aggFn(prices))
+	})
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -428,7 +485,7 @@ func TestCalculateConvertedPrices(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			aggregator, err := oracle.NewMedianAggregator(logger, cfg)
+			aggregator, err := oracle.NewMedianAggregator(logger, cfg, metrics.NewNopMetrics())
 			require.NoError(t, err)
 
 			price, err := aggregator.CalculateConvertedPrice(tc.outcome, tc.operations, tc.medians)
@@ -444,6 +501,363 @@ func TestCalculateConvertedPrices(t *testing.T) {
 }
 
 // verifyPrice verifies that the expected price matches the actual price within an acceptable delta.
+func TestAggregateFnOutlierFilter(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	eth := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	// BITCOIN/USD uses the market's deviation filter, whereas ETHEREUM/USD disables filtering.
+	filterCfg := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btc.String(): {
+				CurrencyPair: btc,
+			},
+			eth.String(): {
+				CurrencyPair: eth,
+				OutlierFilter: config.OutlierFilterConfig{
+					Type: config.OutlierFilterNone,
+				},
+			},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btc.String(): {
+				CurrencyPair: btc,
+				Conversions: []config.Conversions{
+					{
+						{
+							CurrencyPair: btc,
+						},
+					},
+				},
+			},
+			eth.String(): {
+				CurrencyPair: eth,
+				Conversions: []config.Conversions{
+					{
+						{
+							CurrencyPair: eth,
+						},
+					},
+				},
+			},
+		},
+		OutlierFilter: config.OutlierFilterConfig{
+			Type:      config.OutlierFilterDeviation,
+			Threshold: 0.1,
+		},
+	}
+
+	m := metricmocks.NewMetrics(t)
+	m.On("AddRejectedPrice", "glitch", btc.String(), config.OutlierFilterDeviation).Once()
+
+	median, err := oracle.NewMedianAggregator(logger, filterCfg, m)
+	require.NoError(t, err)
+
+	prices := median.AggregateFn()(map[string]map[oracletypes.CurrencyPair]*big.Int{
+		"coinbase": {
+			btc: createPrice(100, 8),
+			eth: createPrice(10, 18),
+		},
+		"kraken": {
+			btc: createPrice(102, 8),
+			eth: createPrice(11, 18),
+		},
+		"glitch": {
+			btc: createPrice(1_000_000, 8),
+			eth: createPrice(1_000, 18),
+		},
+	})
+
+	// The glitched BITCOIN/USD price is rejected, so the median is taken over the remaining
+	// two prices. The glitched ETHEREUM/USD price is kept since filtering is disabled.
+	require.Len(t, prices, 2)
+	verifyPrice(t, createPrice(101, 8), prices[btc])
+	verifyPrice(t, createPrice(11, 18), prices[eth])
+}
+
+func TestAggregateFnQuorum(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	btcUSDT := oracletypes.NewCurrencyPair("BITCOIN", "USDT")
+	usdtUSD := oracletypes.NewCurrencyPair("USDT", "USD")
+	ethUSD := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	// BITCOIN/USD can be resolved directly or via USDT. ETHEREUM/USD can only be resolved
+	// directly and only requires a single provider.
+	quorumCfg := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btcUSD.String():  {CurrencyPair: btcUSD},
+			btcUSDT.String(): {CurrencyPair: btcUSDT},
+			usdtUSD.String(): {CurrencyPair: usdtUSD},
+			ethUSD.String():  {CurrencyPair: ethUSD},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btcUSD.String(): {
+				CurrencyPair: btcUSD,
+				Conversions: []config.Conversions{
+					{
+						{CurrencyPair: btcUSD},
+					},
+					{
+						{CurrencyPair: btcUSDT},
+						{CurrencyPair: usdtUSD},
+					},
+				},
+			},
+			ethUSD.String(): {
+				CurrencyPair: ethUSD,
+				Conversions: []config.Conversions{
+					{
+						{CurrencyPair: ethUSD},
+					},
+				},
+				MinProviders: 1,
+			},
+		},
+		MinProviders: 2,
+	}
+
+	testCases := []struct {
+		name              string
+		pricesPerProvider map[string]map[oracletypes.CurrencyPair]*big.Int
+		expected          map[oracletypes.CurrencyPair]*big.Int
+		expectedWithheld  map[oracletypes.CurrencyPair]string
+	}{
+		{
+			name: "all conversion paths meet the quorum",
+			pricesPerProvider: map[string]map[oracletypes.CurrencyPair]*big.Int{
+				"coinbase": {
+					btcUSD:  createPrice(100, 8),
+					btcUSDT: createPrice(100, 8),
+					usdtUSD: createPrice(1, 8),
+				},
+				"kraken": {
+					btcUSD:  createPrice(100, 8),
+					btcUSDT: createPrice(100, 8),
+					usdtUSD: createPrice(1, 8),
+					ethUSD:  createPrice(10, 18),
+				},
+			},
+			expected: map[oracletypes.CurrencyPair]*big.Int{
+				btcUSD: createPrice(100, 8),
+				ethUSD: createPrice(10, 18),
+			},
+			expectedWithheld: map[oracletypes.CurrencyPair]string{},
+		},
+		{
+			name: "only the direct conversion path meets the quorum",
+			pricesPerProvider: map[string]map[oracletypes.CurrencyPair]*big.Int{
+				"coinbase": {
+					btcUSD:  createPrice(100, 8),
+					btcUSDT: createPrice(200, 8),
+					usdtUSD: createPrice(1, 8),
+				},
+				"kraken": {
+					btcUSD:  createPrice(100, 8),
+					btcUSDT: createPrice(200, 8),
+					ethUSD:  createPrice(10, 18),
+				},
+			},
+			expected: map[oracletypes.CurrencyPair]*big.Int{
+				btcUSD: createPrice(100, 8),
+				ethUSD: createPrice(10, 18),
+			},
+			expectedWithheld: map[oracletypes.CurrencyPair]string{},
+		},
+		{
+			name: "no conversion path meets the quorum",
+			pricesPerProvider: map[string]map[oracletypes.CurrencyPair]*big.Int{
+				"coinbase": {
+					btcUSD:  createPrice(100, 8),
+					btcUSDT: createPrice(100, 8),
+					usdtUSD: createPrice(1, 8),
+					ethUSD:  createPrice(10, 18),
+				},
+			},
+			expected: map[oracletypes.CurrencyPair]*big.Int{
+				ethUSD: createPrice(10, 18),
+			},
+			expectedWithheld: map[oracletypes.CurrencyPair]string{
+				btcUSD: oracle.WithheldInsufficientQuorum,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := metricmocks.NewMetrics(t)
+			for cp := range tc.expectedWithheld {
+				m.On("AddWithheldPrice", cp.String(), oracle.WithheldInsufficientQuorum).Once()
+			}
+
+			median, err := oracle.NewMedianAggregator(logger, quorumCfg, m)
+			require.NoError(t, err)
+
+			prices := median.AggregateFn()(tc.pricesPerProvider)
+			require.Equal(t, len(tc.expected), len(prices))
+			for cp, expectedPrice := range tc.expected {
+				actualPrice, ok := prices[cp]
+				require.True(t, ok)
+				verifyPrice(t, expectedPrice, actualPrice)
+			}
+
+			require.Equal(t, tc.expectedWithheld, median.GetWithheldPrices())
+		})
+	}
+}
+
+func TestAggregateFnDecimals(t *testing.T) {
+	pepeUSD := oracletypes.NewCurrencyPair("PEPE", "USD")
+	pepeUSDT := oracletypes.NewCurrencyPair("PEPE", "USDT")
+	usdtUSD := oracletypes.NewCurrencyPair("USDT", "USD")
+
+	// PEPE is reported with 18 decimals, while USDT/USD uses the legacy 8 decimals.
+	decimalsCfg := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			pepeUSDT.String(): {CurrencyPair: pepeUSDT, Decimals: 18},
+			usdtUSD.String():  {CurrencyPair: usdtUSD},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			pepeUSD.String(): {
+				CurrencyPair: pepeUSD,
+				Conversions: []config.Conversions{
+					{
+						{CurrencyPair: pepeUSDT},
+						{CurrencyPair: usdtUSD},
+					},
+				},
+				Decimals: 18,
+			},
+		},
+	}
+
+	median, err := oracle.NewMedianAggregator(logger, decimalsCfg, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	prices := median.AggregateFn()(map[string]map[oracletypes.CurrencyPair]*big.Int{
+		"coinbase": {
+			// 0.00000123456789 with 18 decimals.
+			pepeUSDT: big.NewInt(1_234_567_890_000),
+			// 0.5 with 8 decimals.
+			usdtUSD: big.NewInt(50_000_000),
+		},
+	})
+
+	// The converted price keeps all of the precision of the 18 decimal feed.
+	require.Len(t, prices, 1)
+	require.Equal(t, "617283945000", prices[pepeUSD].String())
+}
+
+func TestAggregateFnPathDiscovery(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	btcUSDT := oracletypes.NewCurrencyPair("BITCOIN", "USDT")
+	usdUSDT := oracletypes.NewCurrencyPair("USD", "USDT")
+
+	discoveryCfg := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btcUSD.String():  {CurrencyPair: btcUSD},
+			btcUSDT.String(): {CurrencyPair: btcUSDT},
+			usdUSDT.String(): {CurrencyPair: usdUSDT},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btcUSD.String(): {
+				CurrencyPair: btcUSD,
+				PathDiscovery: &config.PathDiscoveryConfig{
+					MaxHops: 2,
+				},
+			},
+		},
+	}
+
+	median, err := oracle.NewMedianAggregator(logger, discoveryCfg, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	// The conversion paths are discovered from the feeds.
+	require.Equal(t, map[oracletypes.CurrencyPair][]config.Conversions{
+		btcUSD: {
+			{
+				{CurrencyPair: btcUSD},
+			},
+			{
+				{CurrencyPair: btcUSDT},
+				{CurrencyPair: usdUSDT, Invert: true},
+			},
+		},
+	}, median.GetConversionPaths())
+
+	prices := median.AggregateFn()(map[string]map[oracletypes.CurrencyPair]*big.Int{
+		"coinbase": {
+			btcUSD:  big.NewInt(7_000_000_000_000),
+			btcUSDT: big.NewInt(7_010_000_000_000),
+			usdUSDT: big.NewInt(100_000_000),
+		},
+	})
+
+	// The price is the median across the discovered paths.
+	require.Len(t, prices, 1)
+	require.Equal(t, big.NewInt(7_005_000_000_000), prices[btcUSD])
+
+	// Restricting the path discovery to a single hop only uses the direct feed.
+	feed := discoveryCfg.AggregatedFeeds[btcUSD.String()]
+	feed.PathDiscovery = &config.PathDiscoveryConfig{MaxHops: 1}
+	discoveryCfg.AggregatedFeeds = map[string]config.AggregateFeedConfig{btcUSD.String(): feed}
+	require.NoError(t, median.UpdateMarketConfig(discoveryCfg))
+	require.Len(t, median.GetConversionPaths()[btcUSD], 1)
+}
+
+func TestAggregateFnVolumeWeighted(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	ethUSD := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	volumeCfg := config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			btcUSD.String(): {CurrencyPair: btcUSD, VolumeWeighted: true},
+			ethUSD.String(): {CurrencyPair: ethUSD},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			btcUSD.String(): {
+				CurrencyPair: btcUSD,
+				Conversions:  []config.Conversions{{{CurrencyPair: btcUSD}}},
+				MinProviders: 3,
+			},
+			ethUSD.String(): {
+				CurrencyPair: ethUSD,
+				Conversions:  []config.Conversions{{{CurrencyPair: ethUSD}}},
+			},
+		},
+	}
+
+	median, err := oracle.NewMedianAggregator(logger, volumeCfg, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	providerPrices := map[string]map[oracletypes.CurrencyPair]*big.Int{
+		"illiquid1": {btcUSD: big.NewInt(100), ethUSD: big.NewInt(10)},
+		"illiquid2": {btcUSD: big.NewInt(101), ethUSD: big.NewInt(11)},
+		"deep":      {btcUSD: big.NewInt(110), ethUSD: big.NewInt(20)},
+	}
+
+	// Only the volume-weighted feed is weighted by the provider volumes. The weighted feed still
+	// counts each provider towards the quorum.
+	median.SetProviderVolumes(map[string]map[oracletypes.CurrencyPair]*big.Float{
+		"illiquid1": {btcUSD: big.NewFloat(1), ethUSD: big.NewFloat(1)},
+		"illiquid2": {btcUSD: big.NewFloat(1), ethUSD: big.NewFloat(1)},
+		"deep":      {btcUSD: big.NewFloat(1000), ethUSD: big.NewFloat(1000)},
+	})
+	prices := median.AggregateFn()(providerPrices)
+	require.Equal(t, map[oracletypes.CurrencyPair]*big.Int{
+		btcUSD: big.NewInt(110),
+		ethUSD: big.NewInt(11),
+	}, prices)
+	require.Empty(t, median.GetWithheldPrices())
+
+	// If a provider does not report a volume, its price carries the median of the reported
+	// volumes and the remaining providers are still weighted.
+	median.SetProviderVolumes(map[string]map[oracletypes.CurrencyPair]*big.Float{
+		"illiquid1": {btcUSD: big.NewFloat(1)},
+		"deep":      {btcUSD: big.NewFloat(1000)},
+	})
+	prices = median.AggregateFn()(providerPrices)
+	require.Equal(t, big.NewInt(110), prices[btcUSD])
+}
+
 func verifyPrice(t *testing.T, expected, actual *big.Int) {
 	t.Helper()
 
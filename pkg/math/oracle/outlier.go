@@ -0,0 +1,201 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/skip-mev/slinky/aggregator"
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// MinOutlierFilterPrices is the minimum number of provider prices required to filter outliers.
+// With fewer prices, there is no majority to determine which price is the outlier.
+const MinOutlierFilterPrices = 3
+
+// OutlierFilter defines the interface for a filter that rejects outlier provider prices before
+// the median price of a feed is calculated.
+type OutlierFilter interface {
+	// Name returns the name of the filter. This is used as the reason for rejected prices.
+	Name() string
+
+	// Filter returns the provider prices that are kept along with the prices that were
+	// rejected as outliers. The given prices must not be modified.
+	Filter(prices map[string]*big.Int) (map[string]*big.Int, []RejectedPrice)
+}
+
+// RejectedPrice is a provider price that was rejected as an outlier.
+type RejectedPrice struct {
+	// Provider is the name of the provider that reported the price.
+	Provider string
+
+	// Price is the price reported by the provider.
+	Price *big.Int
+
+	// Reason is a human readable description of why the price was rejected.
+	Reason string
+}
+
+// NewOutlierFilter returns the outlier filter for the given config. A nil filter is returned if
+// the config does not filter outliers.
+func NewOutlierFilter(cfg config.OutlierFilterConfig) (OutlierFilter, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case config.OutlierFilterMAD:
+		return NewMADFilter(cfg.Threshold), nil
+	case config.OutlierFilterDeviation:
+		return NewDeviationFilter(cfg.Threshold), nil
+	default:
+		return nil, nil
+	}
+}
+
+// MADFilter rejects provider prices whose absolute deviation from the median exceeds a threshold
+// number of median absolute deviations (MAD). Note that if the majority of prices are identical,
+// the MAD is zero and any price that differs from the median is rejected.
+type MADFilter struct {
+	threshold *big.Float
+}
+
+// NewMADFilter returns a new MAD filter with the given threshold.
+func NewMADFilter(threshold float64) *MADFilter {
+	return &MADFilter{
+		threshold: big.NewFloat(threshold),
+	}
+}
+
+// Name returns the name of the filter.
+func (f *MADFilter) Name() string {
+	return config.OutlierFilterMAD
+}
+
+// Filter returns the provider prices that are within the threshold number of median absolute
+// deviations of the median.
+func (f *MADFilter) Filter(prices map[string]*big.Int) (map[string]*big.Int, []RejectedPrice) {
+	if len(prices) < MinOutlierFilterPrices {
+		return prices, nil
+	}
+
+	median := medianOf(prices)
+	deviations := absDeviations(prices, median)
+
+	values := make([]*big.Int, 0, len(deviations))
+	for _, deviation := range deviations {
+		values = append(values, deviation)
+	}
+	mad := aggregator.CalculateMedian(values)
+
+	// A price is an outlier if |price - median| > threshold * mad.
+	limit := new(big.Float).Mul(new(big.Float).SetInt(mad), f.threshold)
+	return partition(prices, func(provider string) (string, bool) {
+		deviation := deviations[provider]
+		if new(big.Float).SetInt(deviation).Cmp(limit) <= 0 {
+			return "", false
+		}
+
+		return fmt.Sprintf(
+			"deviation %s from median %s exceeds %s times the median absolute deviation %s",
+			deviation, median, f.threshold.Text('g', -1), mad,
+		), true
+	})
+}
+
+// DeviationFilter rejects provider prices whose deviation from the median exceeds a threshold
+// fraction of the median (i.e. 0.05 for 5%).
+type DeviationFilter struct {
+	threshold *big.Float
+}
+
+// NewDeviationFilter returns a new deviation filter with the given threshold.
+func NewDeviationFilter(threshold float64) *DeviationFilter {
+	return &DeviationFilter{
+		threshold: big.NewFloat(threshold),
+	}
+}
+
+// Name returns the name of the filter.
+func (f *DeviationFilter) Name() string {
+	return config.OutlierFilterDeviation
+}
+
+// Filter returns the provider prices that are within the threshold fraction of the median.
+func (f *DeviationFilter) Filter(prices map[string]*big.Int) (map[string]*big.Int, []RejectedPrice) {
+	if len(prices) < MinOutlierFilterPrices {
+		return prices, nil
+	}
+
+	median := medianOf(prices)
+	if median.Sign() == 0 {
+		return prices, nil
+	}
+
+	deviations := absDeviations(prices, median)
+
+	// A price is an outlier if |price - median| > threshold * median.
+	medianFloat := new(big.Float).SetInt(median)
+	limit := new(big.Float).Mul(medianFloat, f.threshold)
+	return partition(prices, func(provider string) (string, bool) {
+		deviation := new(big.Float).SetInt(deviations[provider])
+		if deviation.Cmp(limit) <= 0 {
+			return "", false
+		}
+
+		fraction, _ := new(big.Float).Quo(deviation, medianFloat).Float64()
+		return fmt.Sprintf(
+			"deviation %.4f%% from median %s exceeds %s%%",
+			fraction*100, median, new(big.Float).Mul(f.threshold, big.NewFloat(100)).Text('g', -1),
+		), true
+	})
+}
+
+// medianOf returns the median of the given prices without modifying them.
+func medianOf(prices map[string]*big.Int) *big.Int {
+	values := make([]*big.Int, 0, len(prices))
+	for _, price := range prices {
+		values = append(values, price)
+	}
+
+	return aggregator.CalculateMedian(values)
+}
+
+// absDeviations returns the absolute deviation of each price from the median.
+func absDeviations(prices map[string]*big.Int, median *big.Int) map[string]*big.Int {
+	deviations := make(map[string]*big.Int, len(prices))
+	for provider, price := range prices {
+		deviations[provider] = new(big.Int).Abs(new(big.Int).Sub(price, median))
+	}
+
+	return deviations
+}
+
+// partition splits the prices into the prices that are kept and the prices that are rejected by
+// the given function. Rejected prices are sorted by provider.
+func partition(
+	prices map[string]*big.Int,
+	reject func(provider string) (string, bool),
+) (map[string]*big.Int, []RejectedPrice) {
+	kept := make(map[string]*big.Int, len(prices))
+	var rejected []RejectedPrice
+
+	for provider, price := range prices {
+		if reason, ok := reject(provider); ok {
+			rejected = append(rejected, RejectedPrice{
+				Provider: provider,
+				Price:    price,
+				Reason:   reason,
+			})
+			continue
+		}
+
+		kept[provider] = price
+	}
+
+	sort.Slice(rejected, func(i, j int) bool {
+		return rejected[i].Provider < rejected[j].Provider
+	})
+
+	return kept, rejected
+}
@@ -0,0 +1,220 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/pkg/math/oracle"
+)
+
+func TestNewOutlierFilter(t *testing.T) {
+	testCases := []struct {
+		name         string
+		cfg          config.OutlierFilterConfig
+		expectedName string
+		expectErr    bool
+	}{
+		{
+			name: "unset",
+			cfg:  config.OutlierFilterConfig{},
+		},
+		{
+			name: "none",
+			cfg: config.OutlierFilterConfig{
+				Type: config.OutlierFilterNone,
+			},
+		},
+		{
+			name: "mad",
+			cfg: config.OutlierFilterConfig{
+				Type:      config.OutlierFilterMAD,
+				Threshold: 3,
+			},
+			expectedName: config.OutlierFilterMAD,
+		},
+		{
+			name: "deviation",
+			cfg: config.OutlierFilterConfig{
+				Type:      config.OutlierFilterDeviation,
+				Threshold: 0.1,
+			},
+			expectedName: config.OutlierFilterDeviation,
+		},
+		{
+			name: "invalid",
+			cfg: config.OutlierFilterConfig{
+				Type: config.OutlierFilterDeviation,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := oracle.NewOutlierFilter(tc.cfg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if len(tc.expectedName) == 0 {
+				require.Nil(t, filter)
+				return
+			}
+
+			require.Equal(t, tc.expectedName, filter.Name())
+		})
+	}
+}
+
+func TestMADFilter(t *testing.T) {
+	testCases := []struct {
+		name             string
+		threshold        float64
+		prices           map[string]*big.Int
+		expectedRejected []string
+	}{
+		{
+			name:      "too few prices to filter",
+			threshold: 3,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(1000),
+			},
+		},
+		{
+			name:      "no outliers",
+			threshold: 3,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(101),
+				"c": big.NewInt(99),
+				"d": big.NewInt(102),
+			},
+		},
+		{
+			name:      "single outlier",
+			threshold: 3,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(101),
+				"c": big.NewInt(99),
+				"d": big.NewInt(150),
+			},
+			expectedRejected: []string{"d"},
+		},
+		{
+			name:      "outliers on both sides",
+			threshold: 3,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(101),
+				"c": big.NewInt(99),
+				"d": big.NewInt(100),
+				"e": big.NewInt(1),
+				"f": big.NewInt(1000),
+			},
+			expectedRejected: []string{"e", "f"},
+		},
+		{
+			name:      "zero mad rejects any deviation",
+			threshold: 3,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(100),
+				"c": big.NewInt(101),
+			},
+			expectedRejected: []string{"c"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kept, rejected := oracle.NewMADFilter(tc.threshold).Filter(tc.prices)
+			verifyFiltered(t, tc.prices, kept, rejected, tc.expectedRejected)
+		})
+	}
+}
+
+func TestDeviationFilter(t *testing.T) {
+	testCases := []struct {
+		name             string
+		threshold        float64
+		prices           map[string]*big.Int
+		expectedRejected []string
+	}{
+		{
+			name:      "too few prices to filter",
+			threshold: 0.05,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(1000),
+			},
+		},
+		{
+			name:      "zero median",
+			threshold: 0.05,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(0),
+				"b": big.NewInt(0),
+				"c": big.NewInt(10),
+			},
+		},
+		{
+			name:      "price at the threshold is kept",
+			threshold: 0.05,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(100),
+				"c": big.NewInt(105),
+			},
+		},
+		{
+			name:      "price above the threshold is rejected",
+			threshold: 0.05,
+			prices: map[string]*big.Int{
+				"a": big.NewInt(100),
+				"b": big.NewInt(100),
+				"c": big.NewInt(106),
+				"d": big.NewInt(94),
+			},
+			expectedRejected: []string{"c", "d"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kept, rejected := oracle.NewDeviationFilter(tc.threshold).Filter(tc.prices)
+			verifyFiltered(t, tc.prices, kept, rejected, tc.expectedRejected)
+		})
+	}
+}
+
+// verifyFiltered verifies that exactly the expected providers were rejected and that the
+// remaining prices were kept.
+func verifyFiltered(
+	t *testing.T,
+	prices map[string]*big.Int,
+	kept map[string]*big.Int,
+	rejected []oracle.RejectedPrice,
+	expectedRejected []string,
+) {
+	t.Helper()
+
+	rejectedProviders := make([]string, 0, len(rejected))
+	for _, r := range rejected {
+		rejectedProviders = append(rejectedProviders, r.Provider)
+		require.Equal(t, prices[r.Provider], r.Price)
+		require.NotEmpty(t, r.Reason)
+	}
+	require.ElementsMatch(t, expectedRejected, rejectedProviders)
+
+	require.Len(t, kept, len(prices)-len(expectedRejected))
+	for provider, price := range kept {
+		require.Equal(t, prices[provider], price)
+	}
+}
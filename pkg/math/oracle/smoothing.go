@@ -0,0 +1,215 @@
+package oracle
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// MaxSmoothingSamples is the maximum number of aggregated prices that are kept per currency pair
+// for the twap smoothing. If the window holds more prices, the oldest prices are discarded.
+const MaxSmoothingSamples = 1024
+
+// PriceSmoother smooths the aggregated price of each currency pair using the smoothing configured
+// for the currency pair in the market config i.e. a time-weighted or exponential moving average.
+// Currency pairs without smoothing are reported as is. The smoother keeps a bounded in-memory
+// window of the aggregated prices of each smoothed currency pair.
+type PriceSmoother struct {
+	mtx    sync.Mutex
+	logger *zap.Logger
+	cfg    config.AggregateMarketConfig
+
+	// now returns the current time. This is used to timestamp the aggregated prices.
+	now func() time.Time
+
+	// states is the smoothing state of each smoothed currency pair.
+	states map[oracletypes.CurrencyPair]*smoothingState
+}
+
+// priceSample is an aggregated price along with the time it was recorded.
+type priceSample struct {
+	price     *big.Int
+	timestamp time.Time
+}
+
+// smoothingState is the smoothing state of a single currency pair.
+type smoothingState struct {
+	// cfg is the smoothing config the state was created with. The state is reset if the
+	// config changes.
+	cfg config.SmoothingConfig
+
+	// samples is the window of aggregated prices used by the twap smoothing, ordered by time.
+	samples []priceSample
+
+	// ema is the current exponential moving average used by the ema smoothing.
+	ema *big.Float
+
+	// lastUpdate is the time of the latest aggregated price.
+	lastUpdate time.Time
+}
+
+// NewPriceSmoother returns a new price smoother for the given market config. The now function is
+// used to timestamp the aggregated prices; time.Now is used in production, but tests may drive the
+// smoother with a fake clock.
+func NewPriceSmoother(
+	logger *zap.Logger,
+	cfg config.AggregateMarketConfig,
+	now func() time.Time,
+) (*PriceSmoother, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if now == nil {
+		return nil, fmt.Errorf("now function cannot be nil")
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return &PriceSmoother{
+		logger: logger,
+		cfg:    cfg,
+		now:    now,
+		states: make(map[oracletypes.CurrencyPair]*smoothingState),
+	}, nil
+}
+
+// UpdateMarketConfig validates and atomically swaps the market config used by the smoother. The
+// smoothing state of any currency pair whose smoothing config changed is discarded.
+func (s *PriceSmoother) UpdateMarketConfig(cfg config.AggregateMarketConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.cfg = cfg
+	for cp, state := range s.states {
+		if cfg.GetSmoothing(cp) != state.cfg {
+			delete(s.states, cp)
+		}
+	}
+
+	return nil
+}
+
+// Smooth records the given aggregated prices at the current time and returns the smoothed price
+// of each currency pair. Currency pairs without smoothing are returned as is. The given prices are
+// not modified.
+func (s *PriceSmoother) Smooth(prices map[oracletypes.CurrencyPair]*big.Int) map[oracletypes.CurrencyPair]*big.Int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.now()
+	smoothed := make(map[oracletypes.CurrencyPair]*big.Int, len(prices))
+	for cp, price := range prices {
+		cfg := s.cfg.GetSmoothing(cp)
+		if !cfg.IsEnabled() || price == nil {
+			smoothed[cp] = price
+			continue
+		}
+
+		state, ok := s.states[cp]
+		if !ok || state.cfg != cfg {
+			state = &smoothingState{cfg: cfg}
+			s.states[cp] = state
+		}
+
+		switch cfg.Type {
+		case config.SmoothingTWAP:
+			smoothed[cp] = state.twap(now, price)
+		case config.SmoothingEMA:
+			smoothed[cp] = state.exponentialAverage(now, price)
+		}
+
+		s.logger.Debug(
+			"smoothed aggregated price",
+			zap.String("currency_pair", cp.String()),
+			zap.String("smoothing", cfg.Type),
+			zap.String("raw_price", price.String()),
+			zap.String("smoothed_price", smoothed[cp].String()),
+		)
+	}
+
+	return smoothed
+}
+
+// twap records the price and returns the time-weighted average price over the window. Each price
+// is weighted by the time elapsed since the previous price, such that the latest price is always
+// included. If no time has elapsed within the window, the latest price is returned.
+func (s *smoothingState) twap(now time.Time, price *big.Int) *big.Int {
+	s.samples = append(s.samples, priceSample{price: price, timestamp: now})
+	s.lastUpdate = now
+
+	// Discard the prices that are no longer needed. The newest price at or before the start of
+	// the window is kept since it marks the start of the interval of the first price in the window.
+	start := now.Add(-s.cfg.Window)
+	drop := 0
+	for drop < len(s.samples)-1 && !s.samples[drop+1].timestamp.After(start) {
+		drop++
+	}
+	if excess := len(s.samples) - MaxSmoothingSamples; excess > drop {
+		drop = excess
+	}
+	s.samples = s.samples[drop:]
+
+	sum := new(big.Int)
+	total := new(big.Int)
+	for i := 1; i < len(s.samples); i++ {
+		from := s.samples[i-1].timestamp
+		if from.Before(start) {
+			from = start
+		}
+
+		elapsed := s.samples[i].timestamp.Sub(from)
+		if elapsed <= 0 {
+			continue
+		}
+
+		weight := big.NewInt(int64(elapsed))
+		sum.Add(sum, new(big.Int).Mul(s.samples[i].price, weight))
+		total.Add(total, weight)
+	}
+
+	if total.Sign() == 0 {
+		return price
+	}
+
+	return sum.Quo(sum, total)
+}
+
+// exponentialAverage records the price and returns the exponential moving average. The weight
+// of the new price is 1 - e^(-elapsed/window), where elapsed is the time since the previous price.
+func (s *smoothingState) exponentialAverage(now time.Time, price *big.Int) *big.Int {
+	if s.ema == nil {
+		s.ema = new(big.Float).SetInt(price)
+		s.lastUpdate = now
+
+		return price
+	}
+
+	elapsed := now.Sub(s.lastUpdate)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	s.lastUpdate = now
+
+	alpha := big.NewFloat(1 - math.Exp(-elapsed.Seconds()/s.cfg.Window.Seconds()))
+
+	// ema = ema + alpha * (price - ema)
+	delta := new(big.Float).Sub(new(big.Float).SetInt(price), s.ema)
+	s.ema.Add(s.ema, delta.Mul(delta, alpha))
+
+	result, _ := s.ema.Int(nil)
+	return result
+}
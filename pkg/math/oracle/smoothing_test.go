@@ -0,0 +1,181 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/pkg/math/oracle"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// fakeClock is a clock that only moves when it is advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func smoothingConfig(cp oracletypes.CurrencyPair, smoothing config.SmoothingConfig) config.AggregateMarketConfig {
+	return config.AggregateMarketConfig{
+		Feeds: map[string]config.FeedConfig{
+			cp.String(): {
+				CurrencyPair: cp,
+			},
+		},
+		AggregatedFeeds: map[string]config.AggregateFeedConfig{
+			cp.String(): {
+				CurrencyPair: cp,
+				Conversions: []config.Conversions{
+					{
+						{CurrencyPair: cp},
+					},
+				},
+				Smoothing: smoothing,
+			},
+		},
+	}
+}
+
+func TestPriceSmoother(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	ethUSD := oracletypes.NewCurrencyPair("ETHEREUM", "USD")
+
+	type update struct {
+		advance  time.Duration
+		price    int64
+		expected int64
+	}
+
+	testCases := []struct {
+		name      string
+		smoothing config.SmoothingConfig
+		updates   []update
+	}{
+		{
+			name:      "no smoothing",
+			smoothing: config.SmoothingConfig{},
+			updates: []update{
+				{0, 100, 100},
+				{time.Second, 200, 200},
+				{time.Second, 50, 50},
+			},
+		},
+		{
+			name: "twap",
+			smoothing: config.SmoothingConfig{
+				Type:   config.SmoothingTWAP,
+				Window: 10 * time.Second,
+			},
+			updates: []update{
+				// A single price is reported as is.
+				{0, 100, 100},
+				// Each price is weighted by the time elapsed since the previous price.
+				{time.Second, 200, 200},
+				{time.Second, 100, 150},
+				{2 * time.Second, 400, 275},
+				// Prices that fall outside of the window are discarded.
+				{8 * time.Second, 300, 320},
+				{10 * time.Second, 100, 100},
+				// Prices recorded at the same time carry no weight.
+				{0, 500, 100},
+			},
+		},
+		{
+			name: "ema",
+			smoothing: config.SmoothingConfig{
+				Type:   config.SmoothingEMA,
+				Window: 10 * time.Second,
+			},
+			updates: []update{
+				// The first price seeds the average.
+				{0, 1_000_000, 1_000_000},
+				// After one window, the new price carries a weight of 1 - 1/e.
+				{10 * time.Second, 2_000_000, 1_632_120},
+				// Prices recorded at the same time carry no weight.
+				{0, 0, 1_632_120},
+				// After a long gap, the new price carries nearly all of the weight.
+				{time.Hour, 3_000_000, 3_000_000},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			smoother, err := oracle.NewPriceSmoother(logger, smoothingConfig(btcUSD, tc.smoothing), clock.Now)
+			require.NoError(t, err)
+
+			for i, u := range tc.updates {
+				clock.Advance(u.advance)
+
+				// Currency pairs without smoothing are always reported as is.
+				smoothed := smoother.Smooth(map[oracletypes.CurrencyPair]*big.Int{
+					btcUSD: big.NewInt(u.price),
+					ethUSD: big.NewInt(u.price),
+				})
+				require.Equal(t, big.NewInt(u.expected), smoothed[btcUSD], "update %d", i)
+				require.Equal(t, big.NewInt(u.price), smoothed[ethUSD], "update %d", i)
+			}
+		})
+	}
+}
+
+func TestPriceSmootherUpdateMarketConfig(t *testing.T) {
+	btcUSD := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	twap := config.SmoothingConfig{
+		Type:   config.SmoothingTWAP,
+		Window: 10 * time.Second,
+	}
+
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	smoother, err := oracle.NewPriceSmoother(logger, smoothingConfig(btcUSD, twap), clock.Now)
+	require.NoError(t, err)
+
+	smooth := func(price int64) *big.Int {
+		return smoother.Smooth(map[oracletypes.CurrencyPair]*big.Int{
+			btcUSD: big.NewInt(price),
+		})[btcUSD]
+	}
+
+	smooth(100)
+	clock.Advance(time.Second)
+	smooth(200)
+	clock.Advance(time.Second)
+	require.Equal(t, big.NewInt(150), smooth(100))
+
+	// Updating the market config without changing the smoothing keeps the window.
+	require.NoError(t, smoother.UpdateMarketConfig(smoothingConfig(btcUSD, twap)))
+	clock.Advance(time.Second)
+	require.Equal(t, big.NewInt(200), smooth(300))
+
+	// Changing the smoothing discards the window.
+	twap.Window = 20 * time.Second
+	require.NoError(t, smoother.UpdateMarketConfig(smoothingConfig(btcUSD, twap)))
+	clock.Advance(time.Second)
+	require.Equal(t, big.NewInt(400), smooth(400))
+
+	// Invalid configs are rejected.
+	twap.Window = 0
+	require.Error(t, smoother.UpdateMarketConfig(smoothingConfig(btcUSD, twap)))
+}
+
+func TestNewPriceSmoother(t *testing.T) {
+	_, err := oracle.NewPriceSmoother(nil, cfg, time.Now)
+	require.Error(t, err)
+
+	_, err = oracle.NewPriceSmoother(logger, cfg, nil)
+	require.Error(t, err)
+
+	_, err = oracle.NewPriceSmoother(logger, cfg, time.Now)
+	require.NoError(t, err)
+}
@@ -41,6 +41,10 @@ func NewAPIHandler(
 		return nil, fmt.Errorf("expected provider config name %s, got %s", Name, cfg.Name)
 	}
 
+	if cfg.API.BatchSize > MaxBatchSize {
+		return nil, fmt.Errorf("batch size %d exceeds the maximum batch size %d", cfg.API.BatchSize, MaxBatchSize)
+	}
+
 	return &APIHandler{
 		cfg: cfg,
 	}, nil
@@ -105,7 +109,7 @@ func (h *APIHandler) ParseResponse(
 		}
 
 		cp := market.CurrencyPair
-		price, err := math.Float64StringToBigInt(data.Price, cp.Decimals())
+		price, err := math.Float64StringToBigInt(data.Price, market.GetDecimals())
 		if err != nil {
 			return providertypes.NewGetResponseWithErr[oracletypes.CurrencyPair, *big.Int](cps, err)
 		}
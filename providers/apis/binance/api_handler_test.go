@@ -34,6 +34,11 @@ var (
 					Ticker:       "BNBUSDT",
 					CurrencyPair: oracletypes.NewCurrencyPair("BINANCE", "USDT"),
 				},
+				"PEPE/USDT": {
+					Ticker:       "PEPEUSDT",
+					CurrencyPair: oracletypes.NewCurrencyPair("PEPE", "USDT"),
+					Decimals:     18,
+				},
 			},
 		},
 	}
@@ -57,6 +62,24 @@ var (
 	}
 )
 
+func TestNewAPIHandler(t *testing.T) {
+	t.Run("batch size within the maximum", func(t *testing.T) {
+		cfg := providerCfg
+		cfg.API.BatchSize = binance.MaxBatchSize
+
+		_, err := binance.NewAPIHandler(cfg)
+		require.NoError(t, err)
+	})
+
+	t.Run("batch size exceeds the maximum", func(t *testing.T) {
+		cfg := providerCfg
+		cfg.API.BatchSize = binance.MaxBatchSize + 1
+
+		_, err := binance.NewAPIHandler(cfg)
+		require.Error(t, err)
+	})
+}
+
 func TestCreateURL(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -216,6 +239,21 @@ func TestParseResponse(t *testing.T) {
 				map[oracletypes.CurrencyPair]error{},
 			),
 		},
+		{
+			name: "valid single with configured decimals",
+			cps:  []oracletypes.CurrencyPair{oracletypes.NewCurrencyPair("PEPE", "USDT")},
+			response: testutils.CreateResponseFromJSON(
+				`[{"symbol":"PEPEUSDT","price":"0.00000123"}]`,
+			),
+			expected: providertypes.NewGetResponse(
+				map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+					oracletypes.NewCurrencyPair("PEPE", "USDT"): {
+						Value: big.NewInt(1230000000000),
+					},
+				},
+				map[oracletypes.CurrencyPair]error{},
+			),
+		},
 		{
 			name: "unknown base",
 			cps: []oracletypes.CurrencyPair{
@@ -28,6 +28,11 @@ const (
 	// the Non-US URL supports.
 	US_URL = "https://api.binance.us/api/v3/ticker/price?symbols=%s%s%s" //nolint
 
+	// MaxBatchSize is the maximum number of currency pairs that are fetched in a single
+	// request. The symbols are sent in the URL, which is kept within common URL length
+	// limits.
+	MaxBatchSize = 100
+
 	Quotation    = "%22"
 	Separator    = ","
 	LeftBracket  = "%5B"
@@ -39,6 +44,7 @@ var (
 	DefaultUSAPIConfig = config.APIConfig{
 		Name:       Name,
 		Atomic:     true,
+		BatchSize:  MaxBatchSize,
 		Enabled:    true,
 		Timeout:    500 * time.Millisecond,
 		Interval:   1 * time.Second,
@@ -50,6 +56,7 @@ var (
 	DefaultNonUSAPIConfig = config.APIConfig{
 		Name:       Name,
 		Atomic:     true,
+		BatchSize:  MaxBatchSize,
 		Enabled:    true,
 		Timeout:    500 * time.Millisecond,
 		Interval:   1 * time.Second,
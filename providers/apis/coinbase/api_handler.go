@@ -82,7 +82,7 @@ func (h *APIHandler) ParseResponse(
 
 	// Check if this currency pair is supported by the Coinbase API.
 	cp := cps[0]
-	_, ok := h.cfg.Market.CurrencyPairToMarketConfigs[cp.String()]
+	market, ok := h.cfg.Market.CurrencyPairToMarketConfigs[cp.String()]
 	if !ok {
 		return providertypes.NewGetResponseWithErr[oracletypes.CurrencyPair, *big.Int](
 			cps,
@@ -97,7 +97,7 @@ func (h *APIHandler) ParseResponse(
 	}
 
 	// Convert the float64 price into a big.Int.
-	price, err := math.Float64StringToBigInt(result.Data.Amount, cp.Decimals())
+	price, err := math.Float64StringToBigInt(result.Data.Amount, market.GetDecimals())
 	if err != nil {
 		return providertypes.NewGetResponseWithErr[oracletypes.CurrencyPair, *big.Int](cps, err)
 	}
@@ -40,6 +40,10 @@ func NewAPIHandler(
 		return nil, fmt.Errorf("expected provider config name %s, got %s", Name, cfg.Name)
 	}
 
+	if cfg.API.BatchSize > MaxBatchSize {
+		return nil, fmt.Errorf("batch size %d exceeds the maximum batch size %d", cfg.API.BatchSize, MaxBatchSize)
+	}
+
 	return &APIHandler{
 		cfg: cfg,
 	}, nil
@@ -111,7 +115,7 @@ func (h *APIHandler) ParseResponse(
 
 			// Resolve the price.
 			cp := market.CurrencyPair
-			price := math.Float64ToBigInt(price, cp.Decimals())
+			price := math.Float64ToBigInt(price, market.GetDecimals())
 			resolved[cp] = providertypes.NewResult[*big.Int](price, time.Now())
 			delete(configCPs.CurrencyPairToMarketConfigs, cp.String())
 		}
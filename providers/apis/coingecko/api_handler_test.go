@@ -38,6 +38,24 @@ var providerCfg = config.ProviderConfig{
 	},
 }
 
+func TestNewAPIHandler(t *testing.T) {
+	t.Run("batch size within the maximum", func(t *testing.T) {
+		cfg := providerCfg
+		cfg.API.BatchSize = coingecko.MaxBatchSize
+
+		_, err := coingecko.NewAPIHandler(cfg)
+		require.NoError(t, err)
+	})
+
+	t.Run("batch size exceeds the maximum", func(t *testing.T) {
+		cfg := providerCfg
+		cfg.API.BatchSize = coingecko.MaxBatchSize + 1
+
+		_, err := coingecko.NewAPIHandler(cfg)
+		require.Error(t, err)
+	})
+}
+
 func TestCreateURL(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -36,6 +36,11 @@ const (
 	// of a currency pair. The first currency is the base currency and the second
 	// currency is the quote currency.
 	TickerSeparator = "/"
+
+	// MaxBatchSize is the maximum number of currency pairs that are fetched in a single
+	// request. The base and quote currencies are sent in the URL, which is kept within
+	// common URL length limits.
+	MaxBatchSize = 50
 )
 
 var (
@@ -43,6 +48,7 @@ var (
 	DefaultAPIConfig = config.APIConfig{
 		Name:       Name,
 		Atomic:     true,
+		BatchSize:  MaxBatchSize,
 		Enabled:    true,
 		Timeout:    500 * time.Millisecond,
 		Interval:   15 * time.Second, // Coingecko has a very low rate limit.
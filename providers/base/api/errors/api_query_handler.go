@@ -53,3 +53,10 @@ func ErrParseResponseWithErr(err error) error {
 func ErrUnexpectedStatusCodeWithCode(code int) error {
 	return fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, code)
 }
+
+// ErrRateLimitWithErr is used to create a new ErrRateLimit with the given error. This is
+// returned when a request is not sent because the provider must wait for its request budget
+// or for the API to accept requests again.
+func ErrRateLimitWithErr(err error) error {
+	return errors.Join(ErrRateLimit, err)
+}
@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/providers/base/api/errors"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/base/endpoints"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
@@ -35,8 +39,9 @@ type APIQueryHandler[K providertypes.ResponseKey, V providertypes.ResponseValue]
 // This is used to query using http requests. It manages querying the data provider
 // by using the APIDataHandler and RequestHandler. All responses are sent to the
 // response channel. In the case where the APIQueryHandler is atomic, the handler
-// will make a single request for all IDs. If the APIQueryHandler is not
-// atomic, the handler will make a request for each ID in a separate go routine.
+// will make a single request for all IDs, or a request for each batch of IDs if a batch
+// size is configured. If the APIQueryHandler is not atomic, the handler will make a
+// request for each ID in a separate go routine.
 type APIQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
 	logger  *zap.Logger
 	metrics metrics.APIMetrics
@@ -49,6 +54,23 @@ type APIQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseVa
 	// The API data handler is responsible for creating the URL to be sent to the
 	// request handler and parsing the response from the request handler.
 	apiHandler APIDataHandler[K, V]
+
+	// The limiter enforces the request budget of the provider across all of its
+	// requests. This is nil if the requests of the provider are not limited.
+	limiter *rate.Limiter
+
+	// mtx guards pausedUntil.
+	mtx sync.Mutex
+
+	// pausedUntil is the time until which the API asked the provider to stop making
+	// requests, as indicated by the Retry-After and X-RateLimit-* headers.
+	pausedUntil time.Time
+
+	// failover tracks which of the URL and the fallback URLs of the provider is used.
+	failover *endpoints.Failover
+
+	// activeEndpoint is the index of the endpoint that was last reported as active.
+	activeEndpoint atomic.Int64
 }
 
 // NewAPIQueryHandler creates a new APIQueryHandler. It manages querying the data
@@ -84,13 +106,23 @@ func NewAPIQueryHandler[K providertypes.ResponseKey, V providertypes.ResponseVal
 		return nil, fmt.Errorf("no metrics specified for api query handler")
 	}
 
-	return &APIQueryHandlerImpl[K, V]{
+	var limiter *rate.Limiter
+	if cfg.RateLimit.RequestsPerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit.RequestsPerSecond), cfg.RateLimit.GetBurst())
+	}
+
+	h := &APIQueryHandlerImpl[K, V]{
 		logger:         logger.With(zap.String("api_data_handler", cfg.Name)),
 		config:         cfg,
 		requestHandler: requestHandler,
 		apiHandler:     apiHandler,
 		metrics:        metrics,
-	}, nil
+		limiter:        limiter,
+		failover:       endpoints.NewFailover(len(cfg.GetEndpoints()), cfg.GetFailbackInterval()),
+	}
+	h.reportActiveEndpoint(0, true)
+
+	return h, nil
 }
 
 // Query is used to query the API data provider for the given IDs. This method blocks
@@ -125,16 +157,11 @@ func (h *APIQueryHandlerImpl[K, V]) Query(
 	wg.SetLimit(cap(responseCh))
 	h.logger.Debug("setting concurrency limit", zap.Int("limit", cap(responseCh)))
 
-	// If our task is atomic, we can make a single request for all the IDs. Otherwise,
-	// we need to make a request for each ID.
+	// Make a single request for each batch of IDs. Failures of a request are only
+	// reported for the IDs in its batch.
 	var tasks []func() error
-	if h.config.Atomic {
-		tasks = append(tasks, h.subTask(ctx, ids, responseCh))
-	} else {
-		for i := 0; i < len(ids); i++ {
-			id := ids[i]
-			tasks = append(tasks, h.subTask(ctx, []K{id}, responseCh))
-		}
+	for _, batch := range h.batchIDs(ids) {
+		tasks = append(tasks, h.subTask(ctx, batch, responseCh))
 	}
 
 	// Block each task until the wait group has capacity to accept a new response.
@@ -148,6 +175,27 @@ func (h *APIQueryHandlerImpl[K, V]) Query(
 	}
 }
 
+// batchIDs splits the IDs into the batches that are each fulfilled with a single request.
+// If the handler is atomic, the IDs are split into batches of at most the configured batch
+// size, or a single batch if no batch size is configured. Otherwise, each ID is its own batch.
+func (h *APIQueryHandlerImpl[K, V]) batchIDs(ids []K) [][]K {
+	batchSize := 1
+	if h.config.Atomic {
+		batchSize = h.config.BatchSize
+		if batchSize == 0 {
+			batchSize = len(ids)
+		}
+	}
+
+	batches := make([][]K, 0, (len(ids)+batchSize-1)/batchSize)
+	for start := 0; start < len(ids); start += batchSize {
+		end := min(start+batchSize, len(ids))
+		batches = append(batches, ids[start:end])
+	}
+
+	return batches
+}
+
 // subTask is the subtask that is used to query the data provider for the given IDs,
 // parse the response, and write the response to the response channel.
 func (h *APIQueryHandlerImpl[K, V]) subTask(
@@ -176,10 +224,11 @@ func (h *APIQueryHandlerImpl[K, V]) subTask(
 
 		h.logger.Debug("created url", zap.String("url", url))
 
-		// Make the request.
-		resp, err := h.requestHandler.Do(ctx, url)
+		// Make the request, retrying it if the API responds with a retryable status code and
+		// failing over to the fallback URLs if the URL is unhealthy.
+		resp, err := h.doRequestWithFailover(ctx, url)
 		if err != nil {
-			h.writeResponse(responseCh, providertypes.NewGetResponseWithErr[K, V](ids, errors.ErrDoRequestWithErr(err)))
+			h.writeResponse(responseCh, providertypes.NewGetResponseWithErr[K, V](ids, err))
 			return nil
 		}
 
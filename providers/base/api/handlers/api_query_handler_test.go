@@ -627,6 +627,73 @@ func TestAPIQueryHandler(t *testing.T) {
 	}
 }
 
+func TestAPIQueryHandlerBatches(t *testing.T) {
+	batchURL1 := constantURL + "/batch1"
+	batchURL2 := constantURL + "/batch2"
+
+	requestHandler := mocks.NewRequestHandler(t)
+	requestHandler.On("Do", mock.Anything, batchURL1).Return(newUnexpectedStatusCodeResponse(), nil).Times(1)
+	requestHandler.On("Do", mock.Anything, batchURL2).Return(newValidResponse(), nil).Times(1)
+
+	apiHandler := mocks.NewAPIDataHandler[oracletypes.CurrencyPair, *big.Int](t)
+	apiHandler.On("CreateURL", []oracletypes.CurrencyPair{btcusd, ethusd}).Return(batchURL1, nil).Times(1)
+	apiHandler.On("CreateURL", []oracletypes.CurrencyPair{atomusd}).Return(batchURL2, nil).Times(1)
+	apiHandler.On("ParseResponse", []oracletypes.CurrencyPair{atomusd}, newValidResponse()).Return(
+		providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](
+			map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+				atomusd: {
+					Value: big.NewInt(300),
+				},
+			},
+			nil,
+		),
+	).Times(1)
+
+	m := mockmetrics.NewAPIMetrics(t)
+	m.On("ObserveProviderResponseLatency", "handler1", mock.Anything).Times(1)
+	m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(btcusd)), metrics.UnexpectedStatusCode).Times(1)
+	m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(ethusd)), metrics.UnexpectedStatusCode).Times(1)
+	m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(atomusd)), metrics.Success).Times(1)
+
+	batchCfg := cfg
+	batchCfg.BatchSize = 2
+
+	handler, err := handlers.NewAPIQueryHandler[oracletypes.CurrencyPair, *big.Int](
+		logger,
+		batchCfg,
+		requestHandler,
+		apiHandler,
+		m,
+	)
+	require.NoError(t, err)
+
+	responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], 2)
+	go func() {
+		handler.Query(context.Background(), []oracletypes.CurrencyPair{btcusd, ethusd, atomusd}, responseCh)
+		close(responseCh)
+	}()
+
+	// The failed batch is only reported for its own ids.
+	var responses []providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int]
+	for resp := range responseCh {
+		responses = append(responses, resp)
+	}
+	require.Len(t, responses, 2)
+
+	for _, resp := range responses {
+		if _, ok := resp.Resolved[atomusd]; ok {
+			require.Equal(t, big.NewInt(300), resp.Resolved[atomusd].Value)
+			require.Empty(t, resp.UnResolved)
+			continue
+		}
+
+		require.Empty(t, resp.Resolved)
+		require.Len(t, resp.UnResolved, 2)
+		require.Equal(t, errors.ErrUnexpectedStatusCodeWithCode(http.StatusInternalServerError), resp.UnResolved[btcusd])
+		require.Equal(t, errors.ErrUnexpectedStatusCodeWithCode(http.StatusInternalServerError), resp.UnResolved[ethusd])
+	}
+}
+
 func newRateLimitResponse() *http.Response {
 	return &http.Response{
 		StatusCode: http.StatusTooManyRequests,
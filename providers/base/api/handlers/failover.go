@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	providererrors "github.com/skip-mev/slinky/providers/base/api/errors"
+	"github.com/skip-mev/slinky/providers/base/endpoints"
+)
+
+// doRequestWithFailover makes the request to the active endpoint of the provider. The given url
+// is created from the URL of the provider, and is rewritten to use the active endpoint. If the
+// endpoint is unhealthy, the provider fails over to the next endpoint and the request is made
+// again, until a healthy endpoint responds, every endpoint has been tried, or the context
+// expires.
+func (h *APIQueryHandlerImpl[K, V]) doRequestWithFailover(ctx context.Context, url string) (*http.Response, error) {
+	urls := h.config.GetEndpoints()
+	endpoint := h.failover.Active()
+	h.reportActiveEndpoint(endpoint, false)
+
+	for tried := 1; ; tried++ {
+		resp, err := h.doRequest(ctx, h.config.EndpointURL(url, urls[endpoint]))
+		if !isEndpointFailure(resp, err) {
+			return resp, err
+		}
+
+		next := h.failover.ReportFailure(endpoint)
+		h.reportActiveEndpoint(next, false)
+		if next != endpoint {
+			h.logger.Warn(
+				"endpoint is unhealthy; failing over",
+				zap.String("endpoint", endpoints.Label(urls[endpoint])),
+				zap.String("next_endpoint", endpoints.Label(urls[next])),
+			)
+		}
+
+		if tried >= len(urls) || next == endpoint || ctx.Err() != nil {
+			return resp, err
+		}
+
+		// Release the connection of the response from the unhealthy endpoint.
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		endpoint = next
+	}
+}
+
+// reportActiveEndpoint updates the active endpoint metric if the active endpoint changed. The
+// metric is only reported for providers with fallback URLs.
+func (h *APIQueryHandlerImpl[K, V]) reportActiveEndpoint(endpoint int, force bool) {
+	if h.failover.Size() < 2 {
+		return
+	}
+
+	if previous := h.activeEndpoint.Swap(int64(endpoint)); previous != int64(endpoint) || force {
+		h.metrics.SetActiveEndpoint(h.config.Name, endpoints.Label(h.config.GetEndpoints()[endpoint]))
+	}
+}
+
+// isEndpointFailure returns true if the request failed because the endpoint is unhealthy, i.e.
+// it could not be reached, it is geo-blocked, or it responded with a server error. Requests
+// that were not sent due to rate limits, or that were cancelled, are not endpoint failures.
+func isEndpointFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, providererrors.ErrDoRequest) && !errors.Is(err, context.Canceled)
+	}
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return true
+	case resp.StatusCode == http.StatusForbidden, resp.StatusCode == http.StatusUnavailableForLegalReasons:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,167 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/api/errors"
+	mockmetrics "github.com/skip-mev/slinky/providers/base/api/metrics/mocks"
+)
+
+func TestAPIQueryHandlerFailover(t *testing.T) {
+	t.Run("fails over to the fallback url if the url responds with a server error", func(t *testing.T) {
+		primary, primaryRequests := newFailoverTestServer(http.StatusServiceUnavailable)
+		defer primary.Close()
+		fallback, fallbackRequests := newFailoverTestServer(http.StatusOK)
+		defer fallback.Close()
+
+		apiCfg := failoverTestConfig(primary.URL, fallback.URL)
+		m := newFailoverTestMetrics(t, primary, fallback)
+		handler := newRateLimitTestHandler(t, apiCfg, primary, m)
+
+		resp := queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Len(t, resp.Resolved, 1)
+		require.Equal(t, int32(1), primaryRequests.Load())
+		require.Equal(t, int32(1), fallbackRequests.Load())
+
+		// Subsequent requests are made to the fallback url directly.
+		resp = queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Len(t, resp.Resolved, 1)
+		require.Equal(t, int32(1), primaryRequests.Load())
+		require.Equal(t, int32(2), fallbackRequests.Load())
+	})
+
+	t.Run("fails over to the fallback url if the url is unreachable", func(t *testing.T) {
+		primary, _ := newFailoverTestServer(http.StatusOK)
+		fallback, fallbackRequests := newFailoverTestServer(http.StatusOK)
+		defer fallback.Close()
+
+		apiCfg := failoverTestConfig(primary.URL, fallback.URL)
+		m := newFailoverTestMetrics(t, primary, fallback)
+		handler := newRateLimitTestHandler(t, apiCfg, primary, m)
+		primary.Close()
+
+		resp := queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Len(t, resp.Resolved, 1)
+		require.Equal(t, int32(1), fallbackRequests.Load())
+	})
+
+	t.Run("does not fail over if the url is rate limited", func(t *testing.T) {
+		primary, primaryRequests := newFailoverTestServer(http.StatusTooManyRequests)
+		defer primary.Close()
+		fallback, fallbackRequests := newFailoverTestServer(http.StatusOK)
+		defer fallback.Close()
+
+		apiCfg := failoverTestConfig(primary.URL, fallback.URL)
+		m := newFailoverTestMetrics(t, primary, fallback)
+		handler := newRateLimitTestHandler(t, apiCfg, primary, m)
+
+		resp := queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Len(t, resp.UnResolved, 1)
+		require.ErrorIs(t, resp.UnResolved[btcusd], errors.ErrRateLimit)
+		require.Equal(t, int32(1), primaryRequests.Load())
+		require.Equal(t, int32(0), fallbackRequests.Load())
+	})
+
+	t.Run("returns the last failure if every endpoint is unhealthy", func(t *testing.T) {
+		primary, primaryRequests := newFailoverTestServer(http.StatusBadGateway)
+		defer primary.Close()
+		fallback, fallbackRequests := newFailoverTestServer(http.StatusServiceUnavailable)
+		defer fallback.Close()
+
+		apiCfg := failoverTestConfig(primary.URL, fallback.URL)
+		m := newFailoverTestMetrics(t, primary, fallback)
+		handler := newRateLimitTestHandler(t, apiCfg, primary, m)
+
+		resp := queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Len(t, resp.UnResolved, 1)
+		require.ErrorIs(t, resp.UnResolved[btcusd], errors.ErrUnexpectedStatusCode)
+		require.Equal(t, int32(1), primaryRequests.Load())
+		require.Equal(t, int32(1), fallbackRequests.Load())
+	})
+
+	t.Run("fails back to the url after the failback interval", func(t *testing.T) {
+		var primaryHealthy atomic.Bool
+		var primaryRequests atomic.Int32
+		primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			primaryRequests.Add(1)
+			if !primaryHealthy.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer primary.Close()
+		fallback, fallbackRequests := newFailoverTestServer(http.StatusOK)
+		defer fallback.Close()
+
+		apiCfg := failoverTestConfig(primary.URL, fallback.URL)
+		apiCfg.FailbackInterval = 50 * time.Millisecond
+		m := newFailoverTestMetrics(t, primary, fallback)
+		handler := newRateLimitTestHandler(t, apiCfg, primary, m)
+
+		resp := queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Len(t, resp.Resolved, 1)
+		require.Equal(t, int32(1), fallbackRequests.Load())
+
+		primaryHealthy.Store(true)
+		time.Sleep(apiCfg.FailbackInterval)
+
+		resp = queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Len(t, resp.Resolved, 1)
+		require.Equal(t, int32(2), primaryRequests.Load())
+		require.Equal(t, int32(1), fallbackRequests.Load())
+	})
+}
+
+// failoverTestConfig returns an atomic API config that queries the given url, and fails over to
+// the given fallback url.
+func failoverTestConfig(url, fallbackURL string) config.APIConfig {
+	apiCfg := rateLimitTestConfig(url)
+	apiCfg.FallbackURLs = []string{fallbackURL}
+
+	return apiCfg
+}
+
+// newFailoverTestServer returns a server that responds with the given status code, and a counter
+// of the requests it received.
+func newFailoverTestServer(status int) (*httptest.Server, *atomic.Int32) {
+	requests := new(atomic.Int32)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(status)
+	}))
+
+	return srv, requests
+}
+
+// newFailoverTestMetrics returns mock metrics that accept any provider response, and expect the
+// active endpoint to be one of the given servers.
+func newFailoverTestMetrics(t *testing.T, servers ...*httptest.Server) *mockmetrics.APIMetrics {
+	t.Helper()
+
+	labels := make([]string, len(servers))
+	for i, srv := range servers {
+		labels[i] = strings.TrimPrefix(srv.URL, "http://")
+	}
+
+	m := newRateLimitTestMetrics(t)
+	m.On("SetActiveEndpoint", cfg.Name, mock.MatchedBy(func(endpoint string) bool {
+		for _, label := range labels {
+			if endpoint == label {
+				return true
+			}
+		}
+		return false
+	})).Maybe()
+
+	return m
+}
@@ -1,5 +1,9 @@
 package handlers
 
+import (
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
 // Option is a function that is used to configure a RequestHandler.
 type Option func(*RequestHandlerImpl)
 
@@ -9,3 +13,12 @@ func WithHTTPMethod(method string) Option {
 		r.method = method
 	}
 }
+
+// WithAPIConfig is an option that is used to add the headers and query parameters of the given API
+// config to each request. The secrets referenced by the headers and query parameters are read when
+// the RequestHandler is created.
+func WithAPIConfig(cfg config.APIConfig) Option {
+	return func(r *RequestHandlerImpl) {
+		r.apiCfg = cfg
+	}
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/providers/base/api/errors"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+const (
+	// RetryAfterHeader is the header that APIs use to indicate how long to wait before making
+	// another request. This is either a number of seconds or an HTTP date.
+	RetryAfterHeader = "Retry-After"
+
+	// RateLimitRemainingHeader is the header that APIs use to indicate the number of requests
+	// remaining in the current rate limit window.
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+
+	// RateLimitResetHeader is the header that APIs use to indicate when the current rate limit
+	// window resets. This is either a number of seconds or a unix timestamp in seconds.
+	RateLimitResetHeader = "X-RateLimit-Reset"
+
+	// unixTimestampThreshold is the value above which a rate limit reset header is treated as a
+	// unix timestamp rather than a number of seconds.
+	unixTimestampThreshold = 1e9
+)
+
+// doRequest makes a request to the given url. The request is delayed until the request budget
+// of the provider allows it, and until the API accepts requests again if it previously asked the
+// provider to back off. Responses with a retryable status code are retried with a capped,
+// jittered exponential backoff, as long as the retry can complete before the context deadline.
+// The last response is returned to the caller, regardless of its status code.
+func (h *APIQueryHandlerImpl[K, V]) doRequest(ctx context.Context, url string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := h.waitForBudget(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := h.requestHandler.Do(ctx, url)
+		if err != nil {
+			return nil, errors.ErrDoRequestWithErr(err)
+		}
+
+		h.updatePause(resp)
+
+		if attempt >= h.config.RateLimit.MaxRetries || !h.config.RateLimit.IsRetryable(resp.StatusCode) {
+			return resp, nil
+		}
+
+		// Wait for at least as long as the API asked us to.
+		delay := h.backoff(attempt)
+		if pause := h.pauseRemaining(); pause > delay {
+			delay = pause
+		}
+
+		if !fitsDeadline(ctx, delay) {
+			h.logger.Debug(
+				"not retrying request; backoff exceeds the deadline",
+				zap.Int("status_code", resp.StatusCode),
+				zap.Duration("backoff", delay),
+			)
+			return resp, nil
+		}
+
+		// Release the connection of the response that is being retried.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		status := metrics.UnexpectedStatusCode
+		if resp.StatusCode == http.StatusTooManyRequests {
+			status = metrics.RateLimit
+		}
+		h.metrics.AddRetriedRequest(h.config.Name, status)
+		h.logger.Debug(
+			"retrying request",
+			zap.Int("status_code", resp.StatusCode),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("backoff", delay),
+		)
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, errors.ErrDoRequestWithErr(err)
+		}
+	}
+}
+
+// waitForBudget blocks until the provider is allowed to make a request. If the provider cannot
+// make a request before the context deadline, an error is returned immediately so that the
+// request is not made.
+func (h *APIQueryHandlerImpl[K, V]) waitForBudget(ctx context.Context) error {
+	now := time.Now()
+	delay := h.pauseRemaining()
+
+	// Reserve a request from the budget once the pause is over, such that concurrent requests
+	// are spread out after the pause rather than all being sent at once.
+	cancel := func() {}
+	if h.limiter != nil {
+		reservation := h.limiter.ReserveN(now.Add(delay), 1)
+		if !reservation.OK() {
+			return errors.ErrRateLimitWithErr(fmt.Errorf("request exceeds the burst of the rate limit"))
+		}
+
+		cancel = reservation.Cancel
+		if reservationDelay := reservation.DelayFrom(now); reservationDelay > delay {
+			delay = reservationDelay
+		}
+	}
+
+	if delay <= 0 {
+		return nil
+	}
+
+	h.metrics.AddThrottledRequest(h.config.Name)
+	if !fitsDeadline(ctx, delay) {
+		cancel()
+		return errors.ErrRateLimitWithErr(fmt.Errorf("request budget is not available for %s", delay))
+	}
+
+	h.logger.Debug("waiting for request budget", zap.Duration("delay", delay))
+	if err := sleep(ctx, delay); err != nil {
+		cancel()
+		return errors.ErrRateLimitWithErr(err)
+	}
+
+	return nil
+}
+
+// updatePause pauses requests to the API if the response indicates that the API will not accept
+// requests for some time, either with a Retry-After header or with an exhausted X-RateLimit-*
+// window.
+func (h *APIQueryHandlerImpl[K, V]) updatePause(resp *http.Response) {
+	now := time.Now()
+
+	var until time.Time
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		until = parseRetryAfter(resp.Header.Get(RetryAfterHeader), now)
+	}
+
+	if strings.TrimSpace(resp.Header.Get(RateLimitRemainingHeader)) == "0" {
+		if reset := parseRateLimitReset(resp.Header.Get(RateLimitResetHeader), now); reset.After(until) {
+			until = reset
+		}
+	}
+
+	if !until.After(now) {
+		return
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if until.After(h.pausedUntil) {
+		h.logger.Debug("pausing requests", zap.Time("until", until))
+		h.pausedUntil = until
+	}
+}
+
+// pauseRemaining returns how long the provider must wait before the API accepts requests again.
+func (h *APIQueryHandlerImpl[K, V]) pauseRemaining() time.Duration {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if remaining := time.Until(h.pausedUntil); remaining > 0 {
+		return remaining
+	}
+
+	return 0
+}
+
+// backoff returns the delay before the given retry attempt. The delay doubles with each attempt
+// up to the maximum backoff, and is jittered such that it is between half and all of that value.
+func (h *APIQueryHandlerImpl[K, V]) backoff(attempt int) time.Duration {
+	maxBackoff := h.config.RateLimit.GetMaxBackoff()
+
+	backoff := h.config.RateLimit.GetInitialBackoff()
+	for i := 0; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec
+}
+
+// parseRetryAfter returns the time at which the API accepts requests again, given the value of a
+// Retry-After header. The zero time is returned if the header is empty or invalid.
+func parseRetryAfter(value string, now time.Time) time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return now.Add(time.Duration(seconds) * time.Second)
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return date
+	}
+
+	return time.Time{}
+}
+
+// parseRateLimitReset returns the time at which the rate limit window of the API resets, given
+// the value of an X-RateLimit-Reset header. The zero time is returned if the header is empty or
+// invalid.
+func parseRateLimitReset(value string, now time.Time) time.Time {
+	reset, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || reset <= 0 {
+		return time.Time{}
+	}
+
+	if reset > unixTimestampThreshold {
+		return time.Unix(0, int64(reset*float64(time.Second)))
+	}
+
+	return now.Add(time.Duration(reset * float64(time.Second)))
+}
+
+// fitsDeadline returns true if the context does not expire within the given delay.
+func fitsDeadline(ctx context.Context, delay time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Until(deadline) > delay
+}
+
+// sleep blocks for the given delay, or until the context is cancelled.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,314 @@
+package handlers_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/api/errors"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	mockmetrics "github.com/skip-mev/slinky/providers/base/api/metrics/mocks"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestAPIQueryHandlerRateLimit(t *testing.T) {
+	t.Run("retries retryable status codes until the request succeeds", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if requests.Add(1) <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		apiCfg := rateLimitTestConfig(srv.URL)
+		apiCfg.RateLimit.MaxRetries = 3
+
+		m := newRateLimitTestMetrics(t)
+		m.On("AddRetriedRequest", apiCfg.Name, metrics.UnexpectedStatusCode).Times(2)
+
+		resp := queryRateLimitTestHandler(t, apiCfg, srv, m, btcusd)
+		require.Contains(t, resp.Resolved, btcusd)
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("returns the last response once the retries are exhausted", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		apiCfg := rateLimitTestConfig(srv.URL)
+		apiCfg.RateLimit.MaxRetries = 2
+
+		m := newRateLimitTestMetrics(t)
+		m.On("AddRetriedRequest", apiCfg.Name, metrics.RateLimit).Times(2)
+
+		resp := queryRateLimitTestHandler(t, apiCfg, srv, m, btcusd)
+		require.ErrorIs(t, resp.UnResolved[btcusd], errors.ErrRateLimit)
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("does not retry status codes that are not retryable", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		apiCfg := rateLimitTestConfig(srv.URL)
+		apiCfg.RateLimit.MaxRetries = 2
+
+		resp := queryRateLimitTestHandler(t, apiCfg, srv, newRateLimitTestMetrics(t), btcusd)
+		require.ErrorIs(t, resp.UnResolved[btcusd], errors.ErrUnexpectedStatusCode)
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("honours retry after across queries", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.Header().Set(handlers.RetryAfterHeader, "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		// The retry after exceeds the timeout of the provider, so the request is not retried.
+		apiCfg := rateLimitTestConfig(srv.URL)
+		apiCfg.RateLimit.MaxRetries = 3
+
+		m := newRateLimitTestMetrics(t)
+		m.On("AddThrottledRequest", apiCfg.Name).Times(1)
+
+		handler := newRateLimitTestHandler(t, apiCfg, srv, m)
+
+		resp := queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.ErrorIs(t, resp.UnResolved[btcusd], errors.ErrRateLimit)
+		require.Equal(t, int32(1), requests.Load())
+
+		// The next query is not sent to the API until the retry after elapses.
+		resp = queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.ErrorIs(t, resp.UnResolved[btcusd], errors.ErrRateLimit)
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("waits for a short retry after before retrying", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if requests.Add(1) == 1 {
+				w.Header().Set(handlers.RetryAfterHeader, "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		apiCfg := rateLimitTestConfig(srv.URL)
+		apiCfg.Interval = 3 * time.Second
+		apiCfg.Timeout = 2 * time.Second
+		apiCfg.RateLimit.MaxRetries = 1
+
+		m := newRateLimitTestMetrics(t)
+		m.On("AddRetriedRequest", apiCfg.Name, metrics.RateLimit).Times(1)
+		m.On("AddThrottledRequest", apiCfg.Name).Maybe()
+
+		start := time.Now()
+		resp := queryRateLimitTestHandler(t, apiCfg, srv, m, btcusd)
+		require.Contains(t, resp.Resolved, btcusd)
+		require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+		require.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("honours an exhausted rate limit window", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.Header().Set(handlers.RateLimitRemainingHeader, "0")
+			w.Header().Set(handlers.RateLimitResetHeader, "60")
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		apiCfg := rateLimitTestConfig(srv.URL)
+
+		m := newRateLimitTestMetrics(t)
+		m.On("AddThrottledRequest", apiCfg.Name).Times(1)
+
+		handler := newRateLimitTestHandler(t, apiCfg, srv, m)
+
+		resp := queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.Contains(t, resp.Resolved, btcusd)
+
+		resp = queryRateLimitTestHandlerWith(handler, apiCfg, btcusd)
+		require.ErrorIs(t, resp.UnResolved[btcusd], errors.ErrRateLimit)
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("limits the requests per second", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		apiCfg := rateLimitTestConfig(srv.URL)
+		apiCfg.Atomic = false
+		apiCfg.RateLimit.RequestsPerSecond = 10
+
+		m := newRateLimitTestMetrics(t)
+		m.On("AddThrottledRequest", apiCfg.Name).Times(2)
+
+		start := time.Now()
+		resp := queryRateLimitTestHandler(t, apiCfg, srv, m, btcusd, ethusd, atomusd)
+		require.Len(t, resp.Resolved, 3)
+		require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("does not send requests that exceed the request budget", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		apiCfg := rateLimitTestConfig(srv.URL)
+		apiCfg.Atomic = false
+		apiCfg.RateLimit.RequestsPerSecond = 1
+
+		m := newRateLimitTestMetrics(t)
+		m.On("AddThrottledRequest", apiCfg.Name).Times(2)
+
+		resp := queryRateLimitTestHandler(t, apiCfg, srv, m, btcusd, ethusd, atomusd)
+		require.Len(t, resp.Resolved, 1)
+		require.Len(t, resp.UnResolved, 2)
+		for _, err := range resp.UnResolved {
+			require.ErrorIs(t, err, errors.ErrRateLimit)
+		}
+		require.Equal(t, int32(1), requests.Load())
+	})
+}
+
+// rateLimitTestConfig returns an atomic API config that queries the given url.
+func rateLimitTestConfig(url string) config.APIConfig {
+	apiCfg := cfg
+	apiCfg.URL = url
+	apiCfg.MaxQueries = 3
+	apiCfg.RateLimit = config.RateLimitConfig{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}
+
+	return apiCfg
+}
+
+// newRateLimitTestMetrics returns mock metrics that accept any provider response.
+func newRateLimitTestMetrics(t *testing.T) *mockmetrics.APIMetrics {
+	t.Helper()
+
+	m := mockmetrics.NewAPIMetrics(t)
+	m.On("AddProviderResponse", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	m.On("ObserveProviderResponseLatency", mock.Anything, mock.Anything).Maybe()
+
+	return m
+}
+
+// newRateLimitTestHandler returns an API query handler that queries the given server and resolves
+// every id for successful responses.
+func newRateLimitTestHandler(
+	t *testing.T,
+	apiCfg config.APIConfig,
+	srv *httptest.Server,
+	m metrics.APIMetrics,
+) handlers.APIQueryHandler[oracletypes.CurrencyPair, *big.Int] {
+	t.Helper()
+
+	requestHandler, err := handlers.NewRequestHandlerImpl(srv.Client())
+	require.NoError(t, err)
+
+	apiHandler := mocks.NewAPIDataHandler[oracletypes.CurrencyPair, *big.Int](t)
+	apiHandler.On("CreateURL", mock.Anything).Return(srv.URL, nil).Maybe()
+	apiHandler.On("ParseResponse", mock.Anything, mock.Anything).Return(
+		func(ids []oracletypes.CurrencyPair, _ *http.Response) providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int] {
+			resolved := make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
+			for _, id := range ids {
+				resolved[id] = providertypes.NewResult(big.NewInt(100), time.Now())
+			}
+
+			return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, nil)
+		},
+	).Maybe()
+
+	handler, err := handlers.NewAPIQueryHandler[oracletypes.CurrencyPair, *big.Int](
+		logger,
+		apiCfg,
+		requestHandler,
+		apiHandler,
+		m,
+	)
+	require.NoError(t, err)
+
+	return handler
+}
+
+// queryRateLimitTestHandler creates a handler that queries the given server, queries it once for
+// the given ids and returns the combined responses.
+func queryRateLimitTestHandler(
+	t *testing.T,
+	apiCfg config.APIConfig,
+	srv *httptest.Server,
+	m metrics.APIMetrics,
+	ids ...oracletypes.CurrencyPair,
+) providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int] {
+	t.Helper()
+
+	return queryRateLimitTestHandlerWith(newRateLimitTestHandler(t, apiCfg, srv, m), apiCfg, ids...)
+}
+
+// queryRateLimitTestHandlerWith queries the handler for the given ids within the timeout of the
+// config, and returns the combined responses.
+func queryRateLimitTestHandlerWith(
+	handler handlers.APIQueryHandler[oracletypes.CurrencyPair, *big.Int],
+	apiCfg config.APIConfig,
+	ids ...oracletypes.CurrencyPair,
+) providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int] {
+	ctx, cancel := context.WithTimeout(context.Background(), apiCfg.Timeout)
+	defer cancel()
+
+	responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], apiCfg.MaxQueries)
+	go func() {
+		handler.Query(ctx, ids, responseCh)
+		close(responseCh)
+	}()
+
+	combined := providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](nil, nil)
+	for resp := range responseCh {
+		for id, result := range resp.Resolved {
+			combined.Resolved[id] = result
+		}
+		for id, err := range resp.UnResolved {
+			combined.UnResolved[id] = err
+		}
+	}
+
+	return combined
+}
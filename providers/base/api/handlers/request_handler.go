@@ -1,9 +1,17 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/skip-mev/slinky/oracle/config"
 )
 
 // RequestHandler is an interface that encapsulates sending an HTTP request to a data provider.
@@ -26,6 +34,19 @@ type RequestHandlerImpl struct {
 
 	// method is the HTTP method to use when sending requests.
 	method string
+
+	// apiCfg is the API config that the headers, query parameters and secrets are read from.
+	apiCfg config.APIConfig
+
+	// headers and queryParameters are the templates of the headers and query parameters
+	// that are added to each request.
+	headers         map[string]*template.Template
+	queryParameters map[string]*template.Template
+
+	// secrets are the secrets referenced by the templates, which are rotated round-robin
+	// across requests. next is the index of the secret used by the next request.
+	secrets []config.Secret
+	next    atomic.Uint64
 }
 
 // NewRequestHandlerImpl creates a new RequestHandlerImpl. It manages making HTTP requests.
@@ -43,21 +64,138 @@ func NewRequestHandlerImpl(client *http.Client, opts ...Option) (RequestHandler,
 		return nil, fmt.Errorf("http request method cannot be empty")
 	}
 
+	var err error
+	if h.headers, err = parseTemplates(h.apiCfg.Headers); err != nil {
+		return nil, err
+	}
+
+	if h.queryParameters, err = parseTemplates(h.apiCfg.QueryParameters); err != nil {
+		return nil, err
+	}
+
+	for _, secretCfg := range h.apiCfg.Secrets {
+		secret, err := secretCfg.Read()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret for %s: %w", h.apiCfg.Name, err)
+		}
+
+		h.secrets = append(h.secrets, secret)
+	}
+
 	return h, nil
 }
 
 // Do is used to send a request with the given URL to the data provider. It first
-// wraps the request with the given context before sending it to the data provider.
+// wraps the request with the given context, and adds the configured headers and
+// query parameters, before sending it to the data provider. Secrets are redacted
+// from any error that is returned.
 func (r *RequestHandlerImpl) Do(ctx context.Context, url string) (*http.Response, error) {
 	req, err := http.NewRequestWithContext(ctx, r.method, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.client.Do(req)
+	secret := r.nextSecret()
+	if err := r.applyTemplates(req, secret); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, redactSecret(err, secret)
+	}
+
+	return resp, nil
 }
 
 // Type returns the HTTP method used to send requests.
 func (r *RequestHandlerImpl) Type() string {
 	return r.method
 }
+
+// nextSecret returns the secret to use for the next request, rotating through the configured
+// secrets round-robin.
+func (r *RequestHandlerImpl) nextSecret() config.Secret {
+	if len(r.secrets) == 0 {
+		return ""
+	}
+
+	return r.secrets[(r.next.Add(1)-1)%uint64(len(r.secrets))]
+}
+
+// applyTemplates adds the configured headers and query parameters to the request, executing the
+// templates with the given secret.
+func (r *RequestHandlerImpl) applyTemplates(req *http.Request, secret config.Secret) error {
+	data := config.APITemplateData{Secret: secret.Value()}
+
+	for name, tmpl := range r.headers {
+		value, err := executeTemplate(tmpl, data)
+		if err != nil {
+			return fmt.Errorf("failed to execute header template %s: %w", name, err)
+		}
+
+		req.Header.Set(name, value)
+	}
+
+	if len(r.queryParameters) == 0 {
+		return nil
+	}
+
+	query := req.URL.Query()
+	for name, tmpl := range r.queryParameters {
+		value, err := executeTemplate(tmpl, data)
+		if err != nil {
+			return fmt.Errorf("failed to execute query parameter template %s: %w", name, err)
+		}
+
+		query.Set(name, value)
+	}
+	req.URL.RawQuery = query.Encode()
+
+	return nil
+}
+
+// parseTemplates parses the given header or query parameter templates.
+func parseTemplates(templates map[string]string) (map[string]*template.Template, error) {
+	parsed := make(map[string]*template.Template, len(templates))
+	for name, text := range templates {
+		tmpl, err := config.NewAPITemplate(name, text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", name, err)
+		}
+
+		parsed[name] = tmpl
+	}
+
+	return parsed, nil
+}
+
+// executeTemplate executes the given template with the given data.
+func executeTemplate(tmpl *template.Template, data config.APITemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// redactSecret redacts the given secret from the URL of the request that the HTTP client includes
+// in its errors. The secret is redacted in both its raw and query encoded forms.
+func redactSecret(err error, secret config.Secret) error {
+	var urlErr *url.Error
+	if secret == "" || !errors.As(err, &urlErr) {
+		return err
+	}
+
+	redacted := urlErr.URL
+	for _, value := range []string{secret.Value(), url.QueryEscape(secret.Value())} {
+		redacted = strings.ReplaceAll(redacted, value, config.RedactedSecret)
+	}
+
+	return &url.Error{
+		Op:  urlErr.Op,
+		URL: redacted,
+		Err: urlErr.Err,
+	}
+}
@@ -0,0 +1,106 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+)
+
+func TestRequestHandlerImpl(t *testing.T) {
+	t.Setenv("SLINKY_TEST_API_KEY_1", "key-1")
+	t.Setenv("SLINKY_TEST_API_KEY_2", "key/2")
+
+	authCfg := cfg
+	authCfg.Headers = map[string]string{
+		"Authorization": "Bearer {{ .Secret }}",
+		"X-Basic":       `Basic {{ printf "user:%s" .Secret | base64 }}`,
+	}
+	authCfg.QueryParameters = map[string]string{
+		"api_key": "{{ .Secret }}",
+	}
+	authCfg.Secrets = []config.SecretConfig{
+		{Env: "SLINKY_TEST_API_KEY_1"},
+		{Env: "SLINKY_TEST_API_KEY_2"},
+	}
+
+	t.Run("adds headers and query parameters and rotates secrets", func(t *testing.T) {
+		var requests []*http.Request
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		h, err := handlers.NewRequestHandlerImpl(srv.Client(), handlers.WithAPIConfig(authCfg))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			resp, err := h.Do(context.Background(), srv.URL+"/prices?ids=bitcoin")
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+
+		require.Len(t, requests, 3)
+		for i, expected := range []string{"key-1", "key/2", "key-1"} {
+			require.Equal(t, "Bearer "+expected, requests[i].Header.Get("Authorization"))
+			require.Equal(t, expected, requests[i].URL.Query().Get("api_key"))
+			require.Equal(t, "bitcoin", requests[i].URL.Query().Get("ids"))
+		}
+
+		require.Equal(t, "Basic dXNlcjprZXktMQ==", requests[0].Header.Get("X-Basic"))
+	})
+
+	t.Run("no templates", func(t *testing.T) {
+		var request *http.Request
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		h, err := handlers.NewRequestHandlerImpl(srv.Client())
+		require.NoError(t, err)
+
+		resp, err := h.Do(context.Background(), srv.URL+"/prices?ids=bitcoin")
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		require.Empty(t, request.Header.Get("Authorization"))
+		require.Equal(t, "ids=bitcoin", request.URL.RawQuery)
+	})
+
+	t.Run("secrets are redacted from errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		url := srv.URL
+		srv.Close()
+
+		h, err := handlers.NewRequestHandlerImpl(http.DefaultClient, handlers.WithAPIConfig(authCfg))
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err = h.Do(context.Background(), url+"/prices")
+			require.Error(t, err)
+			require.NotContains(t, err.Error(), "key-1")
+			require.NotContains(t, err.Error(), "key%2F2")
+			require.Contains(t, err.Error(), config.RedactedSecret)
+		}
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		missingCfg := authCfg
+		missingCfg.Secrets = []config.SecretConfig{
+			{Env: "SLINKY_TEST_UNSET_API_KEY"},
+		}
+
+		_, err := handlers.NewRequestHandlerImpl(http.DefaultClient, handlers.WithAPIConfig(missingCfg))
+		require.Error(t, err)
+	})
+}
@@ -28,6 +28,19 @@ type APIMetrics interface {
 	// within a single interval. Note that if the provider is not atomic, this will be the
 	// time it took for all the requests to complete.
 	ObserveProviderResponseLatency(providerName string, duration time.Duration)
+
+	// AddThrottledRequest increments the number of requests that were delayed, or not sent, by
+	// provider because the request budget of the provider was exhausted or the API asked the
+	// provider to back off.
+	AddThrottledRequest(providerName string)
+
+	// AddRetriedRequest increments the number of retried requests by provider and the status of
+	// the response that was retried.
+	AddRetriedRequest(providerName string, status Status)
+
+	// SetActiveEndpoint sets the endpoint (i.e. URL) that the provider currently sends its
+	// requests to.
+	SetActiveEndpoint(providerName string, endpoint string)
 }
 
 // APIMetricsImpl contains metrics exposed by this package.
@@ -37,6 +50,15 @@ type APIMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	apiResponseTimePerProvider *prometheus.HistogramVec
+
+	// Number of throttled requests per provider.
+	apiThrottledRequestsPerProvider *prometheus.CounterVec
+
+	// Number of retried requests per provider.
+	apiRetriedRequestsPerProvider *prometheus.CounterVec
+
+	// Gauge paginated by provider and endpoint, set to one for the active endpoint.
+	apiActiveEndpointPerProvider *prometheus.GaugeVec
 }
 
 // NewAPIMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -61,11 +83,29 @@ func NewAPIMetrics() APIMetrics {
 			Help:      "Response time per API provider.",
 			Buckets:   []float64{50, 100, 250, 500, 1000},
 		}, []string{providermetrics.ProviderLabel}),
+		apiThrottledRequestsPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_throttled_requests_per_provider",
+			Help:      "Number of API provider requests that were delayed or not sent due to rate limits.",
+		}, []string{providermetrics.ProviderLabel}),
+		apiRetriedRequestsPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_retried_requests_per_provider",
+			Help:      "Number of API provider requests that were retried.",
+		}, []string{providermetrics.ProviderLabel, StatusLabel}),
+		apiActiveEndpointPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_active_endpoint_per_provider",
+			Help:      "The endpoint that an API provider currently sends its requests to.",
+		}, []string{providermetrics.ProviderLabel, providermetrics.EndpointLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.apiResponseStatusPerProvider)
 	prometheus.MustRegister(m.apiResponseTimePerProvider)
+	prometheus.MustRegister(m.apiThrottledRequestsPerProvider)
+	prometheus.MustRegister(m.apiRetriedRequestsPerProvider)
+	prometheus.MustRegister(m.apiActiveEndpointPerProvider)
 
 	return m
 }
@@ -79,6 +119,9 @@ func NewNopAPIMetrics() APIMetrics {
 
 func (m *noOpAPIMetricsImpl) AddProviderResponse(_ string, _ string, _ Status)         {}
 func (m *noOpAPIMetricsImpl) ObserveProviderResponseLatency(_ string, _ time.Duration) {}
+func (m *noOpAPIMetricsImpl) AddThrottledRequest(_ string)                             {}
+func (m *noOpAPIMetricsImpl) AddRetriedRequest(_ string, _ Status)                     {}
+func (m *noOpAPIMetricsImpl) SetActiveEndpoint(_ string, _ string)                     {}
 
 // AddProviderResponse increments the number of requests by provider and status.
 func (m *APIMetricsImpl) AddProviderResponse(providerName string, id string, status Status) {
@@ -97,3 +140,33 @@ func (m *APIMetricsImpl) ObserveProviderResponseLatency(providerName string, dur
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// AddThrottledRequest increments the number of throttled requests by provider.
+func (m *APIMetricsImpl) AddThrottledRequest(providerName string) {
+	m.apiThrottledRequestsPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+	},
+	).Add(1)
+}
+
+// AddRetriedRequest increments the number of retried requests by provider and status.
+func (m *APIMetricsImpl) AddRetriedRequest(providerName string, status Status) {
+	m.apiRetriedRequestsPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+		StatusLabel:                   status.String(),
+	},
+	).Add(1)
+}
+
+// SetActiveEndpoint sets the active endpoint of the provider to one, and removes the previously
+// active endpoint of the provider.
+func (m *APIMetricsImpl) SetActiveEndpoint(providerName string, endpoint string) {
+	m.apiActiveEndpointPerProvider.DeletePartialMatch(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+	})
+	m.apiActiveEndpointPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+		providermetrics.EndpointLabel: endpoint,
+	},
+	).Set(1)
+}
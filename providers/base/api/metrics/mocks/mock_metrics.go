@@ -19,11 +19,26 @@ func (_m *APIMetrics) AddProviderResponse(providerName string, id string, status
 	_m.Called(providerName, id, status)
 }
 
+// AddRetriedRequest provides a mock function with given fields: providerName, status
+func (_m *APIMetrics) AddRetriedRequest(providerName string, status metrics.Status) {
+	_m.Called(providerName, status)
+}
+
+// AddThrottledRequest provides a mock function with given fields: providerName
+func (_m *APIMetrics) AddThrottledRequest(providerName string) {
+	_m.Called(providerName)
+}
+
 // ObserveProviderResponseLatency provides a mock function with given fields: providerName, duration
 func (_m *APIMetrics) ObserveProviderResponseLatency(providerName string, duration time.Duration) {
 	_m.Called(providerName, duration)
 }
 
+// SetActiveEndpoint provides a mock function with given fields: providerName, endpoint
+func (_m *APIMetrics) SetActiveEndpoint(providerName string, endpoint string) {
+	_m.Called(providerName, endpoint)
+}
+
 // NewAPIMetrics creates a new instance of APIMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAPIMetrics(t interface {
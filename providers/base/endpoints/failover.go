@@ -0,0 +1,111 @@
+package endpoints
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Failover tracks which of an ordered list of endpoints a provider should use. The first
+// endpoint is the primary endpoint and is preferred. When the active endpoint fails, the
+// provider fails over to the next endpoint in the list, wrapping around to the primary
+// endpoint after the last one. Once the provider has used a fallback endpoint for the
+// failback interval, it fails back to the primary endpoint. If the primary endpoint is
+// still unhealthy, the provider fails over again.
+//
+// Endpoints are referenced by their index, such that the endpoints themselves can change
+// over time (i.e. a websocket endpoint that is generated before each connection).
+type Failover struct {
+	mtx sync.Mutex
+
+	// size is the number of endpoints.
+	size int
+
+	// failbackInterval is the interval after which the primary endpoint is used again.
+	failbackInterval time.Duration
+
+	// active is the index of the endpoint that is currently used.
+	active int
+
+	// failedOverAt is the time at which the provider last failed over.
+	failedOverAt time.Time
+}
+
+// NewFailover returns a new Failover across the given number of endpoints, which starts with
+// the primary endpoint.
+func NewFailover(size int, failbackInterval time.Duration) *Failover {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Failover{
+		size:             size,
+		failbackInterval: failbackInterval,
+	}
+}
+
+// Active returns the index of the endpoint that should be used. This fails back to the primary
+// endpoint if the failback interval has elapsed since the last failover.
+func (f *Failover) Active() int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.failbackDue() {
+		f.active = 0
+	}
+
+	return f.active
+}
+
+// FailbackDue returns true if a fallback endpoint is used and the failback interval has elapsed
+// since the last failover, such that the primary endpoint should be used again.
+func (f *Failover) FailbackDue() bool {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	return f.failbackDue()
+}
+
+// ReportFailure reports that a request to, or a connection with, the given endpoint failed. If
+// the endpoint is the active endpoint, the provider fails over to the next endpoint. Failures of
+// endpoints that are no longer active are ignored, such that concurrent failures of the same
+// endpoint only fail over once. This returns the index of the endpoint that should be used.
+func (f *Failover) ReportFailure(endpoint int) int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if endpoint == f.active && f.size > 1 {
+		f.active = (f.active + 1) % f.size
+		f.failedOverAt = time.Now()
+	}
+
+	return f.active
+}
+
+// Size returns the number of endpoints.
+func (f *Failover) Size() int {
+	return f.size
+}
+
+// failbackDue returns true if the primary endpoint should be used again. This must be called
+// with the lock held.
+func (f *Failover) failbackDue() bool {
+	return f.active != 0 && time.Since(f.failedOverAt) >= f.failbackInterval
+}
+
+// Label returns the label of the given endpoint that is used in metrics and logs, which is the
+// host of the endpoint. This ensures that any credentials in the path or the query of the
+// endpoint (i.e. a websocket token) are not exposed. Format verbs in the endpoint are ignored.
+func Label(endpoint string) string {
+	if i := strings.Index(endpoint, "%"); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil || len(u.Host) == 0 {
+		return "unknown"
+	}
+
+	return u.Host
+}
@@ -0,0 +1,96 @@
+package endpoints_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/base/endpoints"
+)
+
+func TestFailover(t *testing.T) {
+	t.Run("starts with the primary endpoint", func(t *testing.T) {
+		f := endpoints.NewFailover(3, time.Minute)
+		require.Equal(t, 0, f.Active())
+		require.False(t, f.FailbackDue())
+		require.Equal(t, 3, f.Size())
+	})
+
+	t.Run("fails over in order and wraps around", func(t *testing.T) {
+		f := endpoints.NewFailover(3, time.Minute)
+
+		require.Equal(t, 1, f.ReportFailure(0))
+		require.Equal(t, 1, f.Active())
+		require.Equal(t, 2, f.ReportFailure(1))
+		require.Equal(t, 0, f.ReportFailure(2))
+		require.Equal(t, 0, f.Active())
+	})
+
+	t.Run("ignores failures of endpoints that are not active", func(t *testing.T) {
+		f := endpoints.NewFailover(3, time.Minute)
+
+		require.Equal(t, 1, f.ReportFailure(0))
+		require.Equal(t, 1, f.ReportFailure(0))
+		require.Equal(t, 1, f.Active())
+	})
+
+	t.Run("does not fail over with a single endpoint", func(t *testing.T) {
+		f := endpoints.NewFailover(1, time.Minute)
+
+		require.Equal(t, 0, f.ReportFailure(0))
+		require.False(t, f.FailbackDue())
+	})
+
+	t.Run("fails back to the primary endpoint after the failback interval", func(t *testing.T) {
+		f := endpoints.NewFailover(2, 50*time.Millisecond)
+
+		require.Equal(t, 1, f.ReportFailure(0))
+		require.False(t, f.FailbackDue())
+		require.Equal(t, 1, f.Active())
+
+		time.Sleep(60 * time.Millisecond)
+		require.True(t, f.FailbackDue())
+		require.Equal(t, 0, f.Active())
+		require.False(t, f.FailbackDue())
+
+		// If the primary endpoint is still unhealthy, fail over again.
+		require.Equal(t, 1, f.ReportFailure(0))
+		require.False(t, f.FailbackDue())
+	})
+}
+
+func TestLabel(t *testing.T) {
+	testCases := []struct {
+		name     string
+		endpoint string
+		expected string
+	}{
+		{
+			name:     "url with format verbs",
+			endpoint: "https://api.binance.com/api/v3/ticker/price?symbols=%s%s%s",
+			expected: "api.binance.com",
+		},
+		{
+			name:     "websocket url with a token",
+			endpoint: "wss://ws-api-spot.kucoin.com/?token=secret",
+			expected: "ws-api-spot.kucoin.com",
+		},
+		{
+			name:     "websocket url with a port",
+			endpoint: "wss://stream.binance.com:9443/ws",
+			expected: "stream.binance.com:9443",
+		},
+		{
+			name:     "invalid url",
+			endpoint: "not a url",
+			expected: "unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, endpoints.Label(tc.endpoint))
+		})
+	}
+}
@@ -6,8 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"golang.org/x/sync/errgroup"
-
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/pkg/math"
@@ -16,8 +14,8 @@ import (
 )
 
 // fetch is the main blocker for the provider. It is responsible for fetching data from the
-// data provider and updating the data.
-func (p *Provider[K, V]) fetch(ctx context.Context) error {
+// data provider for the given IDs and updating the data.
+func (p *Provider[K, V]) fetch(ctx context.Context, ids []K) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -28,7 +26,7 @@ func (p *Provider[K, V]) fetch(ctx context.Context) error {
 		// The buffer size is set to the minimum of the number of IDs and the max number of queries.
 		// This is to ensure that the response channel does not block the query handler and that the
 		// query handler does not exceed the rate limit parameters of the provider.
-		responseCh = make(chan providertypes.GetResponse[K, V], math.Min(len(p.ids), p.apiCfg.MaxQueries))
+		responseCh = make(chan providertypes.GetResponse[K, V], math.Min(len(ids), p.apiCfg.MaxQueries))
 	case p.ws != nil:
 		// Otherwise, the buffer size is set to the max buffer size configured for the websocket.
 		responseCh = make(chan providertypes.GetResponse[K, V], p.wsCfg.MaxBufferSize)
@@ -42,9 +40,9 @@ func (p *Provider[K, V]) fetch(ctx context.Context) error {
 	// Determine which loop to use based on whether the provider is an API or webSocket provider.
 	switch {
 	case p.api != nil:
-		return p.startAPI(ctx, responseCh)
+		return p.startAPI(ctx, ids, responseCh)
 	case p.ws != nil:
-		return p.startMultiplexWebsocket(ctx, responseCh)
+		return p.startWebSocket(ctx, ids, responseCh)
 	default:
 		return fmt.Errorf("no api or websocket configured")
 	}
@@ -52,7 +50,7 @@ func (p *Provider[K, V]) fetch(ctx context.Context) error {
 
 // startAPI is the main loop for the provider. It is responsible for fetching data from the API
 // and updating the data.
-func (p *Provider[K, V]) startAPI(ctx context.Context, responseCh chan<- providertypes.GetResponse[K, V]) error {
+func (p *Provider[K, V]) startAPI(ctx context.Context, ids []K, responseCh chan<- providertypes.GetResponse[K, V]) error {
 	p.logger.Info("starting api query handler")
 
 	ticker := time.NewTicker(p.apiCfg.Interval)
@@ -68,19 +66,19 @@ func (p *Provider[K, V]) startAPI(ctx context.Context, responseCh chan<- provide
 		case <-ticker.C:
 			p.logger.Debug(
 				"attempting to fetch new data",
-				zap.Int("num_ids", len(p.ids)),
+				zap.Int("num_ids", len(ids)),
 				zap.Int("buffer_size", len(responseCh)),
 			)
 
-			p.attemptAPIDataUpdate(ctx, responseCh)
+			p.attemptAPIDataUpdate(ctx, ids, responseCh)
 		}
 	}
 }
 
 // attemptAPIDataUpdate tries to update data by fetching and parsing API data.
 // It logs any errors encountered during the process.
-func (p *Provider[K, V]) attemptAPIDataUpdate(ctx context.Context, responseCh chan<- providertypes.GetResponse[K, V]) {
-	if len(p.ids) == 0 {
+func (p *Provider[K, V]) attemptAPIDataUpdate(ctx context.Context, ids []K, responseCh chan<- providertypes.GetResponse[K, V]) {
+	if len(ids) == 0 {
 		p.logger.Debug("no ids to fetch")
 		return
 	}
@@ -97,78 +95,15 @@ func (p *Provider[K, V]) attemptAPIDataUpdate(ctx context.Context, responseCh ch
 
 		// Start the query handler. The handler must respect the context timeout.
 		p.logger.Debug("starting query handler")
-		p.api.Query(ctx, p.ids, responseCh)
+		p.api.Query(ctx, ids, responseCh)
 	}()
 }
 
-// startMultiplexWebsocket is the main loop for web socket providers. It is responsible for
-// creating a connection to the websocket and handling the incoming messages. In the case
-// where multiple connections (multiplexing) are used, this function will start multiple
-// connections.
-func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context, responseCh chan<- providertypes.GetResponse[K, V]) error {
-	var (
-		maxSubsPerConn = p.wsCfg.MaxSubscriptionsPerConnection
-		subTasks       = make([][]K, 0)
-		wg             = errgroup.Group{}
-	)
-
-	// create sub handlers
-	// if len(ids) == 30 and MaxSubscriptionsPerConnection == 45
-	// 30 / 45 = 0 -> need one sub handler
-	if maxSubsPerConn > 0 {
-		// case where we will split ID's across sub handlers
-		numSubHandlers := (len(p.ids) / maxSubsPerConn) + 1
-		wg.SetLimit(numSubHandlers)
-
-		// split ids
-		var subIDs []K
-		for i := 0; i < numSubHandlers; i++ {
-			start := i
-			end := maxSubsPerConn * (i + 1)
-			if i+1 == numSubHandlers {
-				subIDs = p.ids[start:]
-			} else {
-				subIDs = p.ids[start:end]
-			}
-
-			subTasks = append(subTasks, subIDs)
-		}
-	} else {
-		// case where there is 1 sub handler
-		subTasks = append(subTasks, p.ids)
-		wg.SetLimit(1)
-	}
-
-	for _, subIDs := range subTasks {
-		wg.Go(p.startWebSocket(ctx, subIDs, responseCh))
-	}
-
-	// Wait for all the sub handlers to finish.
-	return wg.Wait()
-}
-
-// startWebSocket starts a connection to the websocket and handles the incoming messages.
-func (p *Provider[K, V]) startWebSocket(ctx context.Context, subIDs []K, responseCh chan<- providertypes.GetResponse[K, V]) func() error {
-	return func() error {
-		// Start the websocket query handler. If the connection fails to start, then the query handler
-		// will be restarted after a timeout.
-		for {
-			select {
-			case <-ctx.Done():
-				p.logger.Info("provider stopped via context")
-				return ctx.Err()
-			default:
-				p.logger.Debug("starting websocket query handler")
-				if err := p.ws.Start(ctx, subIDs, responseCh); err != nil {
-					p.logger.Error("websocket query handler returned error", zap.Error(err))
-				}
-
-				// If the websocket query handler returns, then the connection was closed. Wait for
-				// a bit before trying to reconnect.
-				time.Sleep(p.wsCfg.ReconnectionTimeout)
-			}
-		}
-	}
+// startWebSocket is the main loop for web socket providers. The IDs are sharded across one or
+// more connections to the websocket, which are re-established with backoff whenever they close.
+func (p *Provider[K, V]) startWebSocket(ctx context.Context, ids []K, responseCh chan<- providertypes.GetResponse[K, V]) error {
+	p.logger.Info("starting websocket connection manager", zap.Int("num_ids", len(ids)))
+	return p.wsManager.Start(ctx, ids, responseCh)
 }
 
 // recv receives responses from the response channel and updates the data.
@@ -181,6 +116,7 @@ func (p *Provider[K, V]) recv(ctx context.Context, responseCh <-chan providertyp
 			return
 		case r := <-responseCh:
 			resolved, unResolved := r.Resolved, r.UnResolved
+			p.recordResponse(r)
 
 			// Update all the resolved data.
 			for id, result := range resolved {
@@ -216,6 +152,31 @@ func (p *Provider[K, V]) recv(ctx context.Context, responseCh <-chan providertyp
 	}
 }
 
+// recordResponse updates the connection status of the provider and the number of failed responses
+// for each unresolved ID. The provider is connected if the response resolved any data, and
+// disconnected if it only contains failures.
+func (p *Provider[K, V]) recordResponse(r providertypes.GetResponse[K, V]) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch {
+	case len(r.Resolved) > 0:
+		p.connection = providertypes.Connected
+	case len(r.UnResolved) > 0:
+		p.connection = providertypes.Disconnected
+	}
+
+	for id, err := range r.UnResolved {
+		status := p.failures[id]
+		status.Errors++
+		if err != nil {
+			status.LastError = err.Error()
+		}
+
+		p.failures[id] = status
+	}
+}
+
 // updateData sets the latest data for the provider. This will only update the data if the timestamp
 // of the data is greater than the current data.
 func (p *Provider[K, V]) updateData(id K, result providertypes.Result[V]) {
@@ -19,6 +19,8 @@ const (
 	ProviderTypeLabel = "type"
 	// StatusLabel is a label for the status of a provider response.
 	StatusLabel = "status"
+	// EndpointLabel is a label for the endpoint (i.e. URL) that a provider uses.
+	EndpointLabel = "endpoint"
 )
 
 type (
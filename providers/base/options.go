@@ -7,6 +7,7 @@ import (
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	providermetrics "github.com/skip-mev/slinky/providers/base/metrics"
 	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
@@ -110,3 +111,15 @@ func WithMetrics[K providertypes.ResponseKey, V providertypes.ResponseValue](met
 		p.metrics = metrics
 	}
 }
+
+// WithWebSocketMetrics sets the websocket metrics implementation that is used to report the
+// status of each of the provider's websocket connections.
+func WithWebSocketMetrics[K providertypes.ResponseKey, V providertypes.ResponseValue](metrics wsmetrics.WebSocketMetrics) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		if metrics == nil {
+			panic("cannot set nil websocket metrics")
+		}
+
+		p.wsMetrics = metrics
+	}
+}
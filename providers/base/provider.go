@@ -12,6 +12,7 @@ import (
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	providermetrics "github.com/skip-mev/slinky/providers/base/metrics"
 	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
@@ -41,6 +42,13 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 	// wsCfg is the websocket configuration for the provider.
 	wsCfg config.WebSocketConfig
 
+	// wsManager runs the connections of the websocket query handler. It shards the IDs of the
+	// provider across connections, and reconnects and rebalances failing connections.
+	wsManager *wshandlers.WebSocketConnManager[K, V]
+
+	// wsMetrics is the metrics implementation for the websocket connections of the provider.
+	wsMetrics wsmetrics.WebSocketMetrics
+
 	// data is the latest set of key -> value pairs for the provider i.e. the latest prices
 	// for a given set of currency pairs.
 	data map[K]providertypes.Result[V]
@@ -48,16 +56,29 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 	// ids is the set of IDs that the provider will fetch data for.
 	ids []K
 
+	// restartCh is used to signal the main loop that the set of IDs has been updated and
+	// that the underlying data handler must be restarted.
+	restartCh chan struct{}
+
 	// metrics is the metrics implementation for the provider.
 	metrics providermetrics.ProviderMetrics
+
+	// connection is the status of the connection to the data source.
+	connection providertypes.ConnectionStatus
+
+	// failures is the number of failed responses, along with the latest error, for each ID.
+	failures map[K]providertypes.IDStatus
 }
 
 // NewProvider returns a new Base provider.
 func NewProvider[K providertypes.ResponseKey, V providertypes.ResponseValue](opts ...ProviderOption[K, V]) (providertypes.Provider[K, V], error) {
 	p := &Provider[K, V]{
-		logger: zap.NewNop(),
-		ids:    make([]K, 0),
-		data:   make(map[K]providertypes.Result[V]),
+		logger:     zap.NewNop(),
+		ids:        make([]K, 0),
+		data:       make(map[K]providertypes.Result[V]),
+		restartCh:  make(chan struct{}, 1),
+		connection: providertypes.Stopped,
+		failures:   make(map[K]providertypes.IDStatus),
 	}
 
 	for _, opt := range opts {
@@ -79,19 +100,81 @@ func NewProvider[K providertypes.ResponseKey, V providertypes.ResponseValue](opt
 		p.metrics = providermetrics.NewNopProviderMetrics()
 	}
 
+	if p.wsMetrics == nil {
+		p.wsMetrics = wsmetrics.NewNopWebSocketMetrics()
+	}
+
+	if p.ws != nil {
+		manager, err := wshandlers.NewWebSocketConnManager(
+			p.logger,
+			p.wsCfg,
+			p.ws,
+			p.wsMetrics,
+			wshandlers.WithDisconnectHook[K, V](func() {
+				p.setConnectionStatus(providertypes.Disconnected)
+			}),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create websocket connection manager: %w", err)
+		}
+
+		p.wsManager = manager
+	}
+
 	return p, nil
 }
 
 // Start starts the provider's main loop. The provider will fetch the data from the handler
-// and continuously update the data. This blocks until the provider is stopped.
+// and continuously update the data. This blocks until the provider is stopped. If the set of
+// IDs is updated while the provider is running, the underlying data handler is restarted with
+// the new set of IDs.
 func (p *Provider[K, V]) Start(ctx context.Context) error {
 	p.logger.Info("starting provider")
-	if len(p.ids) == 0 {
-		p.logger.Warn("no ids to fetch")
-	}
 
-	// Start the main loop.
-	return p.fetch(ctx)
+	p.setConnectionStatus(providertypes.Connecting)
+	defer p.setConnectionStatus(providertypes.Stopped)
+
+	for {
+		ids := p.GetIDs()
+		if len(ids) == 0 {
+			p.logger.Warn("no ids to fetch")
+		}
+
+		// Start the main loop with the current set of IDs.
+		fetchCtx, cancel := context.WithCancel(ctx)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- p.fetch(fetchCtx, ids)
+		}()
+
+		select {
+		case err := <-errCh:
+			cancel()
+			if err != nil || len(ids) > 0 {
+				return err
+			}
+
+			// The data handler may exit immediately if there are no IDs to fetch. Wait for the
+			// set of IDs to be updated rather than exiting.
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-p.restartCh:
+			}
+		case <-p.restartCh:
+			// Wait for the current main loop to exit before restarting with the new set of IDs.
+			cancel()
+			err := <-errCh
+
+			// Drop any data for untracked IDs that was received while the main loop was exiting.
+			p.mu.Lock()
+			p.pruneData()
+			p.mu.Unlock()
+
+			p.setConnectionStatus(providertypes.Connecting)
+			p.logger.Info("restarting provider with updated ids", zap.Int("num_ids", len(p.GetIDs())), zap.Error(err))
+		}
+	}
 }
 
 // Name returns the name of the provider.
@@ -113,6 +196,89 @@ func (p *Provider[K, V]) GetData() map[K]providertypes.Result[V] {
 	return cpy
 }
 
+// GetIDs returns a copy of the set of IDs that the provider is responsible for fetching
+// data for.
+func (p *Provider[K, V]) GetIDs() []K {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cpy := make([]K, len(p.ids))
+	copy(cpy, p.ids)
+
+	return cpy
+}
+
+// SetIDs updates the set of IDs that the provider is responsible for fetching data for. Data
+// for IDs that are no longer tracked is dropped, while data for the remaining IDs is retained.
+// If the provider is running, the underlying data handler is restarted with the new set of IDs.
+func (p *Provider[K, V]) SetIDs(ids []K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.ids = make([]K, len(ids))
+	copy(p.ids, ids)
+	p.pruneData()
+
+	// Signal the main loop to restart. If a restart is already pending, the main loop will
+	// pick up the latest set of IDs when it restarts.
+	select {
+	case p.restartCh <- struct{}{}:
+	default:
+	}
+}
+
+// pruneData removes the data for all IDs that are no longer tracked by the provider. This must
+// be called with the provider lock held.
+func (p *Provider[K, V]) pruneData() {
+	tracked := make(map[K]struct{}, len(p.ids))
+	for _, id := range p.ids {
+		tracked[id] = struct{}{}
+	}
+
+	for id := range p.data {
+		if _, ok := tracked[id]; !ok {
+			delete(p.data, id)
+		}
+	}
+
+	for id := range p.failures {
+		if _, ok := tracked[id]; !ok {
+			delete(p.failures, id)
+		}
+	}
+}
+
+// GetStatus returns the connection status of the provider along with the time of the latest
+// data and the number of failed responses for each ID that the provider is responsible for.
+func (p *Provider[K, V]) GetStatus() providertypes.ProviderStatus[K] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := providertypes.ProviderStatus[K]{
+		Connection: p.connection,
+		IDs:        make(map[K]providertypes.IDStatus, len(p.ids)),
+	}
+
+	for _, id := range p.ids {
+		idStatus := p.failures[id]
+		if result, ok := p.data[id]; ok {
+			idStatus.LastUpdate = result.Timestamp
+		}
+
+		status.IDs[id] = idStatus
+	}
+
+	return status
+}
+
+// setConnectionStatus sets the connection status of the provider.
+func (p *Provider[K, V]) setConnectionStatus(status providertypes.ConnectionStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.connection = status
+}
+
 // Type returns the type of data handler the provider uses.
 func (p *Provider[K, V]) Type() providertypes.ProviderType {
 	switch {
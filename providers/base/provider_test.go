@@ -157,6 +157,7 @@ func TestStart(t *testing.T) {
 		cancel()
 
 		handler := wshandlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		handler.On("Copy").Return(handler).Maybe()
 
 		provider, err := base.NewProvider(
 			base.WithName[oracletypes.CurrencyPair, *big.Int](wsCfgMultiplex.Name),
@@ -207,6 +208,7 @@ func TestStart(t *testing.T) {
 			<-ctx.Done()
 			return ctx.Err()
 		}()).Maybe()
+		handler.On("Copy").Return(handler).Maybe()
 
 		provider, err := base.NewProvider(
 			base.WithName[oracletypes.CurrencyPair, *big.Int](wsCfgMultiplex.Name),
@@ -760,3 +762,129 @@ func TestMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestSetIDs(t *testing.T) {
+	handler := apihandlermocks.NewQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+	handler.On("Query", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		ids := args.Get(1).([]oracletypes.CurrencyPair)
+		responseCh := args.Get(2).(chan<- providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int])
+
+		resolved := make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
+		for _, id := range ids {
+			resolved[id] = providertypes.Result[*big.Int]{
+				Value:     big.NewInt(100),
+				Timestamp: time.Now(),
+			}
+		}
+
+		responseCh <- providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, nil)
+	}).Return()
+
+	provider, err := base.NewProvider(
+		base.WithName[oracletypes.CurrencyPair, *big.Int](apiCfg.Name),
+		base.WithAPIQueryHandler[oracletypes.CurrencyPair, *big.Int](handler),
+		base.WithAPIConfig[oracletypes.CurrencyPair, *big.Int](apiCfg),
+		base.WithLogger[oracletypes.CurrencyPair, *big.Int](logger),
+		base.WithIDs[oracletypes.CurrencyPair, *big.Int](pairs[:1]),
+	)
+	require.NoError(t, err)
+	require.Equal(t, pairs[:1], provider.GetIDs())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- provider.Start(ctx)
+	}()
+
+	hasPrices := func(ids ...oracletypes.CurrencyPair) func() bool {
+		return func() bool {
+			data := provider.GetData()
+			if len(data) != len(ids) {
+				return false
+			}
+
+			for _, id := range ids {
+				if _, ok := data[id]; !ok {
+					return false
+				}
+			}
+
+			return true
+		}
+	}
+
+	// The provider should only fetch prices for the initial set of IDs.
+	require.Eventually(t, hasPrices(pairs[0]), 5*time.Second, 50*time.Millisecond)
+
+	// Adding an ID should resubscribe the provider without stopping it.
+	provider.SetIDs(pairs)
+	require.Equal(t, pairs, provider.GetIDs())
+	require.Eventually(t, hasPrices(pairs...), 5*time.Second, 50*time.Millisecond)
+
+	// Removing an ID should drop the data for the removed ID.
+	provider.SetIDs(pairs[1:])
+	require.Equal(t, pairs[1:], provider.GetIDs())
+	require.Eventually(t, hasPrices(pairs[1]), 5*time.Second, 50*time.Millisecond)
+
+	cancel()
+	require.Equal(t, context.Canceled, <-errCh)
+}
+
+func TestGetStatus(t *testing.T) {
+	handler := apihandlermocks.NewQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+	handler.On("Query", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		responseCh := args.Get(2).(chan<- providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int])
+
+		responseCh <- providertypes.NewGetResponse(
+			map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+				pairs[0]: {
+					Value:     big.NewInt(100),
+					Timestamp: respTime,
+				},
+			},
+			map[oracletypes.CurrencyPair]error{
+				pairs[1]: fmt.Errorf("no response"),
+			},
+		)
+	}).Return()
+
+	provider, err := base.NewProvider(
+		base.WithName[oracletypes.CurrencyPair, *big.Int](apiCfg.Name),
+		base.WithAPIQueryHandler[oracletypes.CurrencyPair, *big.Int](handler),
+		base.WithAPIConfig[oracletypes.CurrencyPair, *big.Int](apiCfg),
+		base.WithLogger[oracletypes.CurrencyPair, *big.Int](logger),
+		base.WithIDs[oracletypes.CurrencyPair, *big.Int](pairs),
+	)
+	require.NoError(t, err)
+
+	reporter, ok := provider.(providertypes.StatusReporter[oracletypes.CurrencyPair])
+	require.True(t, ok)
+
+	status := reporter.GetStatus()
+	require.Equal(t, providertypes.Stopped, status.Connection)
+	require.Equal(t, map[oracletypes.CurrencyPair]providertypes.IDStatus{
+		pairs[0]: {},
+		pairs[1]: {},
+	}, status.IDs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- provider.Start(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return reporter.GetStatus().IDs[pairs[1]].Errors > 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	status = reporter.GetStatus()
+	require.Equal(t, providertypes.Connected, status.Connection)
+	require.Equal(t, respTime, status.IDs[pairs[0]].LastUpdate)
+	require.Zero(t, status.IDs[pairs[0]].Errors)
+	require.True(t, status.IDs[pairs[1]].LastUpdate.IsZero())
+	require.Equal(t, "no response", status.IDs[pairs[1]].LastError)
+
+	cancel()
+	require.Equal(t, context.Canceled, <-errCh)
+	require.Equal(t, providertypes.Stopped, reporter.GetStatus().Connection)
+}
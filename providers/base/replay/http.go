@@ -0,0 +1,176 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+)
+
+var (
+	_ handlers.RequestHandler = (*RecordingRequestHandler)(nil)
+	_ handlers.RequestHandler = (*ReplayRequestHandler)(nil)
+)
+
+// RecordingRequestHandler wraps a request handler and records the response, or error, returned for
+// every request sent to the data provider.
+type RecordingRequestHandler struct {
+	logger   *zap.Logger
+	provider string
+	handler  handlers.RequestHandler
+	recorder *Recorder
+}
+
+// NewRecordingRequestHandler returns a new request handler that records the responses returned by the
+// given request handler for the given provider.
+func NewRecordingRequestHandler(
+	logger *zap.Logger,
+	provider string,
+	handler handlers.RequestHandler,
+	recorder *Recorder,
+) (*RecordingRequestHandler, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("request handler cannot be nil")
+	}
+
+	if recorder == nil {
+		return nil, fmt.Errorf("recorder cannot be nil")
+	}
+
+	return &RecordingRequestHandler{
+		logger:   logger.With(zap.String("provider", provider)),
+		provider: provider,
+		handler:  handler,
+		recorder: recorder,
+	}, nil
+}
+
+// Do sends the request with the underlying request handler and records the response. The body of the
+// response is read in full so that it can be recorded, and is replaced with an in-memory copy.
+func (h *RecordingRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	record := Record{
+		Type:     HTTPRecord,
+		Provider: h.provider,
+		URL:      url,
+	}
+
+	resp, err := h.handler.Do(ctx, url)
+	if err == nil {
+		var body []byte
+		body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		record.StatusCode = resp.StatusCode
+		record.Header = resp.Header
+		record.Data = body
+	}
+
+	record.Timestamp = time.Now().UTC()
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if recordErr := h.recorder.Record(record); recordErr != nil {
+		h.logger.Error("failed to record http response", zap.String("url", url), zap.Error(recordErr))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Type returns the HTTP method used by the underlying request handler.
+func (h *RecordingRequestHandler) Type() string {
+	return h.handler.Type()
+}
+
+// ReplayRequestHandler implements the request handler by replaying the responses recorded for a
+// provider. The responses recorded for each URL are returned in the order they were recorded, once
+// they are due. ErrEndOfRecording is returned once all of the responses for a URL have been replayed.
+type ReplayRequestHandler struct {
+	mtx sync.Mutex
+
+	player *Player
+	method string
+
+	// responses is the set of responses that have not been replayed yet, keyed by URL.
+	responses map[string][]Record
+}
+
+// NewReplayRequestHandler returns a new request handler that replays the responses recorded for the
+// given provider. The method is the HTTP method reported by the request handler.
+func NewReplayRequestHandler(player *Player, provider, method string) (*ReplayRequestHandler, error) {
+	if player == nil {
+		return nil, fmt.Errorf("player cannot be nil")
+	}
+
+	if method == "" {
+		return nil, fmt.Errorf("http request method cannot be empty")
+	}
+
+	responses := make(map[string][]Record)
+	for _, record := range player.Records(provider, HTTPRecord) {
+		responses[record.URL] = append(responses[record.URL], record)
+	}
+
+	return &ReplayRequestHandler{
+		player:    player,
+		method:    method,
+		responses: responses,
+	}, nil
+}
+
+// Do returns the next response recorded for the given URL once it is due.
+func (h *ReplayRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	h.mtx.Lock()
+	queue := h.responses[url]
+	if len(queue) == 0 {
+		h.mtx.Unlock()
+		return nil, fmt.Errorf("%w: no response for %s", ErrEndOfRecording, url)
+	}
+	record := queue[0]
+	h.responses[url] = queue[1:]
+	h.mtx.Unlock()
+
+	if err := h.player.Wait(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if record.Error != "" {
+		return nil, errors.New(record.Error)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, h.method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", record.StatusCode, http.StatusText(record.StatusCode)),
+		StatusCode:    record.StatusCode,
+		Header:        record.Header,
+		Body:          io.NopCloser(bytes.NewReader(record.Data)),
+		ContentLength: int64(len(record.Data)),
+		Request:       req,
+	}, nil
+}
+
+// Type returns the HTTP method of the replayed requests.
+func (h *ReplayRequestHandler) Type() string {
+	return h.method
+}
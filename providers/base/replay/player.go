@@ -0,0 +1,106 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Player replays a recording with its original timing, or sped up. Each record is due once the time
+// elapsed since the start of the replay, multiplied by the speed, reaches the time elapsed between
+// the first record of the recording and the record. The replay starts the first time a record is
+// waited on. A single player should be shared by all of the replay handlers of a recording, such
+// that the timing across providers is preserved.
+type Player struct {
+	mtx sync.Mutex
+
+	// records is the recording to replay.
+	records []Record
+
+	// speed is the factor by which the replay is sped up. If zero, all records are due
+	// immediately.
+	speed float64
+
+	// first is the timestamp of the first record of the recording.
+	first time.Time
+
+	// start is the time at which the replay started.
+	start time.Time
+}
+
+// NewPlayer returns a new player for the given records. A speed of 1 replays the recording with its
+// original timing, a speed of 10 replays it 10 times faster, and a speed of 0 replays every record
+// as soon as it is read.
+func NewPlayer(records []Record, speed float64) (*Player, error) {
+	if speed < 0 {
+		return nil, fmt.Errorf("replay speed cannot be negative; got %f", speed)
+	}
+
+	p := &Player{
+		records: records,
+		speed:   speed,
+	}
+
+	for _, record := range records {
+		if p.first.IsZero() || record.Timestamp.Before(p.first) {
+			p.first = record.Timestamp
+		}
+	}
+
+	return p, nil
+}
+
+// NewPlayerFromFile returns a new player for the recording file at the given path.
+func NewPlayerFromFile(path string, speed float64) (*Player, error) {
+	records, err := ReadRecords(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPlayer(records, speed)
+}
+
+// Records returns the records of the given type for the given provider, in the order they were
+// recorded.
+func (p *Player) Records(provider string, recordType RecordType) []Record {
+	records := make([]Record, 0)
+	for _, record := range p.records {
+		if record.Provider == provider && record.Type == recordType {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// Due returns the time at which the given record is due. The replay is started if it has not
+// started yet.
+func (p *Player) Due(record Record) time.Time {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.start.IsZero() {
+		p.start = time.Now()
+	}
+
+	if p.speed == 0 {
+		return p.start
+	}
+
+	offset := float64(record.Timestamp.Sub(p.first)) / p.speed
+	return p.start.Add(time.Duration(offset))
+}
+
+// Wait blocks until the given record is due or the context is cancelled.
+func (p *Player) Wait(ctx context.Context, record Record) error {
+	timer := time.NewTimer(time.Until(p.Due(record)))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
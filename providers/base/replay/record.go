@@ -0,0 +1,117 @@
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// RecordType is the type of traffic captured by a record.
+type RecordType string
+
+const (
+	// WebSocketRecord is a raw frame read from a websocket connection.
+	WebSocketRecord RecordType = "websocket"
+
+	// HTTPRecord is a response (or error) returned for an HTTP request.
+	HTTPRecord RecordType = "http"
+)
+
+// Record is a single timestamped unit of provider traffic. Records are written to the recording
+// file as JSON lines, such that the traffic of multiple providers can be captured in a single file.
+type Record struct {
+	// Timestamp is the time at which the frame was read or the response was received.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Type is the type of traffic captured by the record.
+	Type RecordType `json:"type"`
+
+	// Provider is the name of the provider that the traffic belongs to.
+	Provider string `json:"provider"`
+
+	// URL is the URL of the HTTP request. This is empty for websocket records.
+	URL string `json:"url,omitempty"`
+
+	// StatusCode is the status code of the HTTP response.
+	StatusCode int `json:"status_code,omitempty"`
+
+	// Header is the header of the HTTP response.
+	Header http.Header `json:"header,omitempty"`
+
+	// Data is the raw websocket frame or the body of the HTTP response.
+	Data []byte `json:"data,omitempty"`
+
+	// Error is the error returned for the HTTP request, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Recorder writes records to a recording file. The recorder is safe for concurrent use, such that
+// a single recorder can be shared by all of the providers of the oracle.
+type Recorder struct {
+	mtx  sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewRecorder returns a new recorder that appends records to the file at the given path. The file is
+// created if it does not exist.
+func NewRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording file: %w", err)
+	}
+
+	return &Recorder{
+		file: file,
+		enc:  json.NewEncoder(file),
+	}, nil
+}
+
+// Record writes the given record to the recording file.
+func (r *Recorder) Record(record Record) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if err := r.enc.Encode(record); err != nil {
+		return fmt.Errorf("failed to write record: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the recording file.
+func (r *Recorder) Close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.file.Close()
+}
+
+// ReadRecords reads all of the records from the recording file at the given path.
+func ReadRecords(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording file: %w", err)
+	}
+	defer file.Close()
+
+	records := make([]Record, 0)
+	dec := json.NewDecoder(file)
+	for {
+		var record Record
+		if err := dec.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, fmt.Errorf("failed to read record %d: %w", len(records), err)
+		}
+
+		records = append(records, record)
+	}
+}
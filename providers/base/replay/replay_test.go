@@ -0,0 +1,304 @@
+package replay_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/base/replay"
+	wshandlermocks "github.com/skip-mev/slinky/providers/base/websocket/handlers/mocks"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+const provider = "provider"
+
+var logger = zap.NewExample()
+
+func TestRecordAndReplayHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"path":"%s"}`, r.URL.Path)
+	}))
+
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	recorder, err := replay.NewRecorder(path)
+	require.NoError(t, err)
+
+	requestHandler, err := apihandlers.NewRequestHandlerImpl(srv.Client())
+	require.NoError(t, err)
+
+	handler, err := replay.NewRecordingRequestHandler(logger, provider, requestHandler, recorder)
+	require.NoError(t, err)
+	require.Equal(t, http.MethodGet, handler.Type())
+
+	// The recorded responses are returned to the caller as is.
+	for _, p := range []string{"/a", "/b", "/a"} {
+		resp, err := handler.Do(context.Background(), srv.URL+p)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf(`{"path":"%s"}`, p), string(body))
+	}
+
+	// Errors are recorded as well.
+	srv.Close()
+	_, err = handler.Do(context.Background(), srv.URL+"/b")
+	require.Error(t, err)
+	require.NoError(t, recorder.Close())
+
+	records, err := replay.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	player, err := replay.NewPlayer(records, 0)
+	require.NoError(t, err)
+
+	replayer, err := replay.NewReplayRequestHandler(player, provider, http.MethodGet)
+	require.NoError(t, err)
+
+	// The responses for each URL are replayed in the order they were recorded.
+	for _, p := range []string{"/a", "/a", "/b"} {
+		resp, err := replayer.Do(context.Background(), srv.URL+p)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf(`{"path":"%s"}`, p), string(body))
+	}
+
+	_, err = replayer.Do(context.Background(), srv.URL+"/b")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, replay.ErrEndOfRecording)
+
+	_, err = replayer.Do(context.Background(), srv.URL+"/b")
+	require.ErrorIs(t, err, replay.ErrEndOfRecording)
+
+	// Responses recorded for other providers are not replayed.
+	other, err := replay.NewReplayRequestHandler(player, "other", http.MethodGet)
+	require.NoError(t, err)
+
+	_, err = other.Do(context.Background(), srv.URL+"/a")
+	require.ErrorIs(t, err, replay.ErrEndOfRecording)
+}
+
+func TestRecordAndReplayWebSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	recorder, err := replay.NewRecorder(path)
+	require.NoError(t, err)
+
+	connHandler := wshandlermocks.NewWebSocketConnHandler(t)
+	connHandler.On("Dial").Return(nil).Once()
+	connHandler.On("Read").Return([]byte("frame1"), nil).Once()
+	connHandler.On("Read").Return(nil, fmt.Errorf("read error")).Once()
+	connHandler.On("Read").Return([]byte("frame2"), nil).Once()
+	connHandler.On("Write", []byte("subscribe")).Return(nil).Once()
+	connHandler.On("Close").Return(nil).Once()
+
+	handler, err := replay.NewRecordingWebSocketConnHandler(logger, provider, connHandler, recorder)
+	require.NoError(t, err)
+
+	// Only the frames that were read successfully are recorded.
+	require.NoError(t, handler.Dial())
+	require.NoError(t, handler.Write([]byte("subscribe")))
+	for _, expected := range []string{"frame1", "", "frame2"} {
+		frame, err := handler.Read()
+		if expected == "" {
+			require.Error(t, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, expected, string(frame))
+	}
+	require.NoError(t, handler.Close())
+	require.NoError(t, recorder.Close())
+
+	records, err := replay.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	player, err := replay.NewPlayer(records, 0)
+	require.NoError(t, err)
+
+	replayer, err := replay.NewReplayWebSocketConnHandler(player, provider, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	// The connection must be established before it can be used.
+	_, err = replayer.Read()
+	require.Error(t, err)
+	require.Error(t, replayer.Write([]byte("subscribe")))
+	require.Error(t, replayer.Close())
+
+	require.NoError(t, replayer.Dial())
+	require.NoError(t, replayer.Write([]byte("subscribe")))
+
+	frame, err := replayer.Read()
+	require.NoError(t, err)
+	require.Equal(t, "frame1", string(frame))
+
+	// The frames are not replayed again once the connection is re-established.
+	require.NoError(t, replayer.Close())
+	require.NoError(t, replayer.Dial())
+
+	frame, err = replayer.Read()
+	require.NoError(t, err)
+	require.Equal(t, "frame2", string(frame))
+
+	_, err = replayer.Read()
+	require.ErrorIs(t, err, replay.ErrEndOfRecording)
+}
+
+func TestReplayWebSocketTiming(t *testing.T) {
+	start := time.Now().UTC()
+	records := []replay.Record{
+		{Timestamp: start, Type: replay.WebSocketRecord, Provider: provider, Data: []byte("frame1")},
+		{Timestamp: start.Add(time.Second), Type: replay.WebSocketRecord, Provider: provider, Data: []byte("frame2")},
+	}
+
+	player, err := replay.NewPlayer(records, 10)
+	require.NoError(t, err)
+
+	// The second frame is due 100ms after the first frame, which is after the read timeout.
+	replayer, err := replay.NewReplayWebSocketConnHandler(player, provider, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, replayer.Dial())
+
+	frame, err := replayer.Read()
+	require.NoError(t, err)
+	require.Equal(t, "frame1", string(frame))
+
+	_, err = replayer.Read()
+	require.ErrorIs(t, err, replay.ErrReadTimeout)
+
+	frame, err = replayer.Read()
+	require.NoError(t, err)
+	require.Equal(t, "frame2", string(frame))
+	require.GreaterOrEqual(t, time.Since(player.Due(records[0])), 100*time.Millisecond)
+
+	// Closing the connection unblocks a pending read.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, replayer.Close())
+	}()
+
+	_, err = replayer.Read()
+	require.Error(t, err)
+	require.NotErrorIs(t, err, replay.ErrEndOfRecording)
+}
+
+func TestPlayer(t *testing.T) {
+	_, err := replay.NewPlayer(nil, -1)
+	require.Error(t, err)
+
+	start := time.Now().UTC()
+	records := []replay.Record{
+		{Timestamp: start.Add(200 * time.Millisecond)},
+		{Timestamp: start},
+	}
+
+	testCases := []struct {
+		name     string
+		speed    float64
+		expected time.Duration
+	}{
+		{
+			name:     "original timing",
+			speed:    1,
+			expected: 200 * time.Millisecond,
+		},
+		{
+			name:     "sped up",
+			speed:    4,
+			expected: 50 * time.Millisecond,
+		},
+		{
+			name:     "as fast as possible",
+			speed:    0,
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			player, err := replay.NewPlayer(records, tc.speed)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, player.Due(records[0]).Sub(player.Due(records[1])))
+
+			// The context is respected while waiting for a record.
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			if tc.expected > 0 {
+				require.ErrorIs(t, player.Wait(ctx, records[0]), context.Canceled)
+			}
+		})
+	}
+}
+
+func TestReplayAPIQueryHandler(t *testing.T) {
+	btc := oracletypes.NewCurrencyPair("BITCOIN", "USD")
+	cfg := config.ProviderConfig{
+		Name: coinbase.Name,
+		API:  coinbase.DefaultAPIConfig,
+		Market: config.MarketConfig{
+			Name: coinbase.Name,
+			CurrencyPairToMarketConfigs: map[string]config.CurrencyPairMarketConfig{
+				btc.String(): {
+					Ticker:       "BTC-USD",
+					CurrencyPair: btc,
+				},
+			},
+		},
+	}
+
+	// The recorded coinbase response is parsed without any network access.
+	player, err := replay.NewPlayer([]replay.Record{
+		{
+			Timestamp:  time.Now().UTC(),
+			Type:       replay.HTTPRecord,
+			Provider:   coinbase.Name,
+			URL:        fmt.Sprintf(coinbase.URL, "BTC-USD"),
+			StatusCode: http.StatusOK,
+			Data:       []byte(`{"data":{"amount":"1020.25","currency":"USD"}}`),
+		},
+	}, 0)
+	require.NoError(t, err)
+
+	requestHandler, err := replay.NewReplayRequestHandler(player, coinbase.Name, http.MethodGet)
+	require.NoError(t, err)
+
+	dataHandler, err := coinbase.NewAPIHandler(cfg)
+	require.NoError(t, err)
+
+	queryHandler, err := apihandlers.NewAPIQueryHandler[oracletypes.CurrencyPair, *big.Int](
+		logger,
+		cfg.API,
+		requestHandler,
+		dataHandler,
+		apimetrics.NewNopAPIMetrics(),
+	)
+	require.NoError(t, err)
+
+	responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], 1)
+	queryHandler.Query(context.Background(), []oracletypes.CurrencyPair{btc}, responseCh)
+
+	resp := <-responseCh
+	require.Empty(t, resp.UnResolved)
+	require.Equal(t, big.NewInt(102025000000), resp.Resolved[btc].Value)
+}
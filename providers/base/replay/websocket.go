@@ -0,0 +1,232 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+)
+
+var (
+	// ErrEndOfRecording is returned once all of the recorded traffic has been replayed.
+	ErrEndOfRecording = errors.New("end of recording")
+
+	// ErrReadTimeout is returned if no recorded frame is due within the read timeout.
+	ErrReadTimeout = errors.New("replay read timeout")
+)
+
+var (
+	_ handlers.WebSocketConnHandler = (*RecordingWebSocketConnHandler)(nil)
+	_ handlers.WebSocketConnHandler = (*ReplayWebSocketConnHandler)(nil)
+)
+
+// RecordingWebSocketConnHandler wraps a websocket connection handler and records every raw frame
+// that is read from the data provider. All other operations are passed through to the underlying
+// connection handler.
+type RecordingWebSocketConnHandler struct {
+	handlers.WebSocketConnHandler
+
+	logger   *zap.Logger
+	provider string
+	recorder *Recorder
+}
+
+// NewRecordingWebSocketConnHandler returns a new websocket connection handler that records the frames
+// read by the given connection handler for the given provider.
+func NewRecordingWebSocketConnHandler(
+	logger *zap.Logger,
+	provider string,
+	handler handlers.WebSocketConnHandler,
+	recorder *Recorder,
+) (*RecordingWebSocketConnHandler, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("connection handler cannot be nil")
+	}
+
+	if recorder == nil {
+		return nil, fmt.Errorf("recorder cannot be nil")
+	}
+
+	return &RecordingWebSocketConnHandler{
+		WebSocketConnHandler: handler,
+		logger:               logger.With(zap.String("provider", provider)),
+		provider:             provider,
+		recorder:             recorder,
+	}, nil
+}
+
+// Read reads a frame from the underlying connection handler and records it. Read errors are not
+// recorded since they are reproduced by the timing of the replay.
+func (h *RecordingWebSocketConnHandler) Read() ([]byte, error) {
+	message, err := h.WebSocketConnHandler.Read()
+	if err != nil {
+		return message, err
+	}
+
+	if err := h.recorder.Record(Record{
+		Timestamp: time.Now().UTC(),
+		Type:      WebSocketRecord,
+		Provider:  h.provider,
+		Data:      message,
+	}); err != nil {
+		h.logger.Error("failed to record websocket frame", zap.Error(err))
+	}
+
+	return message, nil
+}
+
+// Copy returns a copy of the connection handler that records the frames read by a copy of the
+// underlying connection handler.
+func (h *RecordingWebSocketConnHandler) Copy() handlers.WebSocketConnHandler {
+	return &RecordingWebSocketConnHandler{
+		WebSocketConnHandler: h.WebSocketConnHandler.Copy(),
+		logger:               h.logger,
+		provider:             h.provider,
+		recorder:             h.recorder,
+	}
+}
+
+// ReplayWebSocketConnHandler implements the websocket connection handler by replaying the frames
+// recorded for a provider. Frames are read in the order they were recorded, once they are due. As
+// with a live connection, a read fails if no frame is due within the read timeout. Messages that are
+// written to the connection are discarded. The recorded frames are not replayed again when the
+// connection is re-established.
+type ReplayWebSocketConnHandler struct {
+	mtx sync.Mutex
+
+	player      *Player
+	readTimeout time.Duration
+
+	// frames is the set of frames that have not been read yet.
+	frames []Record
+
+	// closeCh is closed when the connection is closed. It is nil if the connection has not
+	// been established.
+	closeCh chan struct{}
+}
+
+// NewReplayWebSocketConnHandler returns a new websocket connection handler that replays the frames
+// recorded for the given provider.
+func NewReplayWebSocketConnHandler(
+	player *Player,
+	provider string,
+	readTimeout time.Duration,
+) (*ReplayWebSocketConnHandler, error) {
+	if player == nil {
+		return nil, fmt.Errorf("player cannot be nil")
+	}
+
+	if readTimeout <= 0 {
+		return nil, fmt.Errorf("read timeout must be strictly positive")
+	}
+
+	return &ReplayWebSocketConnHandler{
+		player:      player,
+		readTimeout: readTimeout,
+		frames:      player.Records(provider, WebSocketRecord),
+	}, nil
+}
+
+// Dial establishes the replayed connection.
+func (h *ReplayWebSocketConnHandler) Dial() error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.closeCh = make(chan struct{})
+	return nil
+}
+
+// Copy returns a connection handler that replays no frames. The frames of all of the connections
+// of a provider are recorded in the order they were read, and are replayed once by the original
+// connection handler.
+func (h *ReplayWebSocketConnHandler) Copy() handlers.WebSocketConnHandler {
+	return &ReplayWebSocketConnHandler{
+		player:      h.player,
+		readTimeout: h.readTimeout,
+	}
+}
+
+// Read returns the next recorded frame once it is due. ErrReadTimeout is returned if the frame is not
+// due within the read timeout, and ErrEndOfRecording is returned after the read timeout once all of the
+// frames have been read.
+func (h *ReplayWebSocketConnHandler) Read() ([]byte, error) {
+	h.mtx.Lock()
+	closeCh := h.closeCh
+	frames := h.frames
+	h.mtx.Unlock()
+
+	if closeCh == nil {
+		return nil, fmt.Errorf("connection has not been established")
+	}
+
+	deadline := time.Now().Add(h.readTimeout)
+	if len(frames) == 0 {
+		return nil, waitUntil(deadline, closeCh, ErrEndOfRecording)
+	}
+
+	due := h.player.Due(frames[0])
+	if due.After(deadline) {
+		return nil, waitUntil(deadline, closeCh, ErrReadTimeout)
+	}
+
+	if err := waitUntil(due, closeCh, nil); err != nil {
+		return nil, err
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	frame := h.frames[0]
+	h.frames = h.frames[1:]
+
+	return frame.Data, nil
+}
+
+// Write discards the given message.
+func (h *ReplayWebSocketConnHandler) Write([]byte) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.closeCh == nil {
+		return fmt.Errorf("connection has not been established")
+	}
+
+	return nil
+}
+
+// Close closes the replayed connection, unblocking any pending read.
+func (h *ReplayWebSocketConnHandler) Close() error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.closeCh == nil {
+		return fmt.Errorf("connection has not been established")
+	}
+
+	close(h.closeCh)
+	h.closeCh = nil
+
+	return nil
+}
+
+// waitUntil blocks until the given time and returns the given error. An error is returned if the
+// connection is closed before then.
+func waitUntil(t time.Time, closeCh <-chan struct{}, err error) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+
+	select {
+	case <-closeCh:
+		return fmt.Errorf("connection closed")
+	case <-timer.C:
+		return err
+	}
+}
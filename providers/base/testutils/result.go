@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+// RequireMarketDataEqual asserts that the 24 hour volume and best bid and ask of the actual
+// price result match the expected result. Volumes are compared at float64 precision.
+func RequireMarketDataEqual(t *testing.T, expected, actual providertypes.Result[*big.Int]) {
+	t.Helper()
+
+	require.Equal(t, expected.Bid, actual.Bid)
+	require.Equal(t, expected.Ask, actual.Ask)
+
+	if expected.Volume == nil {
+		require.Nil(t, actual.Volume)
+		return
+	}
+
+	require.NotNil(t, actual.Volume)
+	expectedVolume, _ := expected.Volume.Float64()
+	actualVolume, _ := actual.Volume.Float64()
+	require.Equal(t, expectedVolume, actualVolume)
+}
@@ -36,6 +36,7 @@ func CreateWebSocketQueryHandlerWithGetResponses[K providertypes.ResponseKey, V
 			time.Sleep(timeout)
 		}
 	}).Maybe()
+	handler.On("Copy").Return(handler).Maybe()
 
 	return handler
 }
@@ -54,6 +55,7 @@ func CreateWebSocketQueryHandlerWithResponseFn[K providertypes.ResponseKey, V pr
 		responseCh := args.Get(2).(chan<- providertypes.GetResponse[K, V])
 		fn(responseCh)
 	}).Maybe()
+	handler.On("Copy").Return(handler).Maybe()
 
 	return handler
 }
@@ -64,6 +66,7 @@ func CreateWebSocketProviderWithGetResponses[K providertypes.ResponseKey, V prov
 	timeout time.Duration,
 	cfg config.ProviderConfig,
 	logger *zap.Logger,
+	ids []K,
 	responses []providertypes.GetResponse[K, V],
 ) providertypes.Provider[K, V] {
 	t.Helper()
@@ -80,6 +83,7 @@ func CreateWebSocketProviderWithGetResponses[K providertypes.ResponseKey, V prov
 		base.WithWebSocketQueryHandler[K, V](handler),
 		base.WithWebSocketConfig[K, V](cfg.WebSocket),
 		base.WithLogger[K, V](logger),
+		base.WithIDs[K, V](ids),
 	)
 	require.NoError(t, err)
 
@@ -91,6 +95,7 @@ func CreateWebSocketProviderWithResponseFn[K providertypes.ResponseKey, V provid
 	t *testing.T,
 	cfg config.ProviderConfig,
 	logger *zap.Logger,
+	ids []K,
 	fn func(chan<- providertypes.GetResponse[K, V]),
 ) providertypes.Provider[K, V] {
 	t.Helper()
@@ -105,6 +110,7 @@ func CreateWebSocketProviderWithResponseFn[K providertypes.ResponseKey, V provid
 		base.WithWebSocketQueryHandler[K, V](handler),
 		base.WithWebSocketConfig[K, V](cfg.WebSocket),
 		base.WithLogger[K, V](logger),
+		base.WithIDs[K, V](ids),
 	)
 	require.NoError(t, err)
 
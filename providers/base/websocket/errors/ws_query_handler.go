@@ -24,6 +24,10 @@ var (
 
 	// ErrDial is returned when the WebSocketConnHandler cannot create a connection.
 	ErrDial = errors.New("websocket connection handler failed to create connection")
+
+	// ErrFailback is returned when the WebSocketConnHandler is connected to a fallback endpoint
+	// and the connection should be re-established with the primary endpoint.
+	ErrFailback = errors.New("websocket connection should be re-established with the primary endpoint")
 )
 
 // ErrHandleMessageWithErr is used to create a new ErrHandleMessage with the given error.
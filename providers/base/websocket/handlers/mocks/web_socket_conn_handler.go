@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	handlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // WebSocketConnHandler is an autogenerated mock type for the WebSocketConnHandler type
 type WebSocketConnHandler struct {
@@ -27,6 +30,26 @@ func (_m *WebSocketConnHandler) Close() error {
 	return r0
 }
 
+// Copy provides a mock function with given fields:
+func (_m *WebSocketConnHandler) Copy() handlers.WebSocketConnHandler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Copy")
+	}
+
+	var r0 handlers.WebSocketConnHandler
+	if rf, ok := ret.Get(0).(func() handlers.WebSocketConnHandler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(handlers.WebSocketConnHandler)
+		}
+	}
+
+	return r0
+}
+
 // Dial provides a mock function with given fields:
 func (_m *WebSocketConnHandler) Dial() error {
 	ret := _m.Called()
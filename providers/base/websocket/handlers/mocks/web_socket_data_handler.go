@@ -14,6 +14,26 @@ type WebSocketDataHandler[K types.ResponseKey, V types.ResponseValue] struct {
 	mock.Mock
 }
 
+// Copy provides a mock function with given fields:
+func (_m *WebSocketDataHandler[K, V]) Copy() handlers.WebSocketDataHandler[K, V] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Copy")
+	}
+
+	var r0 handlers.WebSocketDataHandler[K, V]
+	if rf, ok := ret.Get(0).(func() handlers.WebSocketDataHandler[K, V]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(handlers.WebSocketDataHandler[K, V])
+		}
+	}
+
+	return r0
+}
+
 // CreateMessages provides a mock function with given fields: ids
 func (_m *WebSocketDataHandler[K, V]) CreateMessages(ids []K) ([]handlers.WebsocketEncodedMessage, error) {
 	ret := _m.Called(ids)
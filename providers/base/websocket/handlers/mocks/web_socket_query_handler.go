@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	handlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/skip-mev/slinky/providers/types"
@@ -15,6 +16,26 @@ type WebSocketQueryHandler[K types.ResponseKey, V types.ResponseValue] struct {
 	mock.Mock
 }
 
+// Copy provides a mock function with given fields:
+func (_m *WebSocketQueryHandler[K, V]) Copy() handlers.WebSocketQueryHandler[K, V] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Copy")
+	}
+
+	var r0 handlers.WebSocketQueryHandler[K, V]
+	if rf, ok := ret.Get(0).(func() handlers.WebSocketQueryHandler[K, V]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(handlers.WebSocketQueryHandler[K, V])
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: ctx, ids, responseCh
 func (_m *WebSocketQueryHandler[K, V]) Start(ctx context.Context, ids []K, responseCh chan<- types.GetResponse[K, V]) error {
 	ret := _m.Called(ctx, ids, responseCh)
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	stderrors "errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -9,6 +10,9 @@ import (
 	"github.com/gorilla/websocket"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/endpoints"
+	"github.com/skip-mev/slinky/providers/base/websocket/errors"
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
 )
 
 type (
@@ -42,6 +46,10 @@ type WebSocketConnHandler interface {
 
 	// Dial is used to create the connection to the data provider.
 	Dial() error
+
+	// Copy is used to create a copy of the connection handler that is not connected to the
+	// data provider. This is used to open multiple connections to the same data provider.
+	Copy() WebSocketConnHandler
 }
 
 // WebSocketConnHandlerImpl is a struct that implements the WebSocketConnHandler interface.
@@ -54,6 +62,12 @@ type WebSocketConnHandlerImpl struct {
 
 	// preDialHook is a function that is called before the connection is established.
 	preDialHook PreDialHook
+
+	// failover tracks which of the WSS and the fallback WSS endpoints of the provider is used.
+	failover *endpoints.Failover
+
+	// metrics reports the endpoint that the connection is established with.
+	metrics metrics.WebSocketMetrics
 }
 
 // NewWebSocketHandlerImpl returns a new WebSocketConnHandlerImpl.
@@ -63,7 +77,9 @@ func NewWebSocketHandlerImpl(cfg config.WebSocketConfig, opts ...Option) (*WebSo
 	}
 
 	h := &WebSocketConnHandlerImpl{
-		cfg: cfg,
+		cfg:      cfg,
+		failover: endpoints.NewFailover(len(cfg.GetEndpoints()), cfg.GetFailbackInterval()),
+		metrics:  metrics.NewNopWebSocketMetrics(),
 	}
 
 	for _, opt := range opts {
@@ -84,7 +100,9 @@ func (h *WebSocketConnHandlerImpl) CreateDialer() *websocket.Dialer {
 	}
 }
 
-// Dial is used to create a new connection to the data provider with the given URL.
+// Dial is used to create a new connection to the data provider. The connection is established
+// with the active endpoint of the provider. If that fails, the provider fails over to the next
+// endpoint until a connection is established or every endpoint has been tried.
 func (h *WebSocketConnHandlerImpl) Dial() error {
 	if h.preDialHook != nil {
 		if err := h.preDialHook(h); err != nil {
@@ -92,9 +110,40 @@ func (h *WebSocketConnHandlerImpl) Dial() error {
 		}
 	}
 
-	var err error
-	h.conn, _, err = h.CreateDialer().Dial(h.cfg.WSS, nil)
-	return err
+	h.Lock()
+	cfg, failover := h.cfg, h.failover
+	h.Unlock()
+
+	urls := cfg.GetEndpoints()
+	dialer := h.CreateDialer()
+
+	var errs []error
+	endpoint := failover.Active()
+	for range urls {
+		conn, _, err := dialer.Dial(urls[endpoint], nil)
+		if err == nil {
+			h.Lock()
+			h.conn = conn
+			h.Unlock()
+
+			if failover.Size() > 1 {
+				h.metrics.SetActiveEndpoint(cfg.Name, endpoints.Label(urls[endpoint]))
+			}
+
+			return nil
+		}
+
+		// The endpoint may contain credentials, so only its label is included in the error.
+		errs = append(errs, fmt.Errorf("failed to dial %s: %w", endpoints.Label(urls[endpoint]), err))
+
+		next := failover.ReportFailure(endpoint)
+		if next == endpoint {
+			break
+		}
+		endpoint = next
+	}
+
+	return stderrors.Join(errs...)
 }
 
 // Read is used to read data from the data provider. Each websocket data handler is responsible
@@ -107,6 +156,11 @@ func (h *WebSocketConnHandlerImpl) Read() ([]byte, error) {
 		return nil, fmt.Errorf("connection has not been established")
 	}
 
+	// Stop reading from a fallback endpoint once the primary endpoint should be used again.
+	if h.failover.FailbackDue() {
+		return nil, errors.ErrFailback
+	}
+
 	// Set the read deadline to the configured read timeout.
 	if err := h.conn.SetReadDeadline(time.Now().Add(h.cfg.ReadTimeout)); err != nil {
 		return nil, err
@@ -158,6 +212,20 @@ func (h *WebSocketConnHandlerImpl) Close() error {
 	return h.conn.Close()
 }
 
+// Copy is used to create a copy of the connection handler with the same configuration and
+// options. The copy is not connected to the data provider, and fails over independently of
+// the original.
+func (h *WebSocketConnHandlerImpl) Copy() WebSocketConnHandler {
+	cfg := h.GetConfig()
+
+	return &WebSocketConnHandlerImpl{
+		cfg:         cfg,
+		preDialHook: h.preDialHook,
+		failover:    endpoints.NewFailover(len(cfg.GetEndpoints()), cfg.GetFailbackInterval()),
+		metrics:     h.metrics,
+	}
+}
+
 // GetConfig is used to get the configuration for the connection handler.
 func (h *WebSocketConnHandlerImpl) GetConfig() config.WebSocketConfig {
 	h.Lock()
@@ -171,5 +239,11 @@ func (h *WebSocketConnHandlerImpl) SetConfig(cfg config.WebSocketConfig) {
 	h.Lock()
 	defer h.Unlock()
 
+	// Only reset the failover if the endpoints changed, since the WSS endpoint may be updated
+	// before each connection.
+	if len(cfg.GetEndpoints()) != h.failover.Size() || cfg.GetFailbackInterval() != h.cfg.GetFailbackInterval() {
+		h.failover = endpoints.NewFailover(len(cfg.GetEndpoints()), cfg.GetFailbackInterval())
+	}
+
 	h.cfg = cfg
 }
@@ -0,0 +1,146 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	wserrors "github.com/skip-mev/slinky/providers/base/websocket/errors"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	mockmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics/mocks"
+)
+
+func TestWebSocketConnHandlerFailover(t *testing.T) {
+	t.Run("dials the primary endpoint", func(t *testing.T) {
+		primary := newWebSocketTestServer()
+		defer primary.Close()
+		fallback := newWebSocketTestServer()
+		defer fallback.Close()
+
+		m := mockmetrics.NewWebSocketMetrics(t)
+		m.On("SetActiveEndpoint", "test", webSocketTestLabel(primary)).Once()
+
+		handler, err := handlers.NewWebSocketHandlerImpl(
+			webSocketTestConfig(webSocketTestURL(primary), webSocketTestURL(fallback)),
+			handlers.WithMetrics(m),
+		)
+		require.NoError(t, err)
+		require.NoError(t, handler.Dial())
+		require.NoError(t, handler.Close())
+	})
+
+	t.Run("fails over to the fallback endpoint if the primary endpoint is unreachable", func(t *testing.T) {
+		primary := newWebSocketTestServer()
+		primary.Close()
+		fallback := newWebSocketTestServer()
+		defer fallback.Close()
+
+		m := mockmetrics.NewWebSocketMetrics(t)
+		m.On("SetActiveEndpoint", "test", webSocketTestLabel(fallback)).Twice()
+
+		handler, err := handlers.NewWebSocketHandlerImpl(
+			webSocketTestConfig(webSocketTestURL(primary), webSocketTestURL(fallback)),
+			handlers.WithMetrics(m),
+		)
+		require.NoError(t, err)
+		require.NoError(t, handler.Dial())
+		require.NoError(t, handler.Close())
+
+		// The fallback endpoint remains active until the failback interval elapses.
+		require.NoError(t, handler.Dial())
+		require.NoError(t, handler.Close())
+	})
+
+	t.Run("fails back to the primary endpoint after the failback interval", func(t *testing.T) {
+		primaryListener := newWebSocketTestServer()
+		primaryURL := webSocketTestURL(primaryListener)
+		primaryListener.Close()
+		fallback := newWebSocketTestServer()
+		defer fallback.Close()
+
+		cfg := webSocketTestConfig(primaryURL, webSocketTestURL(fallback))
+		cfg.FailbackInterval = 50 * time.Millisecond
+
+		handler, err := handlers.NewWebSocketHandlerImpl(cfg)
+		require.NoError(t, err)
+		require.NoError(t, handler.Dial())
+
+		time.Sleep(cfg.FailbackInterval)
+		_, err = handler.Read()
+		require.ErrorIs(t, err, wserrors.ErrFailback)
+		require.NoError(t, handler.Close())
+	})
+
+	t.Run("returns an error if every endpoint is unreachable", func(t *testing.T) {
+		primary := newWebSocketTestServer()
+		primary.Close()
+		fallback := newWebSocketTestServer()
+		fallback.Close()
+
+		handler, err := handlers.NewWebSocketHandlerImpl(
+			webSocketTestConfig(webSocketTestURL(primary), webSocketTestURL(fallback)),
+		)
+		require.NoError(t, err)
+
+		err = handler.Dial()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), webSocketTestLabel(primary))
+		require.Contains(t, err.Error(), webSocketTestLabel(fallback))
+	})
+}
+
+// webSocketTestConfig returns a websocket config that connects to the given endpoint, and fails
+// over to the given fallback endpoint.
+func webSocketTestConfig(wss, fallbackWSS string) config.WebSocketConfig {
+	return config.WebSocketConfig{
+		Enabled:                       true,
+		MaxBufferSize:                 1,
+		ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+		Name:                          "test",
+		WSS:                           wss,
+		FallbackWSS:                   []string{fallbackWSS},
+		ReadBufferSize:                config.DefaultReadBufferSize,
+		WriteBufferSize:               config.DefaultWriteBufferSize,
+		HandshakeTimeout:              config.DefaultHandshakeTimeout,
+		EnableCompression:             config.DefaultEnableCompression,
+		ReadTimeout:                   config.DefaultReadTimeout,
+		WriteTimeout:                  config.DefaultWriteTimeout,
+		MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+		MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+	}
+}
+
+// newWebSocketTestServer returns a server that accepts websocket connections and discards any
+// messages it receives.
+func newWebSocketTestServer() *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+// webSocketTestURL returns the websocket endpoint of the given server.
+func webSocketTestURL(srv *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+// webSocketTestLabel returns the label of the websocket endpoint of the given server.
+func webSocketTestLabel(srv *httptest.Server) string {
+	return strings.TrimPrefix(srv.URL, "http://")
+}
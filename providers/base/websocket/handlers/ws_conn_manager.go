@@ -0,0 +1,595 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+// ConnManagerOption is a function that is used to configure a WebSocketConnManager.
+type ConnManagerOption[K providertypes.ResponseKey, V providertypes.ResponseValue] func(*WebSocketConnManager[K, V])
+
+// WithDisconnectHook is an option that is used to set a function that is called whenever a
+// connection to the data provider is closed while the connection manager is running.
+func WithDisconnectHook[K providertypes.ResponseKey, V providertypes.ResponseValue](hook func()) ConnManagerOption[K, V] {
+	return func(m *WebSocketConnManager[K, V]) {
+		if hook == nil {
+			panic("disconnect hook cannot be nil")
+		}
+
+		m.onDisconnect = hook
+	}
+}
+
+// ConnectionStatus is the status of a single connection to the data provider.
+type ConnectionStatus[K providertypes.ResponseKey] struct {
+	// IDs is the set of IDs that the connection is subscribed to.
+	IDs []K
+
+	// Healthy is true if the connection is established and has received data since.
+	Healthy bool
+
+	// Failures is the number of consecutive connections that closed without receiving data.
+	Failures int
+
+	// LastUpdate is the last time data was received for each of the IDs of the connection.
+	// IDs that have not received any data are omitted.
+	LastUpdate map[K]time.Time
+}
+
+// WebSocketConnManager manages the connections of a websocket provider. The IDs of the provider
+// are deterministically sharded across connections such that each connection is subscribed to at
+// most MaxSubscriptionsPerConnection IDs. Each connection is run by its own query handler, the
+// first of which is the given query handler and the rest of which are copies of it.
+//
+// Connections that close are re-established after a delay, which starts at ReconnectionTimeout
+// and doubles with each consecutive failed connection (i.e. one that closed without receiving
+// any data) up to MaxReconnectionTimeout. Each delay is jittered. Once a connection has failed
+// MaxConnectionFailures times in a row, its subscriptions are moved to the healthy connections of
+// the provider that have capacity for them, which are re-established with the additional
+// subscriptions. Subscriptions that do not fit on the healthy connections are moved to an idle
+// connection, or to a new connection if there is none. A connection that has given away all of
+// its subscriptions stays idle until subscriptions are moved to it.
+type WebSocketConnManager[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	logger  *zap.Logger
+	metrics metrics.WebSocketMetrics
+	config  config.WebSocketConfig
+
+	// handler is the query handler of the first connection. The query handlers of the other
+	// connections are copies of it.
+	handler WebSocketQueryHandler[K, V]
+
+	// onDisconnect is called whenever a connection is closed while the manager is running.
+	onDisconnect func()
+
+	// mtx guards conns, lastUpdate and start.
+	mtx sync.Mutex
+
+	// conns are the connections that are currently managed.
+	conns []*managedConn[K, V]
+
+	// start runs a connection that is opened while the manager is running.
+	start func(*managedConn[K, V])
+
+	// lastUpdate is the last time data was received for each ID.
+	lastUpdate map[K]time.Time
+}
+
+// managedConn is a single connection to the data provider.
+type managedConn[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	// label identifies the connection in logs and metrics.
+	label string
+
+	// handler is the query handler that runs the connection.
+	handler WebSocketQueryHandler[K, V]
+
+	// ids is the set of IDs that the connection is subscribed to.
+	ids []K
+
+	// failures is the number of consecutive connections that closed without receiving data.
+	failures int
+
+	// healthy is true if the connection is established and has received data since.
+	healthy bool
+
+	// cancel closes the connection. This is nil if the connection is not established.
+	cancel context.CancelFunc
+
+	// resubscribe is true if the connection was closed to update its subscriptions, in which
+	// case it is re-established immediately.
+	resubscribe bool
+
+	// assigned is signalled when subscriptions are moved to the connection while it is idle.
+	assigned chan struct{}
+}
+
+// newManagedConn returns a new connection with the given label, query handler and IDs.
+func newManagedConn[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	label string,
+	handler WebSocketQueryHandler[K, V],
+	ids []K,
+) *managedConn[K, V] {
+	return &managedConn[K, V]{
+		label:    label,
+		handler:  handler,
+		ids:      ids,
+		assigned: make(chan struct{}, 1),
+	}
+}
+
+// NewWebSocketConnManager returns a new connection manager that runs the connections of a
+// websocket provider with the given query handler.
+func NewWebSocketConnManager[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	logger *zap.Logger,
+	cfg config.WebSocketConfig,
+	handler WebSocketQueryHandler[K, V],
+	m metrics.WebSocketMetrics,
+	opts ...ConnManagerOption[K, V],
+) (*WebSocketConnManager[K, V], error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger is nil")
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("websocket query handler is nil")
+	}
+
+	if m == nil {
+		return nil, fmt.Errorf("websocket metrics is nil")
+	}
+
+	manager := &WebSocketConnManager[K, V]{
+		logger:     logger.With(zap.String("web_socket_conn_manager", cfg.Name)),
+		metrics:    m,
+		config:     cfg,
+		handler:    handler,
+		lastUpdate: make(map[K]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(manager)
+	}
+
+	return manager, nil
+}
+
+// Start shards the given IDs across connections to the data provider and runs the connections
+// until the context is cancelled. All responses are sent to the response channel. This returns
+// immediately if there are no IDs.
+func (m *WebSocketConnManager[K, V]) Start(
+	ctx context.Context,
+	ids []K,
+	responseCh chan<- providertypes.GetResponse[K, V],
+) error {
+	if err := ctx.Err(); err != nil {
+		m.logger.Info("websocket connection manager stopped via context")
+		return err
+	}
+
+	shards := ShardIDs(ids, m.config.MaxSubscriptionsPerConnection)
+	if len(shards) == 0 {
+		m.logger.Debug("no ids to subscribe to")
+		return nil
+	}
+
+	m.mtx.Lock()
+	m.conns = make([]*managedConn[K, V], len(shards))
+	m.lastUpdate = make(map[K]time.Time)
+	for i, shard := range shards {
+		handler := m.handler
+		if i > 0 {
+			handler = m.handler.Copy()
+		}
+
+		m.conns[i] = newManagedConn(strconv.Itoa(i), handler, shard)
+		m.metrics.SetWebSocketConnectionSubscriptions(m.config.Name, m.conns[i].label, len(shard))
+		m.metrics.SetWebSocketConnectionHealth(m.config.Name, m.conns[i].label, false)
+	}
+	conns := m.conns
+
+	// Connections opened while rebalancing are run alongside the initial connections.
+	wg := errgroup.Group{}
+	m.start = func(conn *managedConn[K, V]) {
+		wg.Go(func() error {
+			return m.run(ctx, conn, responseCh)
+		})
+	}
+	m.mtx.Unlock()
+
+	m.logger.Info(
+		"starting websocket connections",
+		zap.Int("num_connections", len(conns)),
+		zap.Int("num_ids", len(ids)),
+	)
+
+	for _, conn := range conns {
+		conn := conn
+		wg.Go(func() error {
+			return m.run(ctx, conn, responseCh)
+		})
+	}
+
+	// Wait for all the connections to close.
+	err := wg.Wait()
+
+	m.mtx.Lock()
+	m.start = nil
+	for _, conn := range m.conns {
+		m.metrics.SetWebSocketConnectionSubscriptions(m.config.Name, conn.label, 0)
+		m.metrics.SetWebSocketConnectionHealth(m.config.Name, conn.label, false)
+	}
+	m.mtx.Unlock()
+
+	return err
+}
+
+// Connections returns the status of each of the connections that are currently managed.
+func (m *WebSocketConnManager[K, V]) Connections() []ConnectionStatus[K] {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	statuses := make([]ConnectionStatus[K], len(m.conns))
+	for i, conn := range m.conns {
+		lastUpdate := make(map[K]time.Time)
+		for _, id := range conn.ids {
+			if t, ok := m.lastUpdate[id]; ok {
+				lastUpdate[id] = t
+			}
+		}
+
+		statuses[i] = ConnectionStatus[K]{
+			IDs:        slices.Clone(conn.ids),
+			Healthy:    conn.healthy,
+			Failures:   conn.failures,
+			LastUpdate: lastUpdate,
+		}
+	}
+
+	return statuses
+}
+
+// run runs the given connection until the context is cancelled. While the connection does not
+// have any subscriptions, it stays idle until subscriptions are moved to it.
+func (m *WebSocketConnManager[K, V]) run(
+	ctx context.Context,
+	conn *managedConn[K, V],
+	responseCh chan<- providertypes.GetResponse[K, V],
+) error {
+	logger := m.logger.With(zap.String("connection", conn.label))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("websocket connection stopped via context")
+			return ctx.Err()
+		default:
+		}
+
+		connCtx, ids := m.connect(ctx, conn)
+		if len(ids) == 0 {
+			logger.Info("websocket connection has no subscriptions; waiting for subscriptions")
+			select {
+			case <-ctx.Done():
+				logger.Info("websocket connection stopped via context")
+				return ctx.Err()
+			case <-conn.assigned:
+			}
+
+			continue
+		}
+
+		logger.Debug("starting websocket query handler", zap.Int("num_ids", len(ids)))
+		if err := m.subscribe(ctx, connCtx, conn, ids, responseCh); err != nil {
+			logger.Error("websocket query handler returned error", zap.Error(err))
+		}
+
+		if ctx.Err() != nil {
+			logger.Info("websocket connection stopped via context")
+			return ctx.Err()
+		}
+
+		delay, reconnect := m.disconnect(conn)
+		if !reconnect {
+			logger.Debug("resubscribing websocket connection")
+			continue
+		}
+
+		// Wait for a bit before trying to reconnect.
+		logger.Debug("reconnecting websocket connection", zap.Duration("delay", delay))
+		select {
+		case <-ctx.Done():
+			logger.Info("websocket connection stopped via context")
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// connect marks the given connection as established and returns the context that closes it,
+// along with the IDs it is subscribed to. No IDs are returned if the connection does not have
+// any subscriptions.
+func (m *WebSocketConnManager[K, V]) connect(ctx context.Context, conn *managedConn[K, V]) (context.Context, []K) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if len(conn.ids) == 0 {
+		return ctx, nil
+	}
+
+	connCtx, cancel := context.WithCancel(ctx)
+	conn.cancel = cancel
+
+	return connCtx, slices.Clone(conn.ids)
+}
+
+// subscribe starts the query handler of the given connection with the given IDs, and blocks
+// until it returns. The responses of the query handler are recorded before they are sent to
+// the response channel.
+func (m *WebSocketConnManager[K, V]) subscribe(
+	ctx context.Context,
+	connCtx context.Context,
+	conn *managedConn[K, V],
+	ids []K,
+	responseCh chan<- providertypes.GetResponse[K, V],
+) error {
+	connCh := make(chan providertypes.GetResponse[K, V], cap(responseCh))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for response := range connCh {
+			m.recordResponse(conn, response)
+
+			select {
+			case responseCh <- response:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	err := conn.handler.Start(connCtx, ids, connCh)
+	close(connCh)
+	<-done
+
+	return err
+}
+
+// recordResponse records the time at which data was received for each of the resolved IDs of
+// the given response. The connection is healthy once it receives data.
+func (m *WebSocketConnManager[K, V]) recordResponse(conn *managedConn[K, V], response providertypes.GetResponse[K, V]) {
+	if len(response.Resolved) == 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for id := range response.Resolved {
+		m.lastUpdate[id] = now
+	}
+
+	if !conn.healthy {
+		conn.healthy = true
+		conn.failures = 0
+		m.metrics.SetWebSocketConnectionHealth(m.config.Name, conn.label, true)
+	}
+	m.metrics.SetWebSocketConnectionLastUpdated(m.config.Name, conn.label)
+}
+
+// disconnect marks the given connection as closed. If the connection was closed to update its
+// subscriptions, it is re-established immediately. Otherwise, this returns the delay before the
+// connection is re-established, and moves its subscriptions to healthy connections if it failed
+// too many times in a row.
+func (m *WebSocketConnManager[K, V]) disconnect(conn *managedConn[K, V]) (time.Duration, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	conn.cancel()
+	conn.cancel = nil
+
+	healthy := conn.healthy
+	conn.healthy = false
+	m.metrics.SetWebSocketConnectionHealth(m.config.Name, conn.label, false)
+
+	if conn.resubscribe {
+		conn.resubscribe = false
+		return 0, false
+	}
+
+	if !healthy {
+		conn.failures++
+	}
+
+	m.metrics.AddWebSocketReconnection(m.config.Name, conn.label)
+	if m.onDisconnect != nil {
+		m.onDisconnect()
+	}
+
+	if conn.failures >= m.config.GetMaxConnectionFailures() {
+		m.rebalance(conn)
+	}
+
+	return m.backoff(conn.failures), true
+}
+
+// rebalance moves the subscriptions of the given failing connection to the healthy connections
+// that have capacity for them, starting with the connections with the fewest subscriptions. The
+// connections that receive subscriptions are re-established. Subscriptions that do not fit on
+// a healthy connection are moved to an idle connection, or to a new connection if none is idle,
+// such that the failing connection is left idle. This must be called with the lock held.
+func (m *WebSocketConnManager[K, V]) rebalance(failing *managedConn[K, V]) {
+	var targets []*managedConn[K, V]
+	for _, conn := range m.conns {
+		if conn != failing && conn.healthy && conn.cancel != nil {
+			targets = append(targets, conn)
+		}
+	}
+
+	if len(targets) == 0 {
+		m.logger.Warn(
+			"no healthy websocket connections to move subscriptions to",
+			zap.String("connection", failing.label),
+			zap.Int("failures", failing.failures),
+		)
+		return
+	}
+
+	maxSubsPerConn := m.config.MaxSubscriptionsPerConnection
+	moved := make(map[*managedConn[K, V]]int)
+	for len(failing.ids) > 0 {
+		var target *managedConn[K, V]
+		for _, conn := range targets {
+			if maxSubsPerConn > 0 && len(conn.ids) >= maxSubsPerConn {
+				continue
+			}
+
+			if target == nil || len(conn.ids) < len(target.ids) {
+				target = conn
+			}
+		}
+
+		if target == nil {
+			break
+		}
+
+		target.ids = append(target.ids, failing.ids[0])
+		failing.ids = failing.ids[1:]
+		moved[target]++
+	}
+
+	for _, conn := range targets {
+		if moved[conn] == 0 {
+			continue
+		}
+
+		slices.SortFunc(conn.ids, compareIDs[K])
+		conn.resubscribe = true
+		conn.cancel()
+
+		m.metrics.SetWebSocketConnectionSubscriptions(m.config.Name, conn.label, len(conn.ids))
+		m.logger.Info(
+			"moved subscriptions of failing websocket connection",
+			zap.String("from", failing.label),
+			zap.String("to", conn.label),
+			zap.Int("num_ids", moved[conn]),
+		)
+	}
+
+	if len(failing.ids) > 0 {
+		m.reassign(failing)
+	}
+
+	m.metrics.SetWebSocketConnectionSubscriptions(m.config.Name, failing.label, len(failing.ids))
+}
+
+// reassign moves all of the subscriptions of the given failing connection to an idle connection,
+// which is woken up, or to a new connection if none of the connections are idle. This must be
+// called with the lock held.
+func (m *WebSocketConnManager[K, V]) reassign(failing *managedConn[K, V]) {
+	var target *managedConn[K, V]
+	for _, conn := range m.conns {
+		if conn != failing && len(conn.ids) == 0 {
+			target = conn
+			break
+		}
+	}
+
+	opened := target == nil
+	if opened {
+		if m.start == nil {
+			return
+		}
+
+		target = newManagedConn[K, V](strconv.Itoa(len(m.conns)), m.handler.Copy(), nil)
+		m.conns = append(m.conns, target)
+		m.metrics.SetWebSocketConnectionHealth(m.config.Name, target.label, false)
+	}
+
+	target.ids = failing.ids
+	target.failures = 0
+	failing.ids = nil
+
+	m.metrics.SetWebSocketConnectionSubscriptions(m.config.Name, target.label, len(target.ids))
+	m.logger.Info(
+		"moved subscriptions of failing websocket connection",
+		zap.String("from", failing.label),
+		zap.String("to", target.label),
+		zap.Int("num_ids", len(target.ids)),
+		zap.Bool("new_connection", opened),
+	)
+
+	if opened {
+		m.start(target)
+		return
+	}
+
+	select {
+	case target.assigned <- struct{}{}:
+	default:
+	}
+}
+
+// backoff returns the delay before a connection with the given number of consecutive failures
+// is re-established. The delay doubles with each failure up to the maximum reconnection timeout,
+// and is jittered such that it is between half and all of that value.
+func (m *WebSocketConnManager[K, V]) backoff(failures int) time.Duration {
+	maxTimeout := m.config.GetMaxReconnectionTimeout()
+
+	timeout := m.config.ReconnectionTimeout
+	for i := 0; i < failures && timeout < maxTimeout; i++ {
+		timeout *= 2
+	}
+	timeout = min(timeout, maxTimeout)
+
+	half := timeout / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec
+}
+
+// ShardIDs splits the given IDs into the subscriptions of each connection to a data provider,
+// such that each connection is subscribed to at most maxSubsPerConn IDs. The IDs are sorted and
+// de-duplicated first, such that the same set of IDs is always split the same way. If
+// maxSubsPerConn is zero, a single connection is subscribed to all of the IDs.
+func ShardIDs[K providertypes.ResponseKey](ids []K, maxSubsPerConn int) [][]K {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(ids)
+	slices.SortFunc(sorted, compareIDs[K])
+	sorted = slices.Compact(sorted)
+
+	if maxSubsPerConn <= 0 {
+		return [][]K{sorted}
+	}
+
+	shards := make([][]K, 0, (len(sorted)+maxSubsPerConn-1)/maxSubsPerConn)
+	for start := 0; start < len(sorted); start += maxSubsPerConn {
+		end := min(start+maxSubsPerConn, len(sorted))
+		shards = append(shards, sorted[start:end:end])
+	}
+
+	return shards
+}
+
+// compareIDs orders IDs by their string representation.
+func compareIDs[K providertypes.ResponseKey](a, b K) int {
+	return strings.Compare(a.String(), b.String())
+}
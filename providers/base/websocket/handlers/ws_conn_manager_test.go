@@ -0,0 +1,353 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	handlermocks "github.com/skip-mev/slinky/providers/base/websocket/handlers/mocks"
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestShardIDs(t *testing.T) {
+	solusd := oracletypes.NewCurrencyPair("SOL", "USD")
+
+	testCases := []struct {
+		name           string
+		ids            []oracletypes.CurrencyPair
+		maxSubsPerConn int
+		expected       [][]oracletypes.CurrencyPair
+	}{
+		{
+			name:           "no ids",
+			ids:            nil,
+			maxSubsPerConn: 2,
+			expected:       nil,
+		},
+		{
+			name:           "single connection if there is no max",
+			ids:            []oracletypes.CurrencyPair{ethusd, btcusd, atomusd},
+			maxSubsPerConn: 0,
+			expected: [][]oracletypes.CurrencyPair{
+				{atomusd, btcusd, ethusd},
+			},
+		},
+		{
+			name:           "single connection if the ids fit",
+			ids:            []oracletypes.CurrencyPair{ethusd, btcusd},
+			maxSubsPerConn: 2,
+			expected: [][]oracletypes.CurrencyPair{
+				{btcusd, ethusd},
+			},
+		},
+		{
+			name:           "shards do not overlap",
+			ids:            []oracletypes.CurrencyPair{atomusd, btcusd, ethusd},
+			maxSubsPerConn: 2,
+			expected: [][]oracletypes.CurrencyPair{
+				{atomusd, btcusd},
+				{ethusd},
+			},
+		},
+		{
+			name:           "one id per connection",
+			ids:            []oracletypes.CurrencyPair{solusd, ethusd, btcusd, atomusd},
+			maxSubsPerConn: 1,
+			expected: [][]oracletypes.CurrencyPair{
+				{atomusd},
+				{btcusd},
+				{ethusd},
+				{solusd},
+			},
+		},
+		{
+			name:           "shards are independent of the order of the ids",
+			ids:            []oracletypes.CurrencyPair{solusd, ethusd, atomusd, btcusd},
+			maxSubsPerConn: 2,
+			expected: [][]oracletypes.CurrencyPair{
+				{atomusd, btcusd},
+				{ethusd, solusd},
+			},
+		},
+		{
+			name:           "every shard is full",
+			ids:            []oracletypes.CurrencyPair{solusd, ethusd, btcusd, atomusd},
+			maxSubsPerConn: 2,
+			expected: [][]oracletypes.CurrencyPair{
+				{atomusd, btcusd},
+				{ethusd, solusd},
+			},
+		},
+		{
+			name:           "duplicate ids are dropped",
+			ids:            []oracletypes.CurrencyPair{btcusd, atomusd, btcusd},
+			maxSubsPerConn: 1,
+			expected: [][]oracletypes.CurrencyPair{
+				{atomusd},
+				{btcusd},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shards := handlers.ShardIDs(tc.ids, tc.maxSubsPerConn)
+			require.Equal(t, tc.expected, shards)
+		})
+	}
+}
+
+func TestWebSocketConnManager(t *testing.T) {
+	t.Run("returns immediately with no ids", func(t *testing.T) {
+		handler := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		manager := newConnManager(t, connManagerTestConfig(1, 3), handler, nil)
+
+		responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], 10)
+		require.NoError(t, manager.Start(context.Background(), nil, responseCh))
+	})
+
+	t.Run("runs each shard on a copy of the query handler", func(t *testing.T) {
+		handler := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		healthyQueryHandler(handler, nil)
+
+		copied := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		healthyQueryHandler(copied, nil)
+		handler.On("Copy").Return(copied).Once()
+
+		manager := newConnManager(t, connManagerTestConfig(2, 3), handler, nil)
+		responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], 10)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- manager.Start(ctx, []oracletypes.CurrencyPair{ethusd, btcusd, atomusd}, responseCh)
+		}()
+
+		require.Eventually(t, func() bool {
+			conns := manager.Connections()
+			return len(conns) == 2 && conns[0].Healthy && conns[1].Healthy
+		}, 5*time.Second, 10*time.Millisecond)
+
+		conns := manager.Connections()
+		require.Equal(t, []oracletypes.CurrencyPair{atomusd, btcusd}, conns[0].IDs)
+		require.Equal(t, []oracletypes.CurrencyPair{ethusd}, conns[1].IDs)
+		require.Contains(t, conns[0].LastUpdate, btcusd)
+		require.Contains(t, conns[1].LastUpdate, ethusd)
+		require.Eventually(t, func() bool {
+			return len(responseCh) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		cancel()
+		require.Equal(t, context.Canceled, <-errCh)
+	})
+
+	t.Run("reconnects failing connections with backoff", func(t *testing.T) {
+		cfg := connManagerTestConfig(0, 3)
+		cfg.ReconnectionTimeout = 10 * time.Millisecond
+		cfg.MaxReconnectionTimeout = 40 * time.Millisecond
+
+		var starts atomic.Int64
+		handler := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("dial failed")).Run(func(_ mock.Arguments) {
+			starts.Add(1)
+		})
+
+		var disconnects atomic.Int64
+		manager := newConnManager(t, cfg, handler, func() {
+			disconnects.Add(1)
+		})
+		responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], 10)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		err := manager.Start(ctx, []oracletypes.CurrencyPair{btcusd}, responseCh)
+		require.Equal(t, context.DeadlineExceeded, err)
+
+		// The delay is capped at the max reconnection timeout, so the connection is retried
+		// several times, but far fewer than it would be without any backoff.
+		require.GreaterOrEqual(t, starts.Load(), int64(5))
+		require.Less(t, starts.Load(), int64(50))
+
+		// The last connection may be closed by the context rather than the data provider.
+		require.InDelta(t, starts.Load(), disconnects.Load(), 1)
+
+		conns := manager.Connections()
+		require.Len(t, conns, 1)
+		require.False(t, conns[0].Healthy)
+		require.Equal(t, int(disconnects.Load()), conns[0].Failures)
+	})
+
+	t.Run("moves subscriptions of a failing connection to healthy connections", func(t *testing.T) {
+		cfg := connManagerTestConfig(2, 2)
+		cfg.ReconnectionTimeout = 10 * time.Millisecond
+		cfg.MaxReconnectionTimeout = 20 * time.Millisecond
+
+		handler := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("dial failed"))
+
+		subscriptions := make(chan []oracletypes.CurrencyPair, 100)
+		copied := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		healthyQueryHandler(copied, subscriptions)
+		handler.On("Copy").Return(copied).Once()
+
+		opened := make(chan []oracletypes.CurrencyPair, 100)
+		copiedOpened := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		healthyQueryHandler(copiedOpened, opened)
+		handler.On("Copy").Return(copiedOpened).Once()
+
+		manager := newConnManager(t, cfg, handler, nil)
+		responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], 100)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- manager.Start(ctx, []oracletypes.CurrencyPair{atomusd, btcusd, ethusd}, responseCh)
+		}()
+
+		// The healthy connection only has capacity for one more subscription, so the rest are
+		// moved to a new connection and the failing connection is left idle.
+		require.Eventually(t, func() bool {
+			conns := manager.Connections()
+			return len(conns) == 3 && len(conns[1].IDs) == 2 && conns[2].Healthy
+		}, 5*time.Second, 10*time.Millisecond)
+
+		conns := manager.Connections()
+		require.Empty(t, conns[0].IDs)
+		require.Equal(t, []oracletypes.CurrencyPair{atomusd, ethusd}, conns[1].IDs)
+		require.Equal(t, []oracletypes.CurrencyPair{btcusd}, conns[2].IDs)
+
+		// The healthy connection is re-established with the additional subscription.
+		require.Equal(t, []oracletypes.CurrencyPair{ethusd}, <-subscriptions)
+		require.Equal(t, []oracletypes.CurrencyPair{atomusd, ethusd}, <-subscriptions)
+		require.Equal(t, []oracletypes.CurrencyPair{btcusd}, <-opened)
+
+		cancel()
+		require.Equal(t, context.Canceled, <-errCh)
+	})
+
+	t.Run("moves subscriptions of a failing connection when every shard is full", func(t *testing.T) {
+		cfg := connManagerTestConfig(1, 2)
+		cfg.ReconnectionTimeout = 10 * time.Millisecond
+		cfg.MaxReconnectionTimeout = 20 * time.Millisecond
+
+		handler := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("dial failed"))
+
+		// The first two copies run the initial shards, one of which fails, and the third runs
+		// the connection that is opened for the subscriptions of the first failing connection.
+		failing := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		failing.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("dial failed"))
+		healthy := handlermocks.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](t)
+		healthyQueryHandler(healthy, nil)
+		handler.On("Copy").Return(healthy).Once()
+		handler.On("Copy").Return(failing).Once()
+		handler.On("Copy").Return(healthy).Once()
+
+		manager := newConnManager(t, cfg, handler, nil)
+		responseCh := make(chan providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], 100)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- manager.Start(ctx, []oracletypes.CurrencyPair{atomusd, btcusd, ethusd}, responseCh)
+		}()
+
+		// Whichever failing connection gives up first while the healthy connection is full has
+		// its subscription moved to a new connection.
+		require.Eventually(t, func() bool {
+			conns := manager.Connections()
+			return len(conns) == 4 && conns[3].Healthy
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// From then on, the subscription left on the failing connections is moved back and
+		// forth between them, since the emptied connection is reused rather than closed.
+		require.Eventually(t, func() bool {
+			conns := manager.Connections()
+			return len(conns[0].IDs) == 1 && len(conns[2].IDs) == 0
+		}, 5*time.Second, 5*time.Millisecond)
+		require.Eventually(t, func() bool {
+			conns := manager.Connections()
+			return len(conns[0].IDs) == 0 && len(conns[2].IDs) == 1
+		}, 5*time.Second, 5*time.Millisecond)
+
+		cancel()
+		require.Equal(t, context.Canceled, <-errCh)
+
+		// Subscriptions are only ever moved between the connections, and no more connections
+		// are opened once a connection is idle.
+		conns := manager.Connections()
+		require.Len(t, conns, 4)
+		var ids []oracletypes.CurrencyPair
+		for _, conn := range conns {
+			ids = append(ids, conn.IDs...)
+		}
+		require.ElementsMatch(t, []oracletypes.CurrencyPair{atomusd, btcusd, ethusd}, ids)
+	})
+}
+
+// connManagerTestConfig returns a websocket config with the given max subscriptions per
+// connection and max connection failures.
+func connManagerTestConfig(maxSubsPerConn, maxFailures int) config.WebSocketConfig {
+	wsCfg := cfg
+	wsCfg.MaxSubscriptionsPerConnection = maxSubsPerConn
+	wsCfg.MaxConnectionFailures = maxFailures
+	return wsCfg
+}
+
+// newConnManager returns a new connection manager with the given query handler and an optional
+// disconnect hook.
+func newConnManager(
+	t *testing.T,
+	wsCfg config.WebSocketConfig,
+	handler handlers.WebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int],
+	onDisconnect func(),
+) *handlers.WebSocketConnManager[oracletypes.CurrencyPair, *big.Int] {
+	t.Helper()
+
+	var opts []handlers.ConnManagerOption[oracletypes.CurrencyPair, *big.Int]
+	if onDisconnect != nil {
+		opts = append(opts, handlers.WithDisconnectHook[oracletypes.CurrencyPair, *big.Int](onDisconnect))
+	}
+
+	manager, err := handlers.NewWebSocketConnManager(logger, wsCfg, handler, metrics.NewNopWebSocketMetrics(), opts...)
+	require.NoError(t, err)
+
+	return manager
+}
+
+// healthyQueryHandler configures the given mock query handler to resolve each of the IDs it is
+// started with, and to stay connected until its context is cancelled. The IDs are sent to the
+// subscriptions channel if it is not nil.
+func healthyQueryHandler(
+	handler *handlermocks.WebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int],
+	subscriptions chan<- []oracletypes.CurrencyPair,
+) {
+	handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		ctx := args.Get(0).(context.Context)
+		ids := args.Get(1).([]oracletypes.CurrencyPair)
+		responseCh := args.Get(2).(chan<- providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int])
+
+		if subscriptions != nil {
+			subscriptions <- ids
+		}
+
+		resolved := make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
+		for _, id := range ids {
+			resolved[id] = providertypes.NewResult[*big.Int](big.NewInt(1), time.Now())
+		}
+		responseCh <- providertypes.NewGetResponse(resolved, nil)
+
+		<-ctx.Done()
+	})
+}
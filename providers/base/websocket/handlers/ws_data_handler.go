@@ -25,4 +25,9 @@ type WebSocketDataHandler[K providertypes.ResponseKey, V providertypes.ResponseV
 	// the handler must maintain the necessary state information to construct the heartbeat messages. This
 	// can be done on the fly as messages as handled by the handler.
 	HeartBeatMessages() ([]WebsocketEncodedMessage, error)
+
+	// Copy is used to create a copy of the data handler. The copy must not share any state
+	// with the original, such that each connection to the data provider can use its own data
+	// handler.
+	Copy() WebSocketDataHandler[K, V]
 }
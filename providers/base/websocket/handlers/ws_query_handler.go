@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"time"
 
@@ -24,6 +25,10 @@ type WebSocketQueryHandler[K providertypes.ResponseKey, V providertypes.Response
 	// the data (i.e. ids). All websocket responses should be sent to the response
 	// channel.
 	Start(ctx context.Context, ids []K, responseCh chan<- providertypes.GetResponse[K, V]) error
+
+	// Copy should return a copy of the query handler that can be started concurrently with
+	// the original (i.e. with its own connection to the data provider).
+	Copy() WebSocketQueryHandler[K, V]
 }
 
 // WebSocketQueryHandlerImpl is the default websocket implementation of the
@@ -136,6 +141,18 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 	return h.recv(ctx, responseCh)
 }
 
+// Copy is used to create a copy of the query handler with its own connection handler and data
+// handler, such that the copy can be started concurrently with the original.
+func (h *WebSocketQueryHandlerImpl[K, V]) Copy() WebSocketQueryHandler[K, V] {
+	return &WebSocketQueryHandlerImpl[K, V]{
+		logger:      h.logger,
+		config:      h.config,
+		dataHandler: h.dataHandler.Copy(),
+		connHandler: h.connHandler.Copy(),
+		metrics:     h.metrics,
+	}
+}
+
 // start is used to start the connection to the data provider.
 func (h *WebSocketQueryHandlerImpl[K, V]) start() error {
 	// Start the connection.
@@ -246,6 +263,16 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 				h.logger.Error("failed to read message from websocket handler", zap.Error(err))
 				h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.ReadErr)
 
+				// If the connection should be re-established with the primary endpoint, close the
+				// connection and return immediately such that the provider reconnects.
+				if stderrors.Is(err, errors.ErrFailback) {
+					if err := h.close(); err != nil {
+						return err
+					}
+
+					return errors.ErrReadWithErr(err)
+				}
+
 				// If the read error count is greater than the max read error count, close the
 				// connection and return.
 				readErrCount++
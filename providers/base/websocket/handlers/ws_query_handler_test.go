@@ -211,6 +211,45 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			ids:       []oracletypes.CurrencyPair{btcusd},
 			responses: providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int]{},
 		},
+		{
+			name: "closes the websocket to fail back to the primary endpoint",
+			cfg:  cfg,
+			connHandler: func() handlers.WebSocketConnHandler {
+				connHandler := handlermocks.NewWebSocketConnHandler(t)
+
+				connHandler.On("Dial").Return(nil).Once()
+				connHandler.On("Write", mock.Anything).Return(nil).Once()
+				connHandler.On("Read").Return(nil, wserrors.ErrFailback).Once().After(time.Second)
+				connHandler.On("Close").Return(nil).Once()
+
+				return connHandler
+			},
+			dataHandler: func() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+				dataHandler := handlermocks.NewWebSocketDataHandler[oracletypes.CurrencyPair, *big.Int](t)
+
+				dataHandler.On("CreateMessages", mock.Anything).Return([]handlers.WebsocketEncodedMessage{testMessage}, nil).Once()
+
+				return dataHandler
+			},
+			metrics: func() metrics.WebSocketMetrics {
+				m := mockmetrics.NewWebSocketMetrics(t)
+
+				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
+				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
+				m.On("AddWebSocketConnectionStatus", name, metrics.WriteSuccess).Return().Once()
+				m.On("AddWebSocketConnectionStatus", name, metrics.Healthy).Return().Once()
+
+				m.On("AddWebSocketConnectionStatus", name, metrics.ReadErr).Return().Once()
+				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+
+				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Once()
+				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
+
+				return m
+			},
+			ids:       []oracletypes.CurrencyPair{btcusd},
+			responses: providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int]{},
+		},
 		{
 			name: "fails to parse the response from the websocket",
 			cfg:  cfg,
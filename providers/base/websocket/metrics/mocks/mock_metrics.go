@@ -24,11 +24,36 @@ func (_m *WebSocketMetrics) AddWebSocketDataHandlerStatus(provider string, statu
 	_m.Called(provider, status)
 }
 
+// AddWebSocketReconnection provides a mock function with given fields: provider, connection
+func (_m *WebSocketMetrics) AddWebSocketReconnection(provider string, connection string) {
+	_m.Called(provider, connection)
+}
+
 // ObserveWebSocketLatency provides a mock function with given fields: provider, duration
 func (_m *WebSocketMetrics) ObserveWebSocketLatency(provider string, duration time.Duration) {
 	_m.Called(provider, duration)
 }
 
+// SetActiveEndpoint provides a mock function with given fields: provider, endpoint
+func (_m *WebSocketMetrics) SetActiveEndpoint(provider string, endpoint string) {
+	_m.Called(provider, endpoint)
+}
+
+// SetWebSocketConnectionHealth provides a mock function with given fields: provider, connection, healthy
+func (_m *WebSocketMetrics) SetWebSocketConnectionHealth(provider string, connection string, healthy bool) {
+	_m.Called(provider, connection, healthy)
+}
+
+// SetWebSocketConnectionLastUpdated provides a mock function with given fields: provider, connection
+func (_m *WebSocketMetrics) SetWebSocketConnectionLastUpdated(provider string, connection string) {
+	_m.Called(provider, connection)
+}
+
+// SetWebSocketConnectionSubscriptions provides a mock function with given fields: provider, connection, subscriptions
+func (_m *WebSocketMetrics) SetWebSocketConnectionSubscriptions(provider string, connection string, subscriptions int) {
+	_m.Called(provider, connection, subscriptions)
+}
+
 // NewWebSocketMetrics creates a new instance of WebSocketMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketMetrics(t interface {
@@ -13,6 +13,10 @@ import (
 const (
 	// StatusLabel is the label used for the status of a provider response.
 	StatusLabel = "status"
+
+	// ConnectionLabel is the label used for the connection of a provider, which is the index
+	// of the connection amongst the connections of the provider.
+	ConnectionLabel = "connection"
 )
 
 // WebSocketMetrics is an interface that defines the API for metrics collection for providers
@@ -31,6 +35,26 @@ type WebSocketMetrics interface {
 	// ObserveWebSocketLatency adds a latency observation to the metrics collector for the
 	// given provider.
 	ObserveWebSocketLatency(provider string, duration time.Duration)
+
+	// SetActiveEndpoint sets the websocket endpoint that the provider is currently connected
+	// to.
+	SetActiveEndpoint(provider string, endpoint string)
+
+	// AddWebSocketReconnection increments the number of times the given connection of the given
+	// provider was re-established.
+	AddWebSocketReconnection(provider, connection string)
+
+	// SetWebSocketConnectionHealth sets whether the given connection of the given provider is
+	// healthy, i.e. it is connected and has received data since it was established.
+	SetWebSocketConnectionHealth(provider, connection string, healthy bool)
+
+	// SetWebSocketConnectionSubscriptions sets the number of subscriptions (i.e. currency pairs)
+	// that are assigned to the given connection of the given provider.
+	SetWebSocketConnectionSubscriptions(provider, connection string, subscriptions int)
+
+	// SetWebSocketConnectionLastUpdated updates the last time the given connection of the given
+	// provider received data.
+	SetWebSocketConnectionLastUpdated(provider, connection string)
 }
 
 // WebSocketMetricsImpl contains metrics exposed by this package.
@@ -43,6 +67,21 @@ type WebSocketMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	responseTimePerProvider *prometheus.HistogramVec
+
+	// Gauge paginated by provider and endpoint, set to one for the active endpoint.
+	activeEndpointPerProvider *prometheus.GaugeVec
+
+	// Number of reconnections per connection.
+	reconnectionsPerConnection *prometheus.CounterVec
+
+	// Gauge paginated by provider and connection, set to one if the connection is healthy.
+	healthPerConnection *prometheus.GaugeVec
+
+	// Number of subscriptions per connection.
+	subscriptionsPerConnection *prometheus.GaugeVec
+
+	// Last time a given connection received data.
+	lastUpdatedPerConnection *prometheus.GaugeVec
 }
 
 // NewWebSocketMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -72,12 +111,42 @@ func NewWebSocketMetrics() WebSocketMetrics {
 			Help:      "Response time per API provider.",
 			Buckets:   []float64{50, 100, 250, 500, 1000},
 		}, []string{providermetrics.ProviderLabel}),
+		activeEndpointPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_active_endpoint_per_provider",
+			Help:      "The endpoint that a websocket provider is currently connected to.",
+		}, []string{providermetrics.ProviderLabel, providermetrics.EndpointLabel}),
+		reconnectionsPerConnection: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_reconnections_per_connection",
+			Help:      "Number of times a websocket connection was re-established.",
+		}, []string{providermetrics.ProviderLabel, ConnectionLabel}),
+		healthPerConnection: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_connection_health",
+			Help:      "Whether a websocket connection is healthy (1) or not (0).",
+		}, []string{providermetrics.ProviderLabel, ConnectionLabel}),
+		subscriptionsPerConnection: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_subscriptions_per_connection",
+			Help:      "Number of subscriptions assigned to a websocket connection.",
+		}, []string{providermetrics.ProviderLabel, ConnectionLabel}),
+		lastUpdatedPerConnection: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_connection_last_updated",
+			Help:      "Last time a websocket connection received data.",
+		}, []string{providermetrics.ProviderLabel, ConnectionLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.connectionStatusPerProvider)
 	prometheus.MustRegister(m.dataHandlerStatusPerProvider)
 	prometheus.MustRegister(m.responseTimePerProvider)
+	prometheus.MustRegister(m.activeEndpointPerProvider)
+	prometheus.MustRegister(m.reconnectionsPerConnection)
+	prometheus.MustRegister(m.healthPerConnection)
+	prometheus.MustRegister(m.subscriptionsPerConnection)
+	prometheus.MustRegister(m.lastUpdatedPerConnection)
 
 	return m
 }
@@ -98,6 +167,21 @@ func (m *noOpWebSocketMetricsImpl) AddWebSocketDataHandlerStatus(_ string, _ Han
 func (m *noOpWebSocketMetricsImpl) ObserveWebSocketLatency(_ string, _ time.Duration) {
 }
 
+func (m *noOpWebSocketMetricsImpl) SetActiveEndpoint(_ string, _ string) {
+}
+
+func (m *noOpWebSocketMetricsImpl) AddWebSocketReconnection(_, _ string) {
+}
+
+func (m *noOpWebSocketMetricsImpl) SetWebSocketConnectionHealth(_, _ string, _ bool) {
+}
+
+func (m *noOpWebSocketMetricsImpl) SetWebSocketConnectionSubscriptions(_, _ string, _ int) {
+}
+
+func (m *noOpWebSocketMetricsImpl) SetWebSocketConnectionLastUpdated(_, _ string) {
+}
+
 // AddWebSocketConnectionStatus adds a method / status response to the metrics collector for the
 // given provider. Specifically, this tracks various connection related errors.
 func (m *WebSocketMetricsImpl) AddWebSocketConnectionStatus(provider string, status ConnectionStatus) {
@@ -125,3 +209,61 @@ func (m *WebSocketMetricsImpl) ObserveWebSocketLatency(provider string, duration
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// SetActiveEndpoint sets the active endpoint of the given provider to one, and removes the
+// previously active endpoint of the provider.
+func (m *WebSocketMetricsImpl) SetActiveEndpoint(provider string, endpoint string) {
+	m.activeEndpointPerProvider.DeletePartialMatch(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+	})
+	m.activeEndpointPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		providermetrics.EndpointLabel: endpoint,
+	},
+	).Set(1)
+}
+
+// AddWebSocketReconnection increments the number of times the given connection of the given
+// provider was re-established.
+func (m *WebSocketMetricsImpl) AddWebSocketReconnection(provider, connection string) {
+	m.reconnectionsPerConnection.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		ConnectionLabel:               connection,
+	},
+	).Add(1)
+}
+
+// SetWebSocketConnectionHealth sets whether the given connection of the given provider is healthy.
+func (m *WebSocketMetricsImpl) SetWebSocketConnectionHealth(provider, connection string, healthy bool) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+
+	m.healthPerConnection.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		ConnectionLabel:               connection,
+	},
+	).Set(value)
+}
+
+// SetWebSocketConnectionSubscriptions sets the number of subscriptions that are assigned to the
+// given connection of the given provider.
+func (m *WebSocketMetricsImpl) SetWebSocketConnectionSubscriptions(provider, connection string, subscriptions int) {
+	m.subscriptionsPerConnection.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		ConnectionLabel:               connection,
+	},
+	).Set(float64(subscriptions))
+}
+
+// SetWebSocketConnectionLastUpdated updates the last time the given connection of the given
+// provider received data.
+func (m *WebSocketMetricsImpl) SetWebSocketConnectionLastUpdated(provider, connection string) {
+	now := time.Now().UTC()
+	m.lastUpdatedPerConnection.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		ConnectionLabel:               connection,
+	},
+	).Set(float64(now.Unix()))
+}
@@ -0,0 +1,197 @@
+package fakeexchange
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config is the config of the fake exchange server. Each exchange is served under the path of the
+// name of the provider that it fakes, i.e. /binance for the Binance provider.
+type Config struct {
+	// Exchanges is the set of exchanges that are served.
+	Exchanges []ExchangeConfig `mapstructure:"exchanges" toml:"exchanges"`
+}
+
+// ExchangeConfig is the config of a single fake exchange.
+type ExchangeConfig struct {
+	// Name is the name of the provider that the exchange fakes.
+	Name string `mapstructure:"name" toml:"name"`
+
+	// UpdateInterval is the interval at which price updates are pushed to the websocket
+	// connections of the exchange.
+	UpdateInterval time.Duration `mapstructure:"update_interval" toml:"update_interval"`
+
+	// Markets is the set of markets that are quoted by the exchange. Requests and subscriptions
+	// for any other market are ignored.
+	Markets []MarketConfig `mapstructure:"markets" toml:"markets"`
+
+	// Faults is the set of faults that are injected into the traffic of the exchange.
+	Faults FaultConfig `mapstructure:"faults" toml:"faults"`
+}
+
+// MarketConfig is the scripted price path of a single market of an exchange.
+type MarketConfig struct {
+	// Ticker is the ticker of the market, exactly as it is configured in the market config of
+	// the provider i.e. BTCUSDT for Binance or XBT/USD for Kraken.
+	Ticker string `mapstructure:"ticker" toml:"ticker"`
+
+	// Prices is the price path of the market. The price is linearly interpolated between the
+	// points of the path, and is held at the last point once the path has ended.
+	Prices []PricePoint `mapstructure:"prices" toml:"prices"`
+
+	// Loop restarts the price path from the first point once the last point is reached.
+	Loop bool `mapstructure:"loop" toml:"loop"`
+}
+
+// PricePoint is a single point of a price path.
+type PricePoint struct {
+	// Offset is the time since the server was started at which the price is quoted.
+	Offset time.Duration `mapstructure:"offset" toml:"offset"`
+
+	// Price is the price of the market.
+	Price float64 `mapstructure:"price" toml:"price"`
+}
+
+// FaultConfig is the set of faults that are injected into the traffic of an exchange. The zero
+// value injects no faults.
+type FaultConfig struct {
+	// Latency is the delay added before every HTTP response and every websocket frame.
+	Latency time.Duration `mapstructure:"latency" toml:"latency"`
+
+	// RateLimit is the maximum number of HTTP requests, including websocket handshakes, that the
+	// exchange accepts per second. Requests above the limit are rejected with a 429 status code.
+	RateLimit uint64 `mapstructure:"rate_limit" toml:"rate_limit"`
+
+	// MalformedEvery makes every nth price response or price update malformed i.e. truncated.
+	MalformedEvery uint64 `mapstructure:"malformed_every" toml:"malformed_every"`
+
+	// DisconnectAfter closes each websocket connection after the given number of price updates
+	// have been pushed on it.
+	DisconnectAfter uint64 `mapstructure:"disconnect_after" toml:"disconnect_after"`
+}
+
+// ReadConfigFromFile reads the fake exchange config from the TOML file at the given path.
+func ReadConfigFromFile(path string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// ValidateBasic performs basic validation of the fake exchange config.
+func (c Config) ValidateBasic() error {
+	seen := make(map[string]struct{})
+	for _, exchange := range c.Exchanges {
+		if err := exchange.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid exchange %s: %w", exchange.Name, err)
+		}
+
+		if _, ok := seen[exchange.Name]; ok {
+			return fmt.Errorf("duplicate exchange %s", exchange.Name)
+		}
+		seen[exchange.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the exchange config.
+func (c ExchangeConfig) ValidateBasic() error {
+	if _, ok := specs[c.Name]; !ok {
+		return fmt.Errorf("unsupported exchange %s", c.Name)
+	}
+
+	if c.UpdateInterval <= 0 {
+		return fmt.Errorf("update interval must be strictly positive")
+	}
+
+	seen := make(map[string]struct{})
+	for _, market := range c.Markets {
+		if err := market.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid market %s: %w", market.Ticker, err)
+		}
+
+		if _, ok := seen[market.Ticker]; ok {
+			return fmt.Errorf("duplicate market %s", market.Ticker)
+		}
+		seen[market.Ticker] = struct{}{}
+	}
+
+	if c.Faults.Latency < 0 {
+		return fmt.Errorf("latency cannot be negative")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the market config.
+func (c MarketConfig) ValidateBasic() error {
+	if len(c.Ticker) == 0 {
+		return fmt.Errorf("ticker cannot be empty")
+	}
+
+	if len(c.Prices) == 0 {
+		return fmt.Errorf("price path cannot be empty")
+	}
+
+	for i, point := range c.Prices {
+		if point.Price <= 0 {
+			return fmt.Errorf("price must be strictly positive; got %f", point.Price)
+		}
+
+		if point.Offset < 0 {
+			return fmt.Errorf("offset cannot be negative; got %s", point.Offset)
+		}
+
+		if i > 0 && point.Offset < c.Prices[i-1].Offset {
+			return fmt.Errorf("offsets must be in ascending order")
+		}
+	}
+
+	if c.Loop && c.Prices[len(c.Prices)-1].Offset == 0 {
+		return fmt.Errorf("a looped price path must end after a strictly positive offset")
+	}
+
+	return nil
+}
+
+// PriceAt returns the price of the market at the given time since the server was started.
+func (c MarketConfig) PriceAt(elapsed time.Duration) float64 {
+	last := c.Prices[len(c.Prices)-1]
+	if c.Loop {
+		elapsed %= last.Offset
+	}
+
+	if elapsed <= c.Prices[0].Offset {
+		return c.Prices[0].Price
+	}
+
+	for i := 1; i < len(c.Prices); i++ {
+		prev, next := c.Prices[i-1], c.Prices[i]
+		if elapsed >= next.Offset {
+			continue
+		}
+
+		// Linearly interpolate between the two points that surround the elapsed time.
+		fraction := float64(elapsed-prev.Offset) / float64(next.Offset-prev.Offset)
+		return prev.Price + fraction*(next.Price-prev.Price)
+	}
+
+	return last.Price
+}
@@ -0,0 +1,177 @@
+package fakeexchange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/fakeexchange"
+)
+
+func TestExchangeConfig(t *testing.T) {
+	market := fakeexchange.MarketConfig{
+		Ticker: "BTCUSDT",
+		Prices: []fakeexchange.PricePoint{
+			{Offset: 0, Price: 100},
+			{Offset: time.Minute, Price: 200},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		config      fakeexchange.ExchangeConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config",
+			config: fakeexchange.ExchangeConfig{
+				Name:           binance.Name,
+				UpdateInterval: time.Second,
+				Markets:        []fakeexchange.MarketConfig{market},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "unsupported exchange",
+			config: fakeexchange.ExchangeConfig{
+				Name:           "unsupported",
+				UpdateInterval: time.Second,
+				Markets:        []fakeexchange.MarketConfig{market},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no update interval",
+			config: fakeexchange.ExchangeConfig{
+				Name:    binance.Name,
+				Markets: []fakeexchange.MarketConfig{market},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "duplicate markets",
+			config: fakeexchange.ExchangeConfig{
+				Name:           binance.Name,
+				UpdateInterval: time.Second,
+				Markets:        []fakeexchange.MarketConfig{market, market},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative latency",
+			config: fakeexchange.ExchangeConfig{
+				Name:           binance.Name,
+				UpdateInterval: time.Second,
+				Markets:        []fakeexchange.MarketConfig{market},
+				Faults:         fakeexchange.FaultConfig{Latency: -time.Second},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty price path",
+			config: fakeexchange.ExchangeConfig{
+				Name:           binance.Name,
+				UpdateInterval: time.Second,
+				Markets:        []fakeexchange.MarketConfig{{Ticker: "BTCUSDT"}},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unordered price path",
+			config: fakeexchange.ExchangeConfig{
+				Name:           binance.Name,
+				UpdateInterval: time.Second,
+				Markets: []fakeexchange.MarketConfig{
+					{
+						Ticker: "BTCUSDT",
+						Prices: []fakeexchange.PricePoint{
+							{Offset: time.Minute, Price: 100},
+							{Offset: 0, Price: 200},
+						},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "looped price path without duration",
+			config: fakeexchange.ExchangeConfig{
+				Name:           binance.Name,
+				UpdateInterval: time.Second,
+				Markets: []fakeexchange.MarketConfig{
+					{
+						Ticker: "BTCUSDT",
+						Prices: []fakeexchange.PricePoint{{Offset: 0, Price: 100}},
+						Loop:   true,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceAt(t *testing.T) {
+	market := fakeexchange.MarketConfig{
+		Ticker: "BTCUSDT",
+		Prices: []fakeexchange.PricePoint{
+			{Offset: 10 * time.Second, Price: 100},
+			{Offset: 20 * time.Second, Price: 200},
+			{Offset: 40 * time.Second, Price: 100},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		loop     bool
+		elapsed  time.Duration
+		expected float64
+	}{
+		{
+			name:     "before the first point",
+			elapsed:  0,
+			expected: 100,
+		},
+		{
+			name:     "on a point",
+			elapsed:  20 * time.Second,
+			expected: 200,
+		},
+		{
+			name:     "between two points",
+			elapsed:  15 * time.Second,
+			expected: 150,
+		},
+		{
+			name:     "after the last point",
+			elapsed:  time.Minute,
+			expected: 100,
+		},
+		{
+			name:     "looped after the last point",
+			loop:     true,
+			elapsed:  55 * time.Second,
+			expected: 150,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			market.Loop = tc.loop
+			require.InDelta(t, tc.expected, market.PriceAt(tc.elapsed), 1e-9)
+		})
+	}
+}
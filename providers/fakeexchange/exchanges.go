@@ -0,0 +1,114 @@
+package fakeexchange
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/apis/coingecko"
+	"github.com/skip-mev/slinky/providers/websockets/bitfinex"
+	"github.com/skip-mev/slinky/providers/websockets/bitstamp"
+	"github.com/skip-mev/slinky/providers/websockets/bybit"
+	"github.com/skip-mev/slinky/providers/websockets/cryptodotcom"
+	"github.com/skip-mev/slinky/providers/websockets/gate"
+	"github.com/skip-mev/slinky/providers/websockets/huobi"
+	"github.com/skip-mev/slinky/providers/websockets/kraken"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+	"github.com/skip-mev/slinky/providers/websockets/mexc"
+	"github.com/skip-mev/slinky/providers/websockets/okx"
+)
+
+// spec describes how the fake exchange of a provider is served.
+type spec struct {
+	// apiPath is the path of the API URL of the provider, relative to the path of the exchange, in
+	// the format expected by the API config of the provider.
+	apiPath string
+
+	// rest serves the REST API of the exchange, if any. The path is relative to the path of the
+	// exchange.
+	rest func(e *exchange, w http.ResponseWriter, r *http.Request, path string)
+
+	// newProtocol returns the websocket protocol of the exchange, if any.
+	newProtocol func() protocol
+
+	// compress is true if the exchange gzip compresses its websocket frames.
+	compress bool
+
+	// token is the token that must be passed as a query parameter to connect to the websocket of
+	// the exchange, if any.
+	token string
+}
+
+// specs is the set of supported exchanges keyed by provider name. Note that the Coinbase API and
+// websocket providers share the same name, and are served by the same exchange.
+var specs = map[string]spec{
+	binance.Name: {
+		apiPath: strings.TrimPrefix(binance.URL, "https://api.binance.com"),
+		rest:    serveBinance,
+	},
+	coinbaseapi.Name: {
+		apiPath:     strings.TrimPrefix(coinbaseapi.URL, "https://api.coinbase.com"),
+		rest:        serveCoinbase,
+		newProtocol: newCoinbaseProtocol,
+	},
+	coingecko.Name: {
+		apiPath: strings.TrimPrefix(coingecko.URL, "https://api.coingecko.com"),
+		rest:    serveCoinGecko,
+	},
+	bitfinex.Name:     {newProtocol: newBitfinexProtocol},
+	bitstamp.Name:     {newProtocol: newBitstampProtocol},
+	bybit.Name:        {newProtocol: newBybitProtocol},
+	cryptodotcom.Name: {newProtocol: newCryptoDotComProtocol},
+	gate.Name:         {newProtocol: newGateProtocol},
+	huobi.Name:        {newProtocol: newHuobiProtocol, compress: true},
+	kraken.Name:       {newProtocol: newKrakenProtocol},
+	kucoin.Name: {
+		rest:        serveKuCoin,
+		newProtocol: newKuCoinProtocol,
+		token:       kucoinToken,
+	},
+	mexc.Name: {newProtocol: newMEXCProtocol},
+	okx.Name:  {newProtocol: newOKXProtocol},
+}
+
+// RewriteProviderConfig returns a copy of the provider config in which the API URL and websocket
+// endpoint of the provider point at the fake exchange server served at the given HTTP URL i.e.
+// http://localhost:8081.
+func RewriteProviderConfig(cfg config.ProviderConfig, url string) (config.ProviderConfig, error) {
+	s, ok := specs[cfg.Name]
+	if !ok {
+		return cfg, fmt.Errorf("unsupported exchange %s", cfg.Name)
+	}
+
+	base := fmt.Sprintf("%s/%s", strings.TrimSuffix(url, "/"), cfg.Name)
+	if s.rest != nil && len(cfg.API.URL) > 0 {
+		cfg.API.URL = base + s.apiPath
+	}
+
+	if s.newProtocol != nil && len(cfg.WebSocket.WSS) > 0 {
+		wss := strings.Replace(base, "http", "ws", 1)
+		cfg.WebSocket.WSS = wss + WebSocketPath
+	}
+
+	return cfg, nil
+}
+
+// RewriteOracleConfig returns a copy of the oracle config in which every provider points at the
+// fake exchange server served at the given HTTP URL.
+func RewriteOracleConfig(cfg config.OracleConfig, url string) (config.OracleConfig, error) {
+	providers := make([]config.ProviderConfig, len(cfg.Providers))
+	for i, provider := range cfg.Providers {
+		rewritten, err := RewriteProviderConfig(provider, url)
+		if err != nil {
+			return cfg, err
+		}
+
+		providers[i] = rewritten
+	}
+
+	cfg.Providers = providers
+	return cfg, nil
+}
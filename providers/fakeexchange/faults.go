@@ -0,0 +1,80 @@
+package fakeexchange
+
+import (
+	"sync"
+	"time"
+)
+
+// faults injects the configured faults into the traffic of an exchange. It is shared by all of
+// the requests and connections of the exchange.
+type faults struct {
+	mtx sync.Mutex
+	cfg FaultConfig
+
+	// windowStart is the start of the current one second rate limit window, and requests is the
+	// number of requests accepted within the window.
+	windowStart time.Time
+	requests    uint64
+
+	// prices is the number of price responses and price updates sent by the exchange.
+	prices uint64
+}
+
+// newFaults returns a new fault injector for the given config.
+func newFaults(cfg FaultConfig) *faults {
+	return &faults{cfg: cfg}
+}
+
+// allow returns true if a request is allowed under the rate limit.
+func (f *faults) allow() bool {
+	if f.cfg.RateLimit == 0 {
+		return true
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	now := time.Now()
+	if now.Sub(f.windowStart) >= time.Second {
+		f.windowStart = now
+		f.requests = 0
+	}
+
+	if f.requests >= f.cfg.RateLimit {
+		return false
+	}
+
+	f.requests++
+	return true
+}
+
+// malformed counts a price response or update and returns true if it must be malformed.
+func (f *faults) malformed() bool {
+	if f.cfg.MalformedEvery == 0 {
+		return false
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.prices++
+	return f.prices%f.cfg.MalformedEvery == 0
+}
+
+// disconnect returns true if a connection must be closed after sending the given number of
+// price updates.
+func (f *faults) disconnect(updates uint64) bool {
+	return f.cfg.DisconnectAfter > 0 && updates >= f.cfg.DisconnectAfter
+}
+
+// delay blocks for the configured latency.
+func (f *faults) delay() {
+	if f.cfg.Latency > 0 {
+		time.Sleep(f.cfg.Latency)
+	}
+}
+
+// malform truncates the given message such that it can no longer be decoded.
+func malform(bz []byte) []byte {
+	return bz[:len(bz)/2]
+}
@@ -0,0 +1,640 @@
+package fakeexchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skip-mev/slinky/providers/websockets/bitfinex"
+	"github.com/skip-mev/slinky/providers/websockets/bitstamp"
+	"github.com/skip-mev/slinky/providers/websockets/bybit"
+	coinbasews "github.com/skip-mev/slinky/providers/websockets/coinbase"
+	"github.com/skip-mev/slinky/providers/websockets/cryptodotcom"
+	"github.com/skip-mev/slinky/providers/websockets/gate"
+	"github.com/skip-mev/slinky/providers/websockets/huobi"
+	"github.com/skip-mev/slinky/providers/websockets/kraken"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+	"github.com/skip-mev/slinky/providers/websockets/mexc"
+	"github.com/skip-mev/slinky/providers/websockets/okx"
+)
+
+// encode marshals each of the given messages into a frame.
+func encode(messages ...interface{}) ([][]byte, error) {
+	frames := make([][]byte, len(messages))
+	for i, message := range messages {
+		bz, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		frames[i] = bz
+	}
+
+	return frames, nil
+}
+
+// bitfinexProtocol fakes the BitFinex ticker channels. Every subscription is assigned a channel
+// id, which is used to push the updates and heartbeats of the channel.
+type bitfinexProtocol struct {
+	channels map[string]int
+	tickers  []string
+}
+
+func newBitfinexProtocol() protocol {
+	return &bitfinexProtocol{channels: make(map[string]int)}
+}
+
+func (p *bitfinexProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (p *bitfinexProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg bitfinex.SubscribeMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	if bitfinex.Event(msg.Event) != bitfinex.EventSubscribe {
+		return nil, nil, fmt.Errorf("unknown event %s", msg.Event)
+	}
+
+	channelID, ok := p.channels[msg.Symbol]
+	if !ok {
+		channelID = len(p.channels) + 1
+		p.channels[msg.Symbol] = channelID
+		p.tickers = append(p.tickers, msg.Symbol)
+	}
+
+	frames, err := encode(bitfinex.SubscribedMessage{
+		BaseMessage: bitfinex.BaseMessage{Event: string(bitfinex.EventSubscribed)},
+		Channel:     msg.Channel,
+		ChannelID:   channelID,
+		Pair:        msg.Symbol,
+	})
+	return []string{msg.Symbol}, frames, err
+}
+
+func (p *bitfinexProtocol) Update(ticker string, price float64) ([]byte, error) {
+	// The ticker payload is [BID, BID_SIZE, ASK, ASK_SIZE, DAILY_CHANGE, DAILY_CHANGE_RELATIVE,
+	// LAST_PRICE, VOLUME, HIGH, LOW].
+	return json.Marshal([]interface{}{
+		p.channels[ticker],
+		[]float64{price, 1, price, 1, 0, 0, price, 1, price, price},
+	})
+}
+
+func (p *bitfinexProtocol) Heartbeat() ([][]byte, error) {
+	messages := make([]interface{}, len(p.tickers))
+	for i, ticker := range p.tickers {
+		messages[i] = []interface{}{p.channels[ticker], bitfinex.IDHeartbeat}
+	}
+
+	return encode(messages...)
+}
+
+// bitstampProtocol fakes the Bitstamp live trades channels.
+type bitstampProtocol struct{}
+
+func newBitstampProtocol() protocol {
+	return bitstampProtocol{}
+}
+
+func (bitstampProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (bitstampProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg bitstamp.SubscriptionRequestMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	switch bitstamp.EventType(msg.Event) {
+	case bitstamp.SubscriptionEvent:
+		ticker := strings.TrimPrefix(msg.Data.Channel, string(bitstamp.TickerChannel))
+		frames, err := encode(bitstamp.SubscriptionResponseMessage{
+			BaseMessage: bitstamp.BaseMessage{Event: string(bitstamp.SubscriptionSucceededEvent)},
+			Channel:     msg.Data.Channel,
+		})
+		return []string{ticker}, frames, err
+	case bitstamp.HeartbeatEvent:
+		frames, err := encode(bitstamp.BaseMessage{Event: string(bitstamp.HeartbeatEvent)})
+		return nil, frames, err
+	default:
+		return nil, nil, fmt.Errorf("unknown event %s", msg.Event)
+	}
+}
+
+func (bitstampProtocol) Update(ticker string, price float64) ([]byte, error) {
+	channel := string(bitstamp.TickerChannel) + ticker
+	return json.Marshal(bitstamp.TickerResponseMessage{
+		BaseMessage: bitstamp.BaseMessage{Event: string(bitstamp.TradeEvent)},
+		Channel:     channel,
+		Data: bitstamp.TickerData{
+			PriceStr: formatPrice(price),
+			Channel:  channel,
+		},
+	})
+}
+
+func (bitstampProtocol) Heartbeat() ([][]byte, error) {
+	return nil, nil
+}
+
+// bybitProtocol fakes the ByBit spot tickers topics.
+type bybitProtocol struct{}
+
+func newBybitProtocol() protocol {
+	return bybitProtocol{}
+}
+
+func (bybitProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (bybitProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg bybit.SubscriptionRequest
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	switch bybit.Operation(msg.Op) {
+	case bybit.OperationSubscribe:
+		tickers := make([]string, len(msg.Args))
+		for i, arg := range msg.Args {
+			tickers[i] = strings.TrimPrefix(arg, string(bybit.TickerChannel)+".")
+		}
+
+		frames, err := encode(bybit.SubscriptionResponse{
+			BaseResponse: bybit.BaseResponse{Success: true, Op: msg.Op},
+			ReqID:        msg.ReqID,
+		})
+		return tickers, frames, err
+	case bybit.OperationPing:
+		frames, err := encode(bybit.HeartbeatPong{
+			BaseResponse: bybit.BaseResponse{Success: true, RetMsg: string(bybit.OperationPong), Op: msg.Op},
+		})
+		return nil, frames, err
+	default:
+		return nil, nil, fmt.Errorf("unknown operation %s", msg.Op)
+	}
+}
+
+func (bybitProtocol) Update(ticker string, price float64) ([]byte, error) {
+	return json.Marshal(bybit.TickerUpdateMessage{
+		Topic: string(bybit.TickerChannel) + "." + ticker,
+		Data: bybit.TickerUpdateData{
+			Symbol:    ticker,
+			LastPrice: formatPrice(price),
+		},
+	})
+}
+
+func (bybitProtocol) Heartbeat() ([][]byte, error) {
+	return nil, nil
+}
+
+// coinbaseProtocol fakes the Coinbase ticker channel. Each update carries an increasing sequence
+// number.
+type coinbaseProtocol struct {
+	sequence int64
+}
+
+func newCoinbaseProtocol() protocol {
+	return &coinbaseProtocol{}
+}
+
+func (p *coinbaseProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (p *coinbaseProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg coinbasews.SubscribeRequestMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	if coinbasews.MessageType(msg.Type) != coinbasews.SubscribeMessage {
+		return nil, nil, fmt.Errorf("unknown message type %s", msg.Type)
+	}
+
+	frames, err := encode(coinbasews.SubscribeResponseMessage{
+		Type: string(coinbasews.SubscriptionsMessage),
+		Channels: []coinbasews.Channel{
+			{Name: string(coinbasews.TickerChannel), Instruments: msg.ProductIDs},
+		},
+	})
+	return msg.ProductIDs, frames, err
+}
+
+func (p *coinbaseProtocol) Update(ticker string, price float64) ([]byte, error) {
+	p.sequence++
+	return json.Marshal(coinbasews.TickerResponseMessage{
+		Type:     string(coinbasews.TickerMessage),
+		Sequence: p.sequence,
+		Ticker:   ticker,
+		Price:    formatPrice(price),
+	})
+}
+
+func (p *coinbaseProtocol) Heartbeat() ([][]byte, error) {
+	return nil, nil
+}
+
+// cryptoDotComProtocol fakes the Crypto.com ticker channels. The exchange sends heartbeats that
+// the client must respond to.
+type cryptoDotComProtocol struct {
+	heartbeatID int64
+}
+
+func newCryptoDotComProtocol() protocol {
+	return &cryptoDotComProtocol{}
+}
+
+func (p *cryptoDotComProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (p *cryptoDotComProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg cryptodotcom.InstrumentRequestMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	switch cryptodotcom.Method(msg.Method) {
+	case cryptodotcom.InstrumentMethod:
+		tickers := make([]string, len(msg.Params.Channels))
+		for i, channel := range msg.Params.Channels {
+			tickers[i] = strings.TrimPrefix(channel, fmt.Sprintf(cryptodotcom.TickerChannel, ""))
+		}
+
+		return tickers, nil, nil
+	case cryptodotcom.HeartBeatResponseMethod:
+		return nil, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown method %s", msg.Method)
+	}
+}
+
+func (p *cryptoDotComProtocol) Update(ticker string, price float64) ([]byte, error) {
+	return json.Marshal(cryptodotcom.InstrumentResponseMessage{
+		ID:     -1,
+		Method: string(cryptodotcom.InstrumentMethod),
+		Result: cryptodotcom.InstrumentResult{
+			Data: []cryptodotcom.InstrumentData{
+				{LatestTradePrice: formatPrice(price), Name: ticker},
+			},
+		},
+	})
+}
+
+func (p *cryptoDotComProtocol) Heartbeat() ([][]byte, error) {
+	p.heartbeatID++
+	return encode(cryptodotcom.HeartBeatResponseMessage{
+		ID:     p.heartbeatID,
+		Method: string(cryptodotcom.HeartBeatRequestMethod),
+	})
+}
+
+// gateProtocol fakes the Gate.io spot tickers channel.
+type gateProtocol struct{}
+
+func newGateProtocol() protocol {
+	return gateProtocol{}
+}
+
+func (gateProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (gateProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg gate.SubscribeRequest
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	if gate.Event(msg.Event) != gate.EventSubscribe {
+		return nil, nil, fmt.Errorf("unknown event %s", msg.Event)
+	}
+
+	frames, err := encode(gate.SubscribeResponse{
+		BaseMessage: gate.BaseMessage{
+			Time:    int(time.Now().Unix()),
+			Channel: msg.Channel,
+			Event:   msg.Event,
+		},
+		ID:     msg.ID,
+		Result: gate.RequestResult{Status: string(gate.StatusSuccess)},
+	})
+	return msg.Payload, frames, err
+}
+
+func (gateProtocol) Update(ticker string, price float64) ([]byte, error) {
+	return json.Marshal(gate.TickerStream{
+		BaseMessage: gate.BaseMessage{
+			Time:    int(time.Now().Unix()),
+			Channel: string(gate.ChannelTickers),
+			Event:   string(gate.EventUpdate),
+		},
+		Result: gate.TickerResult{
+			CurrencyPair: ticker,
+			Last:         formatPrice(price),
+		},
+	})
+}
+
+func (gateProtocol) Heartbeat() ([][]byte, error) {
+	return nil, nil
+}
+
+// huobiProtocol fakes the Huobi market ticker channels. The exchange pings the client, and all of
+// the frames it sends are gzip compressed.
+type huobiProtocol struct{}
+
+func newHuobiProtocol() protocol {
+	return huobiProtocol{}
+}
+
+func (huobiProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (huobiProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var pong huobi.PongMessage
+	if err := json.Unmarshal(message, &pong); err == nil && pong.Pong != 0 {
+		return nil, nil, nil
+	}
+
+	var msg huobi.SubscriptionRequest
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	// The subscription topic is formatted as market.<symbol>.ticker.
+	parts := strings.Split(msg.Sub, ".")
+	if len(parts) != 3 {
+		return nil, nil, fmt.Errorf("invalid subscription topic %s", msg.Sub)
+	}
+
+	frames, err := encode(huobi.SubscriptionResponse{
+		ID:     msg.ID,
+		Status: string(huobi.StatusOk),
+		Subbed: msg.Sub,
+	})
+	return []string{parts[1]}, frames, err
+}
+
+func (huobiProtocol) Update(ticker string, price float64) ([]byte, error) {
+	return json.Marshal(huobi.TickerStream{
+		Channel: fmt.Sprintf("market.%s.ticker", ticker),
+		Tick:    huobi.Tick{LastPrice: price},
+	})
+}
+
+func (huobiProtocol) Heartbeat() ([][]byte, error) {
+	return encode(huobi.PingMessage{Ping: time.Now().UnixMilli()})
+}
+
+// krakenProtocol fakes the Kraken ticker channels. Every subscription is assigned a channel id.
+type krakenProtocol struct {
+	channels map[string]uint64
+}
+
+func newKrakenProtocol() protocol {
+	return &krakenProtocol{channels: make(map[string]uint64)}
+}
+
+func (p *krakenProtocol) Open() ([][]byte, error) {
+	return encode(kraken.SystemStatusResponseMessage{
+		ConnectionID: uint64(time.Now().UnixNano()),
+		Event:        string(kraken.SystemStatusEvent),
+		Status:       string(kraken.OnlineStatus),
+		Version:      "1.9.1",
+	})
+}
+
+func (p *krakenProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg kraken.SubscribeRequestMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	if kraken.Event(msg.Event) != kraken.SubscribeEvent {
+		return nil, nil, fmt.Errorf("unknown event %s", msg.Event)
+	}
+
+	messages := make([]interface{}, len(msg.Pair))
+	for i, pair := range msg.Pair {
+		channelID, ok := p.channels[pair]
+		if !ok {
+			channelID = uint64(len(p.channels) + 1)
+			p.channels[pair] = channelID
+		}
+
+		messages[i] = kraken.SubscribeResponseMessage{
+			ChannelID:    channelID,
+			ChannelName:  msg.Subscription.Name,
+			Event:        string(kraken.SubscriptionStatusEvent),
+			Pair:         pair,
+			Status:       string(kraken.SubscribedStatus),
+			Subscription: msg.Subscription,
+		}
+	}
+
+	frames, err := encode(messages...)
+	return msg.Pair, frames, err
+}
+
+func (p *krakenProtocol) Update(ticker string, price float64) ([]byte, error) {
+	priceStr := formatPrice(price)
+	return json.Marshal([]interface{}{
+		p.channels[ticker],
+		kraken.TickerData{
+			Ask:                        []interface{}{priceStr, 1, "1.000"},
+			Bid:                        []interface{}{priceStr, 1, "1.000"},
+			Volume:                     []string{"1.000", "1.000"},
+			VolumeWeightedAveragePrice: []string{priceStr, priceStr},
+		},
+		string(kraken.TickerChannel),
+		ticker,
+	})
+}
+
+func (p *krakenProtocol) Heartbeat() ([][]byte, error) {
+	return encode(kraken.HeartbeatResponseMessage{Event: string(kraken.HeartbeatEvent)})
+}
+
+// kucoinProtocol fakes the KuCoin market ticker topics. Each update carries an increasing sequence
+// number.
+type kucoinProtocol struct {
+	sequence int64
+}
+
+func newKuCoinProtocol() protocol {
+	return &kucoinProtocol{}
+}
+
+func (p *kucoinProtocol) Open() ([][]byte, error) {
+	return encode(kucoin.BaseMessage{
+		ID:   strconv.FormatInt(time.Now().UnixNano(), 10),
+		Type: string(kucoin.WelcomeMessage),
+	})
+}
+
+func (p *kucoinProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	// The id of a subscribe message is a number, whereas the id of a ping message is a string.
+	var msg struct {
+		ID    json.RawMessage `json:"id"`
+		Type  string          `json:"type"`
+		Topic string          `json:"topic"`
+	}
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+	id := strings.Trim(string(msg.ID), `"`)
+
+	switch kucoin.MessageType(msg.Type) {
+	case kucoin.PingMessage:
+		frames, err := encode(kucoin.BaseMessage{ID: id, Type: string(kucoin.PongMessage)})
+		return nil, frames, err
+	case kucoin.SubscribeMessage:
+		tickers := strings.Split(strings.TrimPrefix(msg.Topic, string(kucoin.TickerTopic)), ",")
+		frames, err := encode(kucoin.BaseMessage{ID: id, Type: string(kucoin.AckMessage)})
+		return tickers, frames, err
+	default:
+		return nil, nil, fmt.Errorf("unknown message type %s", msg.Type)
+	}
+}
+
+func (p *kucoinProtocol) Update(ticker string, price float64) ([]byte, error) {
+	p.sequence++
+	priceStr := formatPrice(price)
+	return json.Marshal(kucoin.TickerResponseMessage{
+		Type:    string(kucoin.Message),
+		Topic:   string(kucoin.TickerTopic) + ticker,
+		Subject: string(kucoin.TickerSubject),
+		Data: kucoin.TickerResponseMessageData{
+			Sequence: strconv.FormatInt(p.sequence, 10),
+			Price:    priceStr,
+			BestAsk:  priceStr,
+			BestBid:  priceStr,
+		},
+	})
+}
+
+func (p *kucoinProtocol) Heartbeat() ([][]byte, error) {
+	return nil, nil
+}
+
+// mexcTickerSuffix is the time zone suffix of the MEXC mini ticker channels.
+const mexcTickerSuffix = "@UTC+8"
+
+// mexcProtocol fakes the MEXC mini ticker channels.
+type mexcProtocol struct{}
+
+func newMEXCProtocol() protocol {
+	return mexcProtocol{}
+}
+
+func (mexcProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (mexcProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var ping mexc.PingRequestMessage
+	if err := json.Unmarshal(message, &ping); err == nil && mexc.MethodType(ping.Message) == mexc.PingMethod {
+		frames, err := encode(mexc.PongResponseMessage{
+			BaseMessage: mexc.BaseMessage{Message: string(mexc.PongMethod)},
+		})
+		return nil, frames, err
+	}
+
+	var msg mexc.SubscriptionRequestMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	if mexc.MethodType(msg.Method) != mexc.SubscriptionMethod {
+		return nil, nil, fmt.Errorf("unknown method %s", msg.Method)
+	}
+
+	tickers := make([]string, len(msg.Params))
+	messages := make([]interface{}, len(msg.Params))
+	for i, param := range msg.Params {
+		tickers[i] = strings.TrimSuffix(strings.TrimPrefix(param, string(mexc.MiniTickerChannel)), mexcTickerSuffix)
+		messages[i] = mexc.SubscriptionResponseMessage{
+			BaseMessage: mexc.BaseMessage{Message: param},
+		}
+	}
+
+	frames, err := encode(messages...)
+	return tickers, frames, err
+}
+
+func (mexcProtocol) Update(ticker string, price float64) ([]byte, error) {
+	return json.Marshal(mexc.TickerResponseMessage{
+		Channel: string(mexc.MiniTickerChannel) + ticker + mexcTickerSuffix,
+		Data: mexc.TickerData{
+			Symbol: ticker,
+			Price:  formatPrice(price),
+		},
+	})
+}
+
+func (mexcProtocol) Heartbeat() ([][]byte, error) {
+	return nil, nil
+}
+
+// okxProtocol fakes the OKX index tickers channels.
+type okxProtocol struct{}
+
+func newOKXProtocol() protocol {
+	return okxProtocol{}
+}
+
+func (okxProtocol) Open() ([][]byte, error) {
+	return nil, nil
+}
+
+func (okxProtocol) Handle(message []byte) ([]string, [][]byte, error) {
+	var msg okx.SubscribeRequestMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil, err
+	}
+
+	if okx.Operation(msg.Operation) != okx.OperationSubscribe {
+		return nil, nil, fmt.Errorf("unknown operation %s", msg.Operation)
+	}
+
+	tickers := make([]string, len(msg.Arguments))
+	messages := make([]interface{}, len(msg.Arguments))
+	for i, arg := range msg.Arguments {
+		tickers[i] = arg.InstrumentID
+		messages[i] = okx.SubscribeResponseMessage{
+			Arguments:    arg,
+			Event:        string(okx.EventSubscribe),
+			ConnectionID: "fake",
+		}
+	}
+
+	frames, err := encode(messages...)
+	return tickers, frames, err
+}
+
+func (okxProtocol) Update(ticker string, price float64) ([]byte, error) {
+	return json.Marshal(okx.TickersResponseMessage{
+		Arguments: okx.SubscriptionTopic{
+			Channel:      string(okx.TickersChannel),
+			InstrumentID: ticker,
+		},
+		Data: []okx.Ticker{
+			{InstrumentID: ticker, LastPrice: formatPrice(price)},
+		},
+	})
+}
+
+func (okxProtocol) Heartbeat() ([][]byte, error) {
+	return nil, nil
+}
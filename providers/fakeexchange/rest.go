@@ -0,0 +1,129 @@
+package fakeexchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/apis/coingecko"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+)
+
+const (
+	// kucoinToken is the token handed out by the fake KuCoin bullet endpoint.
+	kucoinToken = "fake-exchange-token"
+
+	// kucoinPingInterval and kucoinPingTimeout are the ping interval and timeout, in milliseconds,
+	// handed out by the fake KuCoin bullet endpoint.
+	kucoinPingInterval = 10000
+	kucoinPingTimeout  = 30000
+)
+
+// serveBinance serves the Binance symbol price ticker endpoint. Symbols that are not quoted by the
+// exchange are omitted from the response.
+func serveBinance(e *exchange, w http.ResponseWriter, r *http.Request, path string) {
+	if path != "/api/v3/ticker/price" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var symbols []string
+	if err := json.Unmarshal([]byte(r.URL.Query().Get("symbols")), &symbols); err != nil {
+		e.writeJSON(w, http.StatusBadRequest, errorResponse{Code: -1100, Message: "illegal characters found in parameter 'symbols'"})
+		return
+	}
+
+	resp := make(binance.Response, 0, len(symbols))
+	for _, symbol := range symbols {
+		if price, ok := e.price(symbol); ok {
+			resp = append(resp, binance.Data{Symbol: symbol, Price: formatPrice(price)})
+		}
+	}
+
+	e.writePrices(w, resp)
+}
+
+// serveCoinbase serves the Coinbase spot price endpoint.
+func serveCoinbase(e *exchange, w http.ResponseWriter, r *http.Request, path string) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) != 4 || parts[0] != "v2" || parts[1] != "prices" || parts[3] != "spot" {
+		http.NotFound(w, r)
+		return
+	}
+
+	ticker := parts[2]
+	price, ok := e.price(ticker)
+	if !ok {
+		e.writeJSON(w, http.StatusNotFound, errorResponse{Code: http.StatusNotFound, Message: "invalid currency"})
+		return
+	}
+
+	_, quote, _ := strings.Cut(ticker, "-")
+	e.writePrices(w, coinbaseapi.CoinBaseResponse{
+		Data: coinbaseapi.CoinBaseData{
+			Amount:   formatPrice(price),
+			Currency: quote,
+		},
+	})
+}
+
+// serveCoinGecko serves the CoinGecko simple price endpoint. The price of every quoted market whose
+// base and quote are both requested is returned.
+func serveCoinGecko(e *exchange, w http.ResponseWriter, r *http.Request, path string) {
+	if path != "/api/v3/simple/price" {
+		http.NotFound(w, r)
+		return
+	}
+
+	ids := strings.Split(r.URL.Query().Get("ids"), ",")
+	vsCurrencies := strings.Split(r.URL.Query().Get("vs_currencies"), ",")
+
+	resp := make(coingecko.CoinGeckoResponse)
+	for _, base := range ids {
+		for _, quote := range vsCurrencies {
+			price, ok := e.price(fmt.Sprintf("%s%s%s", base, coingecko.TickerSeparator, quote))
+			if !ok {
+				continue
+			}
+
+			if _, ok := resp[base]; !ok {
+				resp[base] = make(map[string]float64)
+			}
+			resp[base][quote] = price
+		}
+	}
+
+	e.writePrices(w, resp)
+}
+
+// serveKuCoin serves the KuCoin bullet endpoint, which hands out the token and the endpoint of the
+// websocket of the exchange.
+func serveKuCoin(e *exchange, w http.ResponseWriter, r *http.Request, path string) {
+	if path != kucoin.BulletPublicEndpoint || r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+
+	scheme := "ws"
+	if r.TLS != nil {
+		scheme = "wss"
+	}
+
+	e.writeJSON(w, http.StatusOK, kucoin.BulletPublicResponse{
+		Code: kucoin.SuccessCode,
+		Data: kucoin.BulledPublicResponseData{
+			Token: kucoinToken,
+			InstanceServers: []kucoin.BulletPublicResponseInstanceServer{
+				{
+					Endpoint:     fmt.Sprintf("%s://%s/%s%s", scheme, r.Host, kucoin.Name, WebSocketPath),
+					Protocol:     kucoin.WebSocketProtocol,
+					PingInterval: kucoinPingInterval,
+					PingTimeout:  kucoinPingTimeout,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,207 @@
+package fakeexchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WebSocketPath is the path, relative to the path of an exchange, that its websocket is served on.
+const WebSocketPath = "/ws"
+
+// Server is a fake exchange server that speaks the REST and websocket protocols of the supported
+// providers. Prices are driven by scripted price paths, and faults such as latency, disconnects,
+// malformed frames and rate limits can be injected per exchange. This allows the oracle to be run
+// end to end against localhost.
+type Server struct {
+	logger *zap.Logger
+
+	// exchanges is the set of exchanges that are served, keyed by provider name.
+	exchanges map[string]*exchange
+
+	// ctx is cancelled when the server is closed, which closes all of the websocket connections.
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// exchange is the state of a single fake exchange.
+type exchange struct {
+	logger *zap.Logger
+	cfg    ExchangeConfig
+	spec   spec
+	faults *faults
+
+	// markets is the set of markets quoted by the exchange, keyed by ticker.
+	markets map[string]MarketConfig
+
+	// start is the time from which the offsets of the price paths are measured.
+	start time.Time
+}
+
+// NewServer returns a new fake exchange server for the given config. The price paths of all of the
+// exchanges start when the server is created.
+func NewServer(logger *zap.Logger, cfg Config) (*Server, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		logger:    logger.With(zap.String("server", "fake exchange")),
+		exchanges: make(map[string]*exchange, len(cfg.Exchanges)),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	start := time.Now()
+	for _, exchangeCfg := range cfg.Exchanges {
+		markets := make(map[string]MarketConfig, len(exchangeCfg.Markets))
+		for _, market := range exchangeCfg.Markets {
+			markets[market.Ticker] = market
+		}
+
+		s.exchanges[exchangeCfg.Name] = &exchange{
+			logger:  s.logger.With(zap.String("exchange", exchangeCfg.Name)),
+			cfg:     exchangeCfg,
+			spec:    specs[exchangeCfg.Name],
+			faults:  newFaults(exchangeCfg.Faults),
+			markets: markets,
+			start:   start,
+		}
+	}
+
+	return s, nil
+}
+
+// Start serves the fake exchanges on the given address until the context is cancelled.
+func (s *Server) Start(ctx context.Context, address string) error {
+	srv := &http.Server{
+		Addr:              address,
+		Handler:           s,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.logger.Info("stopping fake exchange server")
+
+		s.Close()
+		if err := srv.Shutdown(context.Background()); err != nil {
+			s.logger.Error("failed to shut down fake exchange server", zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("starting fake exchange server", zap.String("address", address))
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Close closes all of the open websocket connections and waits for them to be torn down.
+func (s *Server) Close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// ServeHTTP routes the request to the exchange named by the first element of the request path.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	path = "/" + path
+
+	e, ok := s.exchanges[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	if !e.faults.allow() {
+		e.logger.Debug("rate limiting request", zap.String("path", r.URL.Path))
+		e.writeJSON(w, http.StatusTooManyRequests, errorResponse{Code: http.StatusTooManyRequests, Message: "too many requests"})
+		return
+	}
+
+	switch {
+	case path == WebSocketPath && e.spec.newProtocol != nil:
+		if s.ctx.Err() != nil {
+			http.Error(w, "server is closed", http.StatusServiceUnavailable)
+			return
+		}
+
+		s.wg.Add(1)
+		defer s.wg.Done()
+
+		e.serveWebSocket(s.ctx, w, r)
+	case e.spec.rest != nil:
+		e.spec.rest(e, w, r, path)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// price returns the current price of the market with the given ticker, if the market is quoted by
+// the exchange.
+func (e *exchange) price(ticker string) (float64, bool) {
+	market, ok := e.markets[ticker]
+	if !ok {
+		return 0, false
+	}
+
+	return market.PriceAt(time.Since(e.start)), true
+}
+
+// errorResponse is the generic error returned by the fake exchanges.
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"msg"`
+}
+
+// writeJSON writes the given value as the JSON body of the response after the configured latency.
+func (e *exchange) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	e.write(w, status, v, false)
+}
+
+// writePrices writes the given price response. The response is malformed at the configured rate.
+func (e *exchange) writePrices(w http.ResponseWriter, v interface{}) {
+	e.write(w, http.StatusOK, v, e.faults.malformed())
+}
+
+// write writes the given value as the JSON body of the response after the configured latency,
+// truncating the body if it must be malformed.
+func (e *exchange) write(w http.ResponseWriter, status int, v interface{}, malformed bool) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if malformed {
+		bz = malform(bz)
+	}
+
+	e.faults.delay()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(bz); err != nil {
+		e.logger.Debug("failed to write response", zap.Error(err))
+	}
+}
+
+// formatPrice formats the price the way the exchanges quote prices as strings.
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', -1, 64)
+}
@@ -0,0 +1,256 @@
+package fakeexchange_test
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/fakeexchange"
+	"github.com/skip-mev/slinky/providers/websockets/huobi"
+	"github.com/skip-mev/slinky/providers/websockets/kraken"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+var (
+	logger = zap.NewExample()
+
+	btcusd = oracletypes.NewCurrencyPair("BITCOIN", "USD")
+)
+
+// newServer starts a fake exchange server with a single exchange that quotes a constant price for
+// the given ticker.
+func newServer(t *testing.T, name, ticker string, faults fakeexchange.FaultConfig) *httptest.Server {
+	t.Helper()
+
+	srv, err := fakeexchange.NewServer(logger, fakeexchange.Config{
+		Exchanges: []fakeexchange.ExchangeConfig{
+			{
+				Name:           name,
+				UpdateInterval: 10 * time.Millisecond,
+				Markets: []fakeexchange.MarketConfig{
+					{
+						Ticker: ticker,
+						Prices: []fakeexchange.PricePoint{{Offset: 0, Price: 100}},
+					},
+				},
+				Faults: faults,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		srv.Close()
+		ts.Close()
+	})
+
+	return ts
+}
+
+// providerConfig returns the config of the given provider, quoting BITCOIN/USD with the given
+// ticker, that points at the fake exchange server.
+func providerConfig(
+	t *testing.T,
+	ts *httptest.Server,
+	name string,
+	api config.APIConfig,
+	ws config.WebSocketConfig,
+	ticker string,
+) config.ProviderConfig {
+	t.Helper()
+
+	cfg, err := fakeexchange.RewriteProviderConfig(config.ProviderConfig{
+		Name:      name,
+		API:       api,
+		WebSocket: ws,
+		Market: config.MarketConfig{
+			Name: name,
+			CurrencyPairToMarketConfigs: map[string]config.CurrencyPairMarketConfig{
+				btcusd.String(): {
+					Ticker:       ticker,
+					CurrencyPair: btcusd,
+				},
+			},
+		},
+	}, ts.URL)
+	require.NoError(t, err)
+	cfg.Market.Invert()
+
+	return cfg
+}
+
+func TestNewServer(t *testing.T) {
+	t.Run("nil logger", func(t *testing.T) {
+		_, err := fakeexchange.NewServer(nil, fakeexchange.Config{})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := fakeexchange.NewServer(logger, fakeexchange.Config{
+			Exchanges: []fakeexchange.ExchangeConfig{{Name: "unsupported"}},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestRewriteProviderConfig(t *testing.T) {
+	t.Run("unsupported exchange", func(t *testing.T) {
+		_, err := fakeexchange.RewriteProviderConfig(config.ProviderConfig{Name: "unsupported"}, "http://localhost:8081")
+		require.Error(t, err)
+	})
+
+	t.Run("api provider", func(t *testing.T) {
+		cfg, err := fakeexchange.RewriteProviderConfig(config.ProviderConfig{
+			Name: binance.Name,
+			API:  binance.DefaultNonUSAPIConfig,
+		}, "http://localhost:8081/")
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost:8081/binance/api/v3/ticker/price?symbols=%s%s%s", cfg.API.URL)
+	})
+
+	t.Run("websocket provider", func(t *testing.T) {
+		cfg, err := fakeexchange.RewriteProviderConfig(config.ProviderConfig{
+			Name:      kraken.Name,
+			WebSocket: kraken.DefaultWebSocketConfig,
+		}, "http://localhost:8081")
+		require.NoError(t, err)
+		require.Equal(t, "ws://localhost:8081/kraken/ws", cfg.WebSocket.WSS)
+	})
+}
+
+func TestBinance(t *testing.T) {
+	ts := newServer(t, binance.Name, "BTCUSD", fakeexchange.FaultConfig{})
+	cfg := providerConfig(t, ts, binance.Name, binance.DefaultNonUSAPIConfig, config.WebSocketConfig{}, "BTCUSD")
+
+	handler, err := binance.NewAPIHandler(cfg)
+	require.NoError(t, err)
+
+	url, err := handler.CreateURL([]oracletypes.CurrencyPair{btcusd})
+	require.NoError(t, err)
+
+	resp, err := http.Get(url) //nolint:gosec
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	result := handler.ParseResponse([]oracletypes.CurrencyPair{btcusd}, resp)
+	require.Empty(t, result.UnResolved)
+	require.Equal(t, big.NewInt(100_00000000), result.Resolved[btcusd].Value)
+}
+
+func TestRateLimit(t *testing.T) {
+	ts := newServer(t, binance.Name, "BTCUSD", fakeexchange.FaultConfig{RateLimit: 1})
+	url := ts.URL + "/binance/api/v3/ticker/price?symbols=[\"BTCUSD\"]"
+
+	resp, err := http.Get(url) //nolint:gosec
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(url) //nolint:gosec
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
+
+func TestMalformed(t *testing.T) {
+	ts := newServer(t, binance.Name, "BTCUSD", fakeexchange.FaultConfig{MalformedEvery: 1})
+	cfg := providerConfig(t, ts, binance.Name, binance.DefaultNonUSAPIConfig, config.WebSocketConfig{}, "BTCUSD")
+
+	handler, err := binance.NewAPIHandler(cfg)
+	require.NoError(t, err)
+
+	url, err := handler.CreateURL([]oracletypes.CurrencyPair{btcusd})
+	require.NoError(t, err)
+
+	resp, err := http.Get(url) //nolint:gosec
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	result := handler.ParseResponse([]oracletypes.CurrencyPair{btcusd}, resp)
+	require.Empty(t, result.Resolved)
+	require.Contains(t, result.UnResolved, btcusd)
+}
+
+func TestKraken(t *testing.T) {
+	ts := newServer(t, kraken.Name, "XBT/USD", fakeexchange.FaultConfig{})
+	cfg := providerConfig(t, ts, kraken.Name, config.APIConfig{}, kraken.DefaultWebSocketConfig, "XBT/USD")
+
+	handler, err := kraken.NewWebSocketDataHandler(logger, cfg)
+	require.NoError(t, err)
+
+	conn, _, err := websocket.DefaultDialer.Dial(cfg.WebSocket.WSS, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	messages, err := handler.CreateMessages([]oracletypes.CurrencyPair{btcusd})
+	require.NoError(t, err)
+	for _, message := range messages {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, message))
+	}
+
+	// Read until the first price update, which follows the system status and the subscription
+	// status messages.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	for {
+		_, message, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		resp, _, err := handler.HandleMessage(message)
+		require.NoError(t, err)
+
+		if result, ok := resp.Resolved[btcusd]; ok {
+			require.Equal(t, big.NewInt(100_00000000), result.Value)
+			return
+		}
+	}
+}
+
+func TestHuobi(t *testing.T) {
+	ts := newServer(t, huobi.Name, "btcusd", fakeexchange.FaultConfig{DisconnectAfter: 1})
+	cfg := providerConfig(t, ts, huobi.Name, config.APIConfig{}, huobi.DefaultWebSocketConfig, "btcusd")
+
+	handler, err := huobi.NewWebSocketDataHandler(logger, cfg)
+	require.NoError(t, err)
+
+	conn, _, err := websocket.DefaultDialer.Dial(cfg.WebSocket.WSS, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	messages, err := handler.CreateMessages([]oracletypes.CurrencyPair{btcusd})
+	require.NoError(t, err)
+	for _, message := range messages {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, message))
+	}
+
+	// Every frame is gzip compressed, which the handler decompresses, and the connection is
+	// dropped after the first price update.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var updates int
+	for {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			require.False(t, strings.Contains(err.Error(), "timeout"))
+			break
+		}
+		require.Equal(t, websocket.BinaryMessage, messageType)
+
+		resp, _, err := handler.HandleMessage(message)
+		require.NoError(t, err)
+
+		if _, ok := resp.Resolved[btcusd]; ok {
+			updates++
+		}
+	}
+
+	require.Equal(t, 1, updates)
+}
@@ -0,0 +1,254 @@
+package fakeexchange
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// heartbeatInterval is the interval at which the exchanges that ping their clients send
+	// heartbeats.
+	heartbeatInterval = 5 * time.Second
+
+	// writeTimeout is the timeout for writing a single frame to a connection.
+	writeTimeout = 5 * time.Second
+)
+
+// protocol is the websocket protocol of a fake exchange. A new protocol is created for every
+// connection, such that it can track the state of the connection i.e. the channel ids handed
+// out to the client.
+type protocol interface {
+	// Open returns the frames that are sent once the connection is established.
+	Open() ([][]byte, error)
+
+	// Handle handles a message sent by the client. It returns the tickers that the client
+	// subscribed to, and the frames that are sent in response.
+	Handle(message []byte) ([]string, [][]byte, error)
+
+	// Update returns the frame that pushes the given price of the ticker to the client.
+	Update(ticker string, price float64) ([]byte, error)
+
+	// Heartbeat returns the frames that are sent periodically to keep the connection alive.
+	Heartbeat() ([][]byte, error)
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// session is a single websocket connection to a fake exchange.
+type session struct {
+	exchange *exchange
+	conn     *websocket.Conn
+
+	// mtx guards the protocol and the subscriptions, which are used by both the read loop and
+	// the update loop.
+	mtx           sync.Mutex
+	protocol      protocol
+	subscriptions []string
+	subscribed    map[string]struct{}
+
+	// writeMtx serializes the writes to the connection.
+	writeMtx sync.Mutex
+}
+
+// serveWebSocket upgrades the request to a websocket connection and serves it until the context
+// is cancelled, the client disconnects, or a disconnect is injected.
+func (e *exchange) serveWebSocket(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	if e.spec.token != "" && r.URL.Query().Get("token") != e.spec.token {
+		e.writeJSON(w, http.StatusUnauthorized, errorResponse{Code: http.StatusUnauthorized, Message: "invalid token"})
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		e.logger.Debug("failed to upgrade connection", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	s := &session{
+		exchange:   e,
+		conn:       conn,
+		protocol:   e.spec.newProtocol(),
+		subscribed: make(map[string]struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		defer cancel()
+		s.read()
+	}()
+
+	e.logger.Debug("websocket connection established", zap.String("remote", r.RemoteAddr))
+	s.run(ctx)
+	e.logger.Debug("websocket connection closed", zap.String("remote", r.RemoteAddr))
+}
+
+// run sends the opening frames, and then pushes price updates and heartbeats to the client until
+// the context is cancelled or a disconnect is injected.
+func (s *session) run(ctx context.Context) {
+	s.mtx.Lock()
+	frames, err := s.protocol.Open()
+	s.mtx.Unlock()
+	if err != nil || s.write(frames...) != nil {
+		return
+	}
+
+	updates := time.NewTicker(s.exchange.cfg.UpdateInterval)
+	defer updates.Stop()
+
+	heartbeats := time.NewTicker(heartbeatInterval)
+	defer heartbeats.Stop()
+
+	var sent uint64
+	for {
+		select {
+		case <-ctx.Done():
+			s.writeMtx.Lock()
+			_ = s.conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, ""),
+				time.Now().Add(writeTimeout),
+			)
+			s.writeMtx.Unlock()
+
+			return
+		case <-heartbeats.C:
+			s.mtx.Lock()
+			frames, err := s.protocol.Heartbeat()
+			s.mtx.Unlock()
+			if err != nil || s.write(frames...) != nil {
+				return
+			}
+		case <-updates.C:
+			for _, frame := range s.updates() {
+				if s.exchange.faults.malformed() {
+					frame = malform(frame)
+				}
+
+				if err := s.write(frame); err != nil {
+					return
+				}
+
+				// Drop the connection without a close frame, as if the network failed.
+				sent++
+				if s.exchange.faults.disconnect(sent) {
+					s.exchange.logger.Debug("injecting disconnect", zap.Uint64("updates", sent))
+					return
+				}
+			}
+		}
+	}
+}
+
+// updates returns the price update frames of all of the subscribed tickers that are quoted by
+// the exchange.
+func (s *session) updates() [][]byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	frames := make([][]byte, 0, len(s.subscriptions))
+	for _, ticker := range s.subscriptions {
+		price, ok := s.exchange.price(ticker)
+		if !ok {
+			continue
+		}
+
+		frame, err := s.protocol.Update(ticker, price)
+		if err != nil {
+			s.exchange.logger.Error("failed to create price update", zap.String("ticker", ticker), zap.Error(err))
+			continue
+		}
+
+		frames = append(frames, frame)
+	}
+
+	return frames
+}
+
+// read handles the messages sent by the client until the connection is closed.
+func (s *session) read() {
+	for {
+		_, message, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		s.mtx.Lock()
+		tickers, frames, err := s.protocol.Handle(message)
+		for _, ticker := range tickers {
+			if _, ok := s.subscribed[ticker]; !ok {
+				s.subscribed[ticker] = struct{}{}
+				s.subscriptions = append(s.subscriptions, ticker)
+			}
+		}
+		s.mtx.Unlock()
+
+		if err != nil {
+			s.exchange.logger.Debug("failed to handle message", zap.ByteString("message", message), zap.Error(err))
+			continue
+		}
+
+		if err := s.write(frames...); err != nil {
+			return
+		}
+	}
+}
+
+// write writes the given frames to the connection after the configured latency. The frames are
+// gzip compressed if the exchange compresses its frames.
+func (s *session) write(frames ...[]byte) error {
+	for _, frame := range frames {
+		messageType := websocket.TextMessage
+		if s.exchange.spec.compress {
+			compressed, err := compress(frame)
+			if err != nil {
+				return err
+			}
+
+			frame = compressed
+			messageType = websocket.BinaryMessage
+		}
+
+		s.exchange.faults.delay()
+
+		s.writeMtx.Lock()
+		err := s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if err == nil {
+			err = s.conn.WriteMessage(messageType, frame)
+		}
+		s.writeMtx.Unlock()
+
+		if err != nil {
+			s.exchange.logger.Debug("failed to write frame", zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compress gzip compresses the given frame.
+func compress(frame []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(frame); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -34,6 +34,26 @@ func (_m *Provider[K, V]) GetData() map[K]types.Result[V] {
 	return r0
 }
 
+// GetIDs provides a mock function with given fields:
+func (_m *Provider[K, V]) GetIDs() []K {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIDs")
+	}
+
+	var r0 []K
+	if rf, ok := ret.Get(0).(func() []K); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]K)
+		}
+	}
+
+	return r0
+}
+
 // Name provides a mock function with given fields:
 func (_m *Provider[K, V]) Name() string {
 	ret := _m.Called()
@@ -52,6 +72,11 @@ func (_m *Provider[K, V]) Name() string {
 	return r0
 }
 
+// SetIDs provides a mock function with given fields: ids
+func (_m *Provider[K, V]) SetIDs(ids []K) {
+	_m.Called(ids)
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Provider[K, V]) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
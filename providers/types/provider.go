@@ -32,6 +32,13 @@ type Provider[K ResponseKey, V ResponseValue] interface {
 
 	// Type returns the type of the provider data handler.
 	Type() ProviderType
+
+	// GetIDs returns the set of IDs that the provider is responsible for fetching data for.
+	GetIDs() []K
+
+	// SetIDs updates the set of IDs that the provider is responsible for fetching data for.
+	// If the provider is running, it must start fetching data for the new set of IDs.
+	SetIDs(ids []K)
 }
 
 // ProviderFactory inputs the oracle configuration and returns a set of providers. Developers
@@ -2,7 +2,10 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
+
+	"github.com/skip-mev/slinky/pkg/math"
 )
 
 // ResponseKey is a type restriction interface for the key of a GetResponse.
@@ -28,6 +31,18 @@ type Result[V ResponseValue] struct {
 	Value V
 	// Timestamp is the timestamp of the value.
 	Timestamp time.Time
+	// Volume is the 24 hour volume of the requested ID denominated in the base asset. This
+	// is nil if the provider does not report volume.
+	Volume *big.Float
+	// Bid is the best bid of the requested ID. This is the zero value if the provider does
+	// not report the best bid and ask.
+	Bid V
+	// Ask is the best ask of the requested ID. This is the zero value if the provider does
+	// not report the best bid and ask.
+	Ask V
+	// Restored is true if the result was restored from a snapshot persisted by a previous
+	// run of the oracle rather than fetched from the provider.
+	Restored bool
 }
 
 // NewGetResponse creates a new GetResponse.
@@ -78,6 +93,48 @@ func NewResult[V ResponseValue](value V, timestamp time.Time) Result[V] {
 	}
 }
 
+// WithVolume returns a copy of the Result with the given 24 hour volume.
+func (r Result[V]) WithVolume(volume *big.Float) Result[V] {
+	r.Volume = volume
+	return r
+}
+
+// WithBidAsk returns a copy of the Result with the given best bid and ask.
+func (r Result[V]) WithBidAsk(bid, ask V) Result[V] {
+	r.Bid = bid
+	r.Ask = ask
+	return r
+}
+
+// WithMarketData returns a copy of the price result with the 24 hour volume and the best bid
+// and ask parsed from the given decimal strings. The bid and ask are scaled by the given number
+// of decimals, the same as the price. Market data is optional, so values that are empty or
+// cannot be parsed are left unset rather than invalidating the price. The bid and ask are only
+// set if both can be parsed.
+func WithMarketData(result Result[*big.Int], decimals int, volume, bid, ask string) Result[*big.Int] {
+	if len(volume) > 0 {
+		if v, ok := new(big.Float).SetString(volume); ok && v.Sign() >= 0 {
+			result = result.WithVolume(v)
+		}
+	}
+
+	if len(bid) == 0 || len(ask) == 0 {
+		return result
+	}
+
+	bidPrice, err := math.Float64StringToBigInt(bid, decimals)
+	if err != nil {
+		return result
+	}
+
+	askPrice, err := math.Float64StringToBigInt(ask, decimals)
+	if err != nil {
+		return result
+	}
+
+	return result.WithBidAsk(bidPrice, askPrice)
+}
+
 // String returns a string representation of the Result. This is mostly used for logging
 // and testing purposes.
 func (r Result[V]) String() string {
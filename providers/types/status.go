@@ -0,0 +1,49 @@
+package types
+
+import (
+	"time"
+)
+
+// ConnectionStatus is the status of the connection between a provider and its data source.
+type ConnectionStatus string
+
+const (
+	// Stopped indicates that the provider is not running.
+	Stopped ConnectionStatus = "stopped"
+	// Connecting indicates that the provider is running but has not received a response from
+	// its data source yet.
+	Connecting ConnectionStatus = "connecting"
+	// Connected indicates that the latest response from the data source resolved data.
+	Connected ConnectionStatus = "connected"
+	// Disconnected indicates that the latest response from the data source failed to resolve
+	// any data, or that the connection to the data source was closed.
+	Disconnected ConnectionStatus = "disconnected"
+	// UnknownConnectionStatus indicates that the provider does not report its connection status.
+	UnknownConnectionStatus ConnectionStatus = "unknown"
+)
+
+// IDStatus is the status of a single ID (i.e. currency pair) tracked by a provider.
+type IDStatus struct {
+	// LastUpdate is the timestamp of the latest data for the ID. This is the zero value if the
+	// provider has no data for the ID.
+	LastUpdate time.Time
+	// Errors is the number of responses that failed to resolve the ID.
+	Errors uint64
+	// LastError is the error of the latest response that failed to resolve the ID.
+	LastError string
+}
+
+// ProviderStatus is the status of a provider and the IDs it is responsible for.
+type ProviderStatus[K ResponseKey] struct {
+	// Connection is the status of the connection to the data source.
+	Connection ConnectionStatus
+	// IDs is the status of each ID that the provider is responsible for.
+	IDs map[K]IDStatus
+}
+
+// StatusReporter defines an optional interface for providers that report their connection
+// status and the status of each ID they are responsible for.
+type StatusReporter[K ResponseKey] interface {
+	// GetStatus returns the current status of the provider.
+	GetStatus() ProviderStatus[K]
+}
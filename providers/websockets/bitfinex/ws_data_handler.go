@@ -158,6 +158,16 @@ func (h *WebsocketDataHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedM
 	return nil, nil
 }
 
+// Copy is used to create a copy of the data handler. The copy does not share the channel subscriptions
+// of the original.
+func (h *WebsocketDataHandler) Copy() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+	return &WebsocketDataHandler{
+		cfg:        h.cfg,
+		logger:     h.logger,
+		channelMap: make(map[int]config.CurrencyPairMarketConfig),
+	}
+}
+
 // UpdateChannelMap updates the internal map for the given channelID and ticker.
 func (h *WebsocketDataHandler) UpdateChannelMap(channelID int, ticker string) error {
 	market, ok := h.cfg.Market.TickerToMarketConfigs[ticker]
@@ -132,3 +132,11 @@ func (h *WebSocketDataHandler) CreateMessages(
 func (h *WebSocketDataHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return NewHeartbeatRequestMessage()
 }
+
+// Copy is used to create a copy of the data handler.
+func (h *WebSocketDataHandler) Copy() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+	return &WebSocketDataHandler{
+		cfg:    h.cfg,
+		logger: h.logger,
+	}
+}
@@ -190,4 +190,5 @@ type TickerUpdateMessage struct {
 type TickerUpdateData struct {
 	Symbol    string `json:"symbol"`
 	LastPrice string `json:"lastPrice"`
+	Volume24H string `json:"volume24h"`
 }
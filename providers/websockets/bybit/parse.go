@@ -65,13 +65,20 @@ func (h *WebsocketDataHandler) parseTickerUpdate(
 	cp := market.CurrencyPair
 
 	// Convert the price to a big.Int.
-	price, err := math.Float64StringToBigInt(data.LastPrice, cp.Decimals())
+	price, err := math.Float64StringToBigInt(data.LastPrice, market.GetDecimals())
 	if err != nil {
 		h.logger.Error("failed to convert price to big.Int", zap.Error(err))
 		unresolved[cp] = fmt.Errorf("failed to convert price to big.Int: %w", err)
 		return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unresolved), nil
 	}
 
-	resolved[cp] = providertypes.NewResult[*big.Int](price, time.Now().UTC())
+	// Spot tickers do not include the best bid and ask, so only the 24 hour volume is attached.
+	resolved[cp] = providertypes.WithMarketData(
+		providertypes.NewResult[*big.Int](price, time.Now().UTC()),
+		market.GetDecimals(),
+		data.Volume24H,
+		"",
+		"",
+	)
 	return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unresolved), nil
 }
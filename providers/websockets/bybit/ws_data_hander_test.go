@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/testutils"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 
 	"github.com/skip-mev/slinky/providers/websockets/bybit"
@@ -93,6 +94,7 @@ func TestHandlerMessage(t *testing.T) {
 					Data: bybit.TickerUpdateData{
 						Symbol:    "BTCUSD",
 						LastPrice: "1",
+						Volume24H: "6780.866843",
 					},
 				}
 
@@ -104,7 +106,8 @@ func TestHandlerMessage(t *testing.T) {
 			resp: providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](
 				map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
 					oracletypes.NewCurrencyPair("BITCOIN", "USD"): {
-						Value: big.NewInt(100000000),
+						Value:  big.NewInt(100000000),
+						Volume: big.NewFloat(6780.866843),
 					},
 				},
 				map[oracletypes.CurrencyPair]error{},
@@ -208,6 +211,7 @@ func TestHandlerMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value, resp.Resolved[cp].Value)
+				testutils.RequireMarketDataEqual(t, result, resp.Resolved[cp])
 			}
 
 			for cp := range tc.resp.UnResolved {
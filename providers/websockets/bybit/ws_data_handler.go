@@ -151,3 +151,11 @@ func (h *WebsocketDataHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedM
 
 	return []handlers.WebsocketEncodedMessage{msg}, nil
 }
+
+// Copy is used to create a copy of the data handler.
+func (h *WebsocketDataHandler) Copy() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+	return &WebsocketDataHandler{
+		cfg:    h.cfg,
+		logger: h.logger,
+	}
+}
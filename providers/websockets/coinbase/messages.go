@@ -210,4 +210,13 @@ type TickerResponseMessage struct {
 
 	// Price is the price of the ticker.
 	Price string `json:"price"`
+
+	// Volume24H is the 24 hour volume of the ticker denominated in the base currency.
+	Volume24H string `json:"volume_24h"`
+
+	// BestBid is the best bid price of the ticker.
+	BestBid string `json:"best_bid"`
+
+	// BestAsk is the best ask price of the ticker.
+	BestAsk string `json:"best_ask"`
 }
@@ -50,14 +50,21 @@ func (h *WebSocketDataHandler) parseTickerResponseMessage(
 	}
 
 	// Convert the price to a big int.
-	price, err := math.Float64StringToBigInt(msg.Price, cp.Decimals())
+	price, err := math.Float64StringToBigInt(msg.Price, market.GetDecimals())
 	if err != nil {
 		unResolved[cp] = err
 		return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unResolved), err
 	}
 
-	// Convert the time to a time object and resolve the price into the response.
-	resolved[cp] = providertypes.NewResult[*big.Int](price, time.Now().UTC())
+	// Convert the time to a time object and resolve the price along with the 24 hour volume
+	// and best bid and ask into the response.
+	resolved[cp] = providertypes.WithMarketData(
+		providertypes.NewResult[*big.Int](price, time.Now().UTC()),
+		market.GetDecimals(),
+		msg.Volume24H,
+		msg.BestBid,
+		msg.BestAsk,
+	)
 
 	h.logger.Debug("successfully parsed ticker response message")
 	return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unResolved), nil
@@ -130,3 +130,13 @@ func (h *WebSocketDataHandler) CreateMessages(
 func (h *WebSocketDataHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
 }
+
+// Copy is used to create a copy of the data handler. The copy does not share the sequence numbers
+// of the original.
+func (h *WebSocketDataHandler) Copy() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+	return &WebSocketDataHandler{
+		cfg:      h.cfg,
+		logger:   h.logger,
+		sequence: make(map[oracletypes.CurrencyPair]int64),
+	}
+}
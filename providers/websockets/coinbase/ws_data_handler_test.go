@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/testutils"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 	"github.com/skip-mev/slinky/providers/websockets/coinbase"
@@ -60,10 +61,13 @@ func TestHandleMessage(t *testing.T) {
 			name: "ticker message",
 			msg: func() []byte {
 				msg := coinbase.TickerResponseMessage{
-					Type:     string(coinbase.TickerMessage),
-					Ticker:   "BTC-USD",
-					Price:    "10000.00",
-					Sequence: 1,
+					Type:      string(coinbase.TickerMessage),
+					Ticker:    "BTC-USD",
+					Price:     "10000.00",
+					Sequence:  1,
+					Volume24H: "245532.79269678",
+					BestBid:   "9999.50",
+					BestAsk:   "10000.50",
 				}
 
 				bz, err := json.Marshal(msg)
@@ -74,7 +78,10 @@ func TestHandleMessage(t *testing.T) {
 			resp: providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int]{
 				Resolved: map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
 					oracletypes.NewCurrencyPair("BITCOIN", "USD"): {
-						Value: big.NewInt(1000000000000),
+						Value:  big.NewInt(1000000000000),
+						Volume: big.NewFloat(245532.79269678),
+						Bid:    big.NewInt(999950000000),
+						Ask:    big.NewInt(1000050000000),
 					},
 				},
 			},
@@ -207,6 +214,7 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value, resp.Resolved[cp].Value)
+				testutils.RequireMarketDataEqual(t, result, resp.Resolved[cp])
 			}
 
 			for cp := range tc.resp.UnResolved {
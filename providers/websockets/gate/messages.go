@@ -174,4 +174,10 @@ type TickerResult struct {
 	CurrencyPair string `json:"currency_pair"`
 	// Last is the last price of the pair.
 	Last string `json:"last"`
+	// LowestAsk is the best ask price of the pair.
+	LowestAsk string `json:"lowest_ask"`
+	// HighestBid is the best bid price of the pair.
+	HighestBid string `json:"highest_bid"`
+	// BaseVolume is the 24 hour volume of the pair denominated in the base currency.
+	BaseVolume string `json:"base_volume"`
 }
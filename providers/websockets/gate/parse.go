@@ -59,12 +59,18 @@ func (h *WebsocketDataHandler) parseTickerStream(
 	// Parse the price update.
 	cp := market.CurrencyPair
 	priceStr := stream.Result.Last
-	price, err := math.Float64StringToBigInt(priceStr, cp.Decimals())
+	price, err := math.Float64StringToBigInt(priceStr, market.GetDecimals())
 	if err != nil {
 		unresolved[cp] = fmt.Errorf("failed to parse price %s: %w", priceStr, err)
 		return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unresolved), unresolved[cp]
 	}
 
-	resolved[cp] = providertypes.NewResult[*big.Int](price, time.Now().UTC())
+	resolved[cp] = providertypes.WithMarketData(
+		providertypes.NewResult[*big.Int](price, time.Now().UTC()),
+		market.GetDecimals(),
+		stream.Result.BaseVolume,
+		stream.Result.HighestBid,
+		stream.Result.LowestAsk,
+	)
 	return providertypes.NewGetResponse(resolved, unresolved), nil
 }
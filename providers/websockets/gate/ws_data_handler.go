@@ -125,3 +125,11 @@ func (h *WebsocketDataHandler) CreateMessages(
 func (h *WebsocketDataHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
 }
+
+// Copy is used to create a copy of the data handler.
+func (h *WebsocketDataHandler) Copy() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+	return &WebsocketDataHandler{
+		cfg:    h.cfg,
+		logger: h.logger,
+	}
+}
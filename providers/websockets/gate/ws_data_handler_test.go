@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/testutils"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 	"github.com/skip-mev/slinky/providers/websockets/gate"
@@ -107,6 +108,9 @@ func TestHandlerMessage(t *testing.T) {
 					Result: gate.TickerResult{
 						CurrencyPair: "BTC_USDT",
 						Last:         "1",
+						LowestAsk:    "1.01",
+						HighestBid:   "0.99",
+						BaseVolume:   "9110.473081735",
 					},
 				}
 
@@ -118,7 +122,10 @@ func TestHandlerMessage(t *testing.T) {
 			resp: providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](
 				map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
 					oracletypes.NewCurrencyPair("BITCOIN", "USDT"): {
-						Value: big.NewInt(100000000),
+						Value:  big.NewInt(100000000),
+						Volume: big.NewFloat(9110.473081735),
+						Bid:    big.NewInt(99000000),
+						Ask:    big.NewInt(101000000),
 					},
 				},
 				map[oracletypes.CurrencyPair]error{},
@@ -232,6 +239,7 @@ func TestHandlerMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value, resp.Resolved[cp].Value)
+				testutils.RequireMarketDataEqual(t, result, resp.Resolved[cp])
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -323,6 +323,17 @@ const (
 
 // TickerData is the ticker data.
 type TickerData struct {
+	// Ask is the best ask array, which contains the price followed by the whole lot volume
+	// and the lot volume.
+	Ask []interface{} `json:"a"`
+
+	// Bid is the best bid array, which contains the price followed by the whole lot volume
+	// and the lot volume.
+	Bid []interface{} `json:"b"`
+
+	// Volume is the volume array, which contains today's volume and the last 24 hours volume.
+	Volume []string `json:"v"`
+
 	// VolumeWeightedAveragePrice is the volume weighted average price.
 	VolumeWeightedAveragePrice []string `json:"p"`
 }
@@ -335,4 +346,11 @@ const (
 	// ExpectedVolumeWeightedAveragePriceLength is the expected length of the ticker's
 	// VolumeWeightedAveragePrice array.
 	ExpectedVolumeWeightedAveragePriceLength = 2
+
+	// Last24HoursVolumeIndex is the index of the last 24 hours volume in the ticker's
+	// Volume array.
+	Last24HoursVolumeIndex = 1
+
+	// BestPriceIndex is the index of the price in the ticker's Ask and Bid arrays.
+	BestPriceIndex = 0
 )
@@ -111,12 +111,24 @@ func (h *WebSocketDataHandler) parseTickerMessage(
 	// Parse the price update.
 	cp := market.CurrencyPair
 	priceStr := resp.TickerData.VolumeWeightedAveragePrice[TodayPriceIndex]
-	price, err := math.Float64StringToBigInt(priceStr, cp.Decimals())
+	price, err := math.Float64StringToBigInt(priceStr, market.GetDecimals())
 	if err != nil {
 		unResolved[cp] = fmt.Errorf("failed to parse price %s: %w", priceStr, err)
 		return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unResolved), unResolved[cp]
 	}
 
-	resolved[cp] = providertypes.NewResult[*big.Int](price, time.Now().UTC())
+	// Attach the 24 hour volume and best bid and ask when they are included in the update.
+	var volume string
+	if len(resp.TickerData.Volume) > Last24HoursVolumeIndex {
+		volume = resp.TickerData.Volume[Last24HoursVolumeIndex]
+	}
+
+	resolved[cp] = providertypes.WithMarketData(
+		providertypes.NewResult[*big.Int](price, time.Now().UTC()),
+		market.GetDecimals(),
+		volume,
+		bestPrice(resp.TickerData.Bid),
+		bestPrice(resp.TickerData.Ask),
+	)
 	return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unResolved), nil
 }
@@ -151,3 +151,18 @@ func DecodeTickerResponseMessage(message []byte) (TickerResponseMessage, error)
 
 	return response, nil
 }
+
+// bestPrice returns the price from a ticker's best ask or bid array. An empty string is
+// returned if the array does not contain a price.
+func bestPrice(values []interface{}) string {
+	if len(values) <= BestPriceIndex {
+		return ""
+	}
+
+	price, ok := values[BestPriceIndex].(string)
+	if !ok {
+		return ""
+	}
+
+	return price
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/testutils"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 	"github.com/skip-mev/slinky/providers/websockets/kraken"
@@ -51,6 +52,27 @@ func TestHandleMessage(t *testing.T) {
 			msg: func() []byte {
 				return []byte(`[340,{"a":["42694.60000",31,"31.27308189"],"b":["42694.50000",1,"1.01355072"],"c":["42694.60000","0.00455773"],"v":["2068.49653432","2075.61202911"],"p":["42596.41907","42598.31137"],"t":[21771,22049],"l":["42190.20000","42190.20000"],"h":["43165.00000","43165.00000"],"o":["43134.70000","43159.20000"]},"ticker","XBT/USD"]`)
 			},
+			resp: providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int]{
+				Resolved: map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
+					oracletypes.NewCurrencyPair("BITCOIN", "USD"): {
+						Value:  big.NewInt(4259641907000),
+						Volume: big.NewFloat(2075.61202911),
+						Bid:    big.NewInt(4269450000000),
+						Ask:    big.NewInt(4269460000000),
+					},
+				},
+				UnResolved: map[oracletypes.CurrencyPair]error{},
+			},
+			updateMsg: func() []handlers.WebsocketEncodedMessage {
+				return nil
+			},
+			expectedErr: false,
+		},
+		{
+			name: "valid ticker response message without market data",
+			msg: func() []byte {
+				return []byte(`[340,{"p":["42596.41907","42598.31137"]},"ticker","XBT/USD"]`)
+			},
 			resp: providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int]{
 				Resolved: map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
 					oracletypes.NewCurrencyPair("BITCOIN", "USD"): {
@@ -231,6 +253,7 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value, resp.Resolved[cp].Value)
+				testutils.RequireMarketDataEqual(t, result, resp.Resolved[cp])
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -353,6 +376,9 @@ func TestDecodeTickerResponseMessage(t *testing.T) {
 			expected: kraken.TickerResponseMessage{
 				ChannelID: 340,
 				TickerData: kraken.TickerData{
+					Ask:                        []interface{}{"42694.60000", float64(31), "31.27308189"},
+					Bid:                        []interface{}{"42694.50000", float64(1), "1.01355072"},
+					Volume:                     []string{"2068.49653432", "2075.61202911"},
 					VolumeWeightedAveragePrice: []string{"42596.41907", "42598.31137"},
 				},
 				ChannelName: "ticker",
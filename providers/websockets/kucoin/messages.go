@@ -207,6 +207,12 @@ type TickerResponseMessageData struct {
 
 	// Price is the last traded price.
 	Price string `json:"price"`
+
+	// BestAsk is the best ask price.
+	BestAsk string `json:"bestAsk"`
+
+	// BestBid is the best bid price.
+	BestBid string `json:"bestBid"`
 }
 
 const (
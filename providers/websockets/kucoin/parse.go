@@ -68,13 +68,22 @@ func (h *WebSocketDataHandler) parseTickerResponseMessage(
 	}
 
 	// Parse the price from the message.
-	price, err := math.Float64StringToBigInt(msg.Data.Price, cp.Decimals())
+	price, err := math.Float64StringToBigInt(msg.Data.Price, market.GetDecimals())
 	if err != nil {
 		err = fmt.Errorf("failed to parse price %w", err)
 		unResolved[cp] = err
 		return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unResolved), err
 	}
 
-	resolved[cp] = providertypes.NewResult[*big.Int](price, time.Now())
+	// The ticker channel does not include the 24 hour volume, so only the best bid and ask are
+	// attached. In volume-weighted feeds, prices from this provider carry the median of the
+	// volumes reported by the other providers.
+	resolved[cp] = providertypes.WithMarketData(
+		providertypes.NewResult[*big.Int](price, time.Now()),
+		market.GetDecimals(),
+		"",
+		msg.Data.BestBid,
+		msg.Data.BestAsk,
+	)
 	return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unResolved), nil
 }
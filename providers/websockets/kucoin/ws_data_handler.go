@@ -136,3 +136,13 @@ func (h *WebSocketDataHandler) CreateMessages(
 func (h *WebSocketDataHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return NewHeartbeatMessage()
 }
+
+// Copy is used to create a copy of the data handler. The copy does not share the sequence numbers
+// of the original.
+func (h *WebSocketDataHandler) Copy() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+	return &WebSocketDataHandler{
+		cfg:       h.cfg,
+		logger:    h.logger,
+		sequences: make(map[oracletypes.CurrencyPair]int64),
+	}
+}
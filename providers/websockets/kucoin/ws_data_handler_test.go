@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/skip-mev/slinky/providers/base/testutils"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 	"github.com/skip-mev/slinky/providers/websockets/kucoin"
@@ -107,7 +108,9 @@ func TestHandleMessage(t *testing.T) {
 					"subject": "trade.ticker",
 					"data": {
 						"sequence": "1",
-						"price": "0.1"
+						"price": "0.1",
+						"bestAsk": "0.11",
+						"bestBid": "0.09"
 					}
 				}`)
 			},
@@ -115,6 +118,8 @@ func TestHandleMessage(t *testing.T) {
 				Resolved: map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
 					oracletypes.NewCurrencyPair("BITCOIN", "USD"): {
 						Value: big.NewInt(10000000),
+						Bid:   big.NewInt(9000000),
+						Ask:   big.NewInt(11000000),
 					},
 				},
 			},
@@ -277,6 +282,7 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value, resp.Resolved[cp].Value)
+				testutils.RequireMarketDataEqual(t, result, resp.Resolved[cp])
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -27,8 +27,8 @@ const (
 )
 
 const (
-	// IndexTickersChannel is the channel for mark price updates.
-	IndexTickersChannel Channel = "index-tickers"
+	// TickersChannel is the channel for spot ticker updates.
+	TickersChannel Channel = "tickers"
 )
 
 const (
@@ -61,7 +61,7 @@ type BaseMessage struct {
 //		"op": "subscribe",
 //		"args": [
 //			{
-//				"channel": "index-tickers",
+//				"channel": "tickers",
 //				"instId": "LTC-USD-200327"
 //			},
 //			{
@@ -126,7 +126,7 @@ func NewSubscribeToTickersRequestMessage(
 //	{
 //			"event": "error",
 //			"code": "60012",
-//			"msg": "Invalid request: {\"op\": \"subscribe\", \"argss\":[{ \"channel\" : \"index-tickers\", \"instId\" : \"BTC-USDT\"}]}",
+//			"msg": "Invalid request: {\"op\": \"subscribe\", \"argss\":[{ \"channel\" : \"tickers\", \"instId\" : \"BTC-USDT\"}]}",
 //			"connId": "a4d3ae55"
 //	}
 //
@@ -149,44 +149,60 @@ type SubscribeResponseMessage struct {
 	Message string `json:"msg,omitempty"`
 }
 
-// IndexTickersResponseMessage is the response message for index ticker updates. This message
-// type is sent when the index price changes. Price changes are pushed every 100ms if there
-// is a change in price. Otherwise, the message is sent every second. The format of the message
-// is:
+// TickersResponseMessage is the response message for ticker updates. This message type is
+// sent when there is a trade or a change in the best bid or ask, at most once every 100ms. The
+// format of the message is:
 //
 //	{
 //		"arg": {
-//	  		"channel": "index-tickers",
+//	  		"channel": "tickers",
 //	  		"instId": "BTC-USDT"
 //		},
 //		"data": [
 //	  		{
+//				"instType": "SPOT",
 //				"instId": "BTC-USDT",
-//				"idxPx": "0.1",
-//				"high24h": "0.5",
-//				"low24h": "0.1",
-//				"open24h": "0.1",
-//				"sodUtc0": "0.1",
-//				"sodUtc8": "0.1",
+//				"last": "9999.99",
+//				"lastSz": "0.1",
+//				"askPx": "9999.99",
+//				"askSz": "11",
+//				"bidPx": "8888.88",
+//				"bidSz": "5",
+//				"open24h": "9000",
+//				"high24h": "10000",
+//				"low24h": "8888.88",
+//				"volCcy24h": "2222",
+//				"vol24h": "2222",
+//				"sodUtc0": "2222",
+//				"sodUtc8": "2222",
 //				"ts": "1597026383085"
 //	  		}
 //		]
 //	}
 //
-// For more information, see https://www.okx.com/docs-v5/en/?shell#public-data-websocket-index-tickers-channel
-type IndexTickersResponseMessage struct {
+// For more information, see https://www.okx.com/docs-v5/en/?shell#order-book-trading-market-data-ws-tickers-channel
+type TickersResponseMessage struct {
 	// Arguments is the list of arguments for the operation.
 	Arguments SubscriptionTopic `json:"arg" validate:"required"`
 
-	// Data is the list of index ticker data.
-	Data []IndexTicker `json:"data" validate:"required"`
+	// Data is the list of ticker data.
+	Data []Ticker `json:"data" validate:"required"`
 }
 
-// IndexTicker is the index ticker data.
-type IndexTicker struct {
+// Ticker is the ticker data.
+type Ticker struct {
 	// InstrumentID is the instrument ID.
 	InstrumentID string `json:"instId" validate:"required"`
 
-	// IndexPrice is the index price.
-	IndexPrice string `json:"idxPx" validate:"required"`
+	// LastPrice is the last traded price.
+	LastPrice string `json:"last" validate:"required"`
+
+	// Volume is the 24 hour trading volume in the base currency.
+	Volume string `json:"vol24h"`
+
+	// BidPrice is the best bid price.
+	BidPrice string `json:"bidPx"`
+
+	// AskPrice is the best ask price.
+	AskPrice string `json:"askPx"`
 }
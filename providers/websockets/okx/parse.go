@@ -40,7 +40,7 @@ func (h *WebsocketDataHandler) parseSubscribeResponseMessage(resp SubscribeRespo
 	// Attempt to re-subscribe to the channel.
 	// Format of the message is:
 	//  ...
-	//	"msg": "Invalid request: {\"op\": \"subscribe\", \"args\":[{ \"channel\" : \"index-tickers\", \"instId\" : \"BTC-USDT\"}]}",
+	//	"msg": "Invalid request: {\"op\": \"subscribe\", \"args\":[{ \"channel\" : \"tickers\", \"instId\" : \"BTC-USDT\"}]}",
 	//  ...
 	//
 	// The message is an exact copy of the request message, so we can just unmarshal it and re-subscribe.
@@ -69,15 +69,15 @@ func (h *WebsocketDataHandler) parseSubscribeResponseMessage(resp SubscribeRespo
 // parseTickerResponseMessage parses a ticker response message. The format of the message is defined
 // in the messages.go file. This message contains the latest price data for a set of instruments.
 func (h *WebsocketDataHandler) parseTickerResponseMessage(
-	resp IndexTickersResponseMessage,
+	resp TickersResponseMessage,
 ) (providertypes.GetResponse[oracletypes.CurrencyPair, *big.Int], error) {
 	var (
 		resolved   = make(map[oracletypes.CurrencyPair]providertypes.Result[*big.Int])
 		unresolved = make(map[oracletypes.CurrencyPair]error)
 	)
 
-	// The channel must be the index tickers channel.
-	if Channel(resp.Arguments.Channel) != IndexTickersChannel {
+	// The channel must be the tickers channel.
+	if Channel(resp.Arguments.Channel) != TickersChannel {
 		return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unresolved),
 			fmt.Errorf("invalid channel %s", resp.Arguments.Channel)
 	}
@@ -92,14 +92,20 @@ func (h *WebsocketDataHandler) parseTickerResponseMessage(
 
 		// Convert the price to a big.Int.
 		cp := market.CurrencyPair
-		price, err := math.Float64StringToBigInt(ticker.IndexPrice, cp.Decimals())
+		price, err := math.Float64StringToBigInt(ticker.LastPrice, market.GetDecimals())
 		if err != nil {
 			h.logger.Error("failed to convert price to big.Int", zap.Error(err))
 			unresolved[cp] = fmt.Errorf("failed to convert price to big.Int: %w", err)
 			continue
 		}
 
-		resolved[cp] = providertypes.NewResult[*big.Int](price, time.Now().UTC())
+		resolved[cp] = providertypes.WithMarketData(
+			providertypes.NewResult[*big.Int](price, time.Now().UTC()),
+			market.GetDecimals(),
+			ticker.Volume,
+			ticker.BidPrice,
+			ticker.AskPrice,
+		)
 	}
 
 	return providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](resolved, unresolved), nil
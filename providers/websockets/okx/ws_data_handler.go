@@ -96,7 +96,7 @@ func (h *WebsocketDataHandler) HandleMessage(
 	case eventType == EventTickers:
 		h.logger.Debug("received ticker response message")
 
-		var tickerMessage IndexTickersResponseMessage
+		var tickerMessage TickersResponseMessage
 		if err := json.Unmarshal(message, &tickerMessage); err != nil {
 			h.logger.Error("failed to unmarshal ticker response message", zap.Error(err))
 			return resp, nil, fmt.Errorf("failed to unmarshal ticker response message: %w", err)
@@ -117,7 +117,7 @@ func (h *WebsocketDataHandler) HandleMessage(
 
 // CreateMessages is used to create an initial subscription message to send to the data provider.
 // Only the currency pairs that are specified in the config are subscribed to. The only channel
-// that is subscribed to is the index tickers channel - which supports spot markets.
+// that is subscribed to is the tickers channel - which supports spot markets.
 func (h *WebsocketDataHandler) CreateMessages(
 	cps []oracletypes.CurrencyPair,
 ) ([]handlers.WebsocketEncodedMessage, error) {
@@ -131,7 +131,7 @@ func (h *WebsocketDataHandler) CreateMessages(
 		}
 
 		instruments = append(instruments, SubscriptionTopic{
-			Channel:      string(IndexTickersChannel),
+			Channel:      string(TickersChannel),
 			InstrumentID: market.Ticker,
 		})
 	}
@@ -144,3 +144,11 @@ func (h *WebsocketDataHandler) CreateMessages(
 func (h *WebsocketDataHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
 }
+
+// Copy is used to create a copy of the data handler.
+func (h *WebsocketDataHandler) Copy() handlers.WebSocketDataHandler[oracletypes.CurrencyPair, *big.Int] {
+	return &WebsocketDataHandler{
+		cfg:    h.cfg,
+		logger: h.logger,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/testutils"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 	"github.com/skip-mev/slinky/providers/websockets/okx"
@@ -74,15 +75,18 @@ func TestHandlerMessage(t *testing.T) {
 		{
 			name: "instrument price update",
 			msg: func() []byte {
-				msg := okx.IndexTickersResponseMessage{
+				msg := okx.TickersResponseMessage{
 					Arguments: okx.SubscriptionTopic{
-						Channel:      string(okx.IndexTickersChannel),
+						Channel:      string(okx.TickersChannel),
 						InstrumentID: "BTC-USDT",
 					},
-					Data: []okx.IndexTicker{
+					Data: []okx.Ticker{
 						{
 							InstrumentID: "BTC-USDT",
-							IndexPrice:   "1",
+							LastPrice:    "1",
+							Volume:       "9110.473081735",
+							BidPrice:     "0.99",
+							AskPrice:     "1.01",
 						},
 					},
 				}
@@ -95,7 +99,10 @@ func TestHandlerMessage(t *testing.T) {
 			resp: providertypes.NewGetResponse[oracletypes.CurrencyPair, *big.Int](
 				map[oracletypes.CurrencyPair]providertypes.Result[*big.Int]{
 					oracletypes.NewCurrencyPair("BITCOIN", "USDT"): {
-						Value: big.NewInt(100000000),
+						Value:  big.NewInt(100000000),
+						Volume: big.NewFloat(9110.473081735),
+						Bid:    big.NewInt(99000000),
+						Ask:    big.NewInt(101000000),
 					},
 				},
 				map[oracletypes.CurrencyPair]error{},
@@ -106,19 +113,19 @@ func TestHandlerMessage(t *testing.T) {
 		{
 			name: "multiple instruments included in the response",
 			msg: func() []byte {
-				msg := okx.IndexTickersResponseMessage{
+				msg := okx.TickersResponseMessage{
 					Arguments: okx.SubscriptionTopic{
-						Channel:      string(okx.IndexTickersChannel),
+						Channel:      string(okx.TickersChannel),
 						InstrumentID: "BTC-USDT",
 					},
-					Data: []okx.IndexTicker{
+					Data: []okx.Ticker{
 						{
 							InstrumentID: "BTC-USDT",
-							IndexPrice:   "1",
+							LastPrice:    "1",
 						},
 						{
 							InstrumentID: "ETH-USDT",
-							IndexPrice:   "2",
+							LastPrice:    "2",
 						},
 					},
 				}
@@ -145,15 +152,15 @@ func TestHandlerMessage(t *testing.T) {
 		{
 			name: "instrument price update with unknown instrument ID",
 			msg: func() []byte {
-				msg := okx.IndexTickersResponseMessage{
+				msg := okx.TickersResponseMessage{
 					Arguments: okx.SubscriptionTopic{
-						Channel:      string(okx.IndexTickersChannel),
+						Channel:      string(okx.TickersChannel),
 						InstrumentID: "MOG-USDT",
 					},
-					Data: []okx.IndexTicker{
+					Data: []okx.Ticker{
 						{
 							InstrumentID: "MOG-USDT",
-							IndexPrice:   "1",
+							LastPrice:    "1",
 						},
 					},
 				}
@@ -175,7 +182,7 @@ func TestHandlerMessage(t *testing.T) {
 			msg: func() []byte {
 				msg := okx.SubscribeResponseMessage{
 					Arguments: okx.SubscriptionTopic{
-						Channel:      string(okx.IndexTickersChannel),
+						Channel:      string(okx.TickersChannel),
 						InstrumentID: "BTC-USDT",
 					},
 					Event:        string(okx.EventSubscribe),
@@ -201,7 +208,7 @@ func TestHandlerMessage(t *testing.T) {
 					Operation: string(okx.OperationSubscribe),
 					Arguments: []okx.SubscriptionTopic{
 						{
-							Channel:      string(okx.IndexTickersChannel),
+							Channel:      string(okx.TickersChannel),
 							InstrumentID: "BTC-USDT",
 						},
 					},
@@ -232,7 +239,7 @@ func TestHandlerMessage(t *testing.T) {
 					Operation: string(okx.OperationSubscribe),
 					Arguments: []okx.SubscriptionTopic{
 						{
-							Channel:      string(okx.IndexTickersChannel),
+							Channel:      string(okx.TickersChannel),
 							InstrumentID: "BTC-USDT",
 						},
 					},
@@ -274,7 +281,7 @@ func TestHandlerMessage(t *testing.T) {
 					Operation: string(okx.OperationSubscribe),
 					Arguments: []okx.SubscriptionTopic{
 						{
-							Channel:      string(okx.IndexTickersChannel),
+							Channel:      string(okx.TickersChannel),
 							InstrumentID: "BTC-USDT",
 						},
 					},
@@ -324,6 +331,7 @@ func TestHandlerMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value, resp.Resolved[cp].Value)
+				testutils.RequireMarketDataEqual(t, result, resp.Resolved[cp])
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -359,7 +367,7 @@ func TestCreateMessage(t *testing.T) {
 					Operation: string(okx.OperationSubscribe),
 					Arguments: []okx.SubscriptionTopic{
 						{
-							Channel:      string(okx.IndexTickersChannel),
+							Channel:      string(okx.TickersChannel),
 							InstrumentID: "BTC-USDT",
 						},
 					},
@@ -383,11 +391,11 @@ func TestCreateMessage(t *testing.T) {
 					Operation: string(okx.OperationSubscribe),
 					Arguments: []okx.SubscriptionTopic{
 						{
-							Channel:      string(okx.IndexTickersChannel),
+							Channel:      string(okx.TickersChannel),
 							InstrumentID: "BTC-USDT",
 						},
 						{
-							Channel:      string(okx.IndexTickersChannel),
+							Channel:      string(okx.TickersChannel),
 							InstrumentID: "ETH-USDT",
 						},
 					},
@@ -2,13 +2,17 @@ package oracle
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/url"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"cosmossdk.io/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -30,14 +34,17 @@ type GRPCClient struct {
 	addr string
 	// underlying oracle client
 	client types.OracleClient
-	// underlying grpc connection
-	conn *grpc.ClientConn
+	// underlying grpc connection. This is not guarded by the mutex, which is held for the duration
+	// of each request, so that the state of the connection can be read while a request is in flight.
+	conn atomic.Pointer[grpc.ClientConn]
 	// timeout for the client, Price requests will block for this duration.
 	timeout time.Duration
 	// metrics contains the instrumentation for the oracle client
 	metrics metrics.Metrics
 	// blockingDial is a parameter which determines whether the client should block on dialing the server
 	blockingDial bool
+	// tlsConfig is the TLS config used to dial the server. The connection is insecure if this is nil.
+	tlsConfig *tls.Config
 }
 
 // NewClientFromConfig creates a new grpc client of the oracle service with the given
@@ -64,6 +71,26 @@ func NewClientFromConfig(
 		return nil, fmt.Errorf("metrics cannot be nil")
 	}
 
+	// connect to the oracle sidecars over TLS if enabled
+	if cfg.TLS.Enabled {
+		tlsConfig, err := cfg.TLS.ClientTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append([]Option{WithTLSConfig(tlsConfig)}, opts...)
+	}
+
+	// fail over between the oracle endpoints if fallbacks or hedged requests are configured
+	if len(cfg.FallbackOracleAddresses) > 0 || cfg.HedgeFraction > 0 {
+		opts = append([]Option{
+			WithHedgeDelay(cfg.GetHedgeDelay()),
+			WithFailoverCooldown(cfg.GetFailoverCooldown()),
+		}, opts...)
+
+		return NewFailoverClient(logger, cfg.GetOracleAddresses(), cfg.ClientTimeout, metrics, opts...)
+	}
+
 	return NewClient(logger, cfg.OracleAddress, cfg.ClientTimeout, metrics, opts...)
 }
 
@@ -112,8 +139,13 @@ func NewClient(
 func (c *GRPCClient) Start(ctx context.Context) error {
 	c.logger.Info("starting oracle client", "addr", c.addr)
 
+	creds := insecure.NewCredentials()
+	if c.tlsConfig != nil {
+		creds = credentials.NewTLS(c.tlsConfig)
+	}
+
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	}
 
 	if c.blockingDial {
@@ -144,7 +176,7 @@ func (c *GRPCClient) Start(ctx context.Context) error {
 
 	c.mutex.Lock()
 	c.client = types.NewOracleClient(conn)
-	c.conn = conn
+	c.conn.Store(conn)
 	c.mutex.Unlock()
 
 	c.logger.Info("oracle client started")
@@ -158,11 +190,12 @@ func (c *GRPCClient) Stop() error {
 	defer c.mutex.Unlock()
 
 	c.logger.Info("stopping oracle client")
-	if c.conn == nil {
+	conn := c.conn.Load()
+	if conn == nil {
 		return nil
 	}
 
-	err := c.conn.Close()
+	err := conn.Close()
 	c.logger.Info("oracle client stopped", "err", err)
 
 	return err
@@ -173,7 +206,7 @@ func (c *GRPCClient) Stop() error {
 func (c *GRPCClient) Prices(
 	ctx context.Context,
 	req *types.QueryPricesRequest,
-	_ ...grpc.CallOption,
+	opts ...grpc.CallOption,
 ) (resp *types.QueryPricesResponse, err error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -183,6 +216,7 @@ func (c *GRPCClient) Prices(
 		// Observe the duration of the call as well as the error.
 		c.metrics.ObserveOracleResponseLatency(time.Since(start))
 		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+		c.metrics.AddOracleEndpointResponse(c.addr, metrics.StatusFromError(err))
 	}()
 
 	// set deadline on the context
@@ -193,5 +227,73 @@ func (c *GRPCClient) Prices(
 		return nil, fmt.Errorf("oracle client not started")
 	}
 
-	return c.client.Prices(ctx, req, grpc.WaitForReady(true))
+	return c.client.Prices(ctx, req, callOptions(opts)...)
+}
+
+// PriceDetails returns the prices from the remote oracle service along with the provider prices that
+// each price was derived from. This method blocks for the timeout duration configured on the client.
+func (c *GRPCClient) PriceDetails(
+	ctx context.Context,
+	req *types.QueryPriceDetailsRequest,
+	opts ...grpc.CallOption,
+) (*types.QueryPriceDetailsResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.PriceDetails(ctx, req, callOptions(opts)...)
+}
+
+// PriceHistory returns the prices reported by the remote oracle service in past updates, along with the
+// provider prices that each price was derived from. This method blocks for the timeout duration configured on
+// the client.
+func (c *GRPCClient) PriceHistory(
+	ctx context.Context,
+	req *types.QueryPriceHistoryRequest,
+	opts ...grpc.CallOption,
+) (*types.QueryPriceHistoryResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.PriceHistory(ctx, req, callOptions(opts)...)
+}
+
+// StreamPrices opens a stream of prices from the remote oracle service. A response is received every
+// time the oracle updates its prices. Unlike Prices, the client timeout is not applied; the stream is
+// open until the given context is cancelled.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.StreamPricesRequest,
+	opts ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	c.mutex.Lock()
+	client := c.client
+	c.mutex.Unlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return client.StreamPrices(ctx, req, callOptions(opts)...)
+}
+
+// callOptions returns the given call options with WaitForReady enabled, such that requests wait for
+// the connection to the remote oracle to be ready rather than failing fast.
+func callOptions(opts []grpc.CallOption) []grpc.CallOption {
+	return append(slices.Clip(opts), grpc.WaitForReady(true))
 }
@@ -0,0 +1,392 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/service/metrics"
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+var _ OracleClient = (*FailoverClient)(nil)
+
+// FailoverClient defines an implementation of an oracle client that fails over between
+// multiple remote oracle servers i.e. a primary sidecar and any number of fallback
+// sidecars. Endpoints are queried in priority order, where endpoints that recently failed
+// are deprioritized for a cooldown period. Requests can optionally be hedged, such that
+// the next endpoint is queried if the current endpoint has not responded within the hedge
+// delay. The first successful response is returned.
+type FailoverClient struct {
+	logger log.Logger
+
+	// endpoints of the remote oracle servers in priority order
+	endpoints []*endpoint
+	// timeout for the client, requests will block for at most this duration across all endpoints.
+	timeout time.Duration
+	// hedgeDelay is the time after which the next endpoint is queried if the current endpoint
+	// has not responded yet. Requests are not hedged if this is zero.
+	hedgeDelay time.Duration
+	// cooldown is the time that an endpoint is deprioritized for after a failed request.
+	cooldown time.Duration
+	// metrics contains the instrumentation for the oracle client
+	metrics metrics.Metrics
+}
+
+// endpoint wraps the client of a single remote oracle server along with its health.
+type endpoint struct {
+	addr   string
+	client *GRPCClient
+
+	mtx sync.Mutex
+	// failures is the number of consecutive failed requests to the endpoint.
+	failures uint64
+	// unhealthyUntil is the time until which the endpoint is deprioritized.
+	unhealthyUntil time.Time
+}
+
+// NewFailoverClient creates a new oracle client that fails over between the given addresses,
+// in priority order. The timeout bounds each request across all endpoints, and is split between
+// the endpoints such that every endpoint can be queried within it.
+func NewFailoverClient(
+	logger log.Logger,
+	addrs []string,
+	timeout time.Duration,
+	m metrics.Metrics,
+	opts ...Option,
+) (OracleClient, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("at least one oracle address is required")
+	}
+
+	if m == nil {
+		return nil, fmt.Errorf("metrics cannot be nil")
+	}
+
+	if timeout <= 0 {
+		return nil, fmt.Errorf("timeout must be positive")
+	}
+
+	client := &FailoverClient{
+		logger:    logger,
+		endpoints: make([]*endpoint, 0, len(addrs)),
+		timeout:   timeout,
+		cooldown:  config.DefaultFailoverCooldown,
+		metrics:   m,
+	}
+
+	seen := make(map[string]struct{})
+	for _, addr := range addrs {
+		if _, ok := seen[addr]; ok {
+			return nil, fmt.Errorf("duplicate oracle address %s", addr)
+		}
+		seen[addr] = struct{}{}
+
+		// the failover client instruments the requests, so that each request is only counted once
+		c, err := NewClient(logger.With("addr", addr), addr, timeout, metrics.NewNopMetrics(), opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		client.endpoints = append(client.endpoints, &endpoint{
+			addr:   addr,
+			client: c.(*GRPCClient),
+		})
+	}
+
+	// apply options
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if client.hedgeDelay < 0 {
+		return nil, fmt.Errorf("hedge delay cannot be negative")
+	}
+
+	if client.cooldown < 0 {
+		return nil, fmt.Errorf("failover cooldown cannot be negative")
+	}
+
+	return client, nil
+}
+
+// Start starts the client of each endpoint. This method only errors if none of the endpoints
+// could be started, so that the application can start while a fallback sidecar is down.
+func (c *FailoverClient) Start(ctx context.Context) error {
+	var (
+		errs    []error
+		started int
+	)
+	for _, ep := range c.endpoints {
+		if err := ep.client.Start(ctx); err != nil {
+			errs = append(errs, err)
+			ep.recordFailure(c.logger, c.cooldown, err)
+			continue
+		}
+
+		started++
+	}
+
+	if started == 0 {
+		return fmt.Errorf("failed to start any oracle client: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Stop stops the client of each endpoint.
+func (c *FailoverClient) Stop() error {
+	errs := make([]error, 0, len(c.endpoints))
+	for _, ep := range c.endpoints {
+		errs = append(errs, ep.client.Stop())
+	}
+
+	return errors.Join(errs...)
+}
+
+// Prices returns the prices from the first remote oracle service that responds successfully. The
+// endpoint that served the request is recorded in the metrics.
+func (c *FailoverClient) Prices(
+	ctx context.Context,
+	req *types.QueryPricesRequest,
+	opts ...grpc.CallOption,
+) (resp *types.QueryPricesResponse, err error) {
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	resp, addr, err := failover(ctx, c, func(ctx context.Context, client *GRPCClient) (*types.QueryPricesResponse, error) {
+		return client.Prices(ctx, req, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.metrics.AddOracleEndpointResponse(addr, metrics.Success{})
+	return resp, nil
+}
+
+// PriceDetails returns the price details from the first remote oracle service that responds
+// successfully.
+func (c *FailoverClient) PriceDetails(
+	ctx context.Context,
+	req *types.QueryPriceDetailsRequest,
+	opts ...grpc.CallOption,
+) (*types.QueryPriceDetailsResponse, error) {
+	resp, _, err := failover(ctx, c, func(ctx context.Context, client *GRPCClient) (*types.QueryPriceDetailsResponse, error) {
+		return client.PriceDetails(ctx, req, opts...)
+	})
+
+	return resp, err
+}
+
+// PriceHistory returns the price history from the first remote oracle service that responds
+// successfully.
+func (c *FailoverClient) PriceHistory(
+	ctx context.Context,
+	req *types.QueryPriceHistoryRequest,
+	opts ...grpc.CallOption,
+) (*types.QueryPriceHistoryResponse, error) {
+	resp, _, err := failover(ctx, c, func(ctx context.Context, client *GRPCClient) (*types.QueryPriceHistoryResponse, error) {
+		return client.PriceHistory(ctx, req, opts...)
+	})
+
+	return resp, err
+}
+
+// StreamPrices opens a stream of prices from the first remote oracle service, in priority order,
+// that accepts the stream. Requests are not hedged, and the stream does not fail over once it is
+// open; callers are expected to re-open the stream if it is closed.
+func (c *FailoverClient) StreamPrices(
+	ctx context.Context,
+	req *types.StreamPricesRequest,
+	opts ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	var errs []error
+	for _, ep := range c.orderedEndpoints() {
+		stream, err := ep.client.StreamPrices(ctx, req, opts...)
+		if err == nil {
+			ep.recordSuccess(c.logger)
+			return stream, nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", ep.addr, err))
+		if ctx.Err() != nil {
+			break
+		}
+
+		ep.recordFailure(c.logger, c.cooldown, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// result is the response of a single endpoint to a request.
+type result[T any] struct {
+	ep   *endpoint
+	resp T
+	err  error
+}
+
+// failover issues the given request to the endpoints of the client in priority order, and returns
+// the first successful response along with the address of the endpoint that served it. The next
+// endpoint is queried as soon as the current endpoint fails or, if requests are hedged, once the
+// hedge delay has elapsed. Each request is bounded by an equal share of the time remaining for the
+// endpoints that have not been queried, such that a request that times out leaves time for the
+// fallbacks. Outstanding requests are cancelled once a response is returned.
+func failover[T any](
+	ctx context.Context,
+	c *FailoverClient,
+	call func(context.Context, *GRPCClient) (T, error),
+) (T, string, error) {
+	var zero T
+
+	parent := ctx
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	endpoints := c.orderedEndpoints()
+	results := make(chan result[T], len(endpoints))
+
+	deadline, _ := ctx.Deadline()
+
+	var next, pending int
+	launch := func() {
+		ep := endpoints[next]
+		next++
+		pending++
+
+		// Split the remaining time across this endpoint and the endpoints that have not been
+		// queried yet, so that an unreachable or slow endpoint cannot use up the time of the
+		// endpoints after it.
+		attemptCtx, attemptCancel := context.WithTimeout(ctx, time.Until(deadline)/time.Duration(len(endpoints)-next+1))
+
+		go func() {
+			defer attemptCancel()
+
+			resp, err := call(attemptCtx, ep.client)
+			results <- result[T]{ep: ep, resp: resp, err: err}
+		}()
+	}
+	launch()
+
+	var errs []error
+	for pending > 0 {
+		var (
+			hedge <-chan time.Time
+			timer *time.Timer
+		)
+		if c.hedgeDelay > 0 && next < len(endpoints) {
+			timer = time.NewTimer(c.hedgeDelay)
+			hedge = timer.C
+		}
+
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				if timer != nil {
+					timer.Stop()
+				}
+
+				r.ep.recordSuccess(c.logger)
+				return r.resp, r.ep.addr, nil
+			}
+
+			errs = append(errs, fmt.Errorf("%s: %w", r.ep.addr, r.err))
+
+			// the endpoint is not at fault if the caller cancelled the request
+			if parent.Err() == nil {
+				r.ep.recordFailure(c.logger, c.cooldown, r.err)
+				c.metrics.AddOracleEndpointResponse(r.ep.addr, metrics.Failure{})
+			}
+
+			if ctx.Err() == nil && next < len(endpoints) {
+				launch()
+			}
+		case <-hedge:
+			c.logger.Debug("hedging oracle request", "addr", endpoints[next].addr)
+			launch()
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+
+	return zero, "", errors.Join(errs...)
+}
+
+// orderedEndpoints returns the endpoints of the client in the order they should be queried,
+// i.e. the healthy endpoints in priority order followed by the unhealthy endpoints in priority
+// order.
+func (c *FailoverClient) orderedEndpoints() []*endpoint {
+	now := time.Now()
+
+	healthy := make([]*endpoint, 0, len(c.endpoints))
+	var unhealthy []*endpoint
+	for _, ep := range c.endpoints {
+		if ep.healthy(now) {
+			healthy = append(healthy, ep)
+		} else {
+			unhealthy = append(unhealthy, ep)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// healthy returns true if the endpoint has not failed within its cooldown period, and its
+// connection is not known to be failing.
+func (ep *endpoint) healthy(now time.Time) bool {
+	ep.mtx.Lock()
+	unhealthyUntil := ep.unhealthyUntil
+	ep.mtx.Unlock()
+
+	if now.Before(unhealthyUntil) {
+		return false
+	}
+
+	conn := ep.client.conn.Load()
+	return conn == nil || conn.GetState() != connectivity.TransientFailure
+}
+
+// recordSuccess resets the health of the endpoint after a successful request.
+func (ep *endpoint) recordSuccess(logger log.Logger) {
+	ep.mtx.Lock()
+	defer ep.mtx.Unlock()
+
+	if ep.failures > 0 {
+		logger.Info("oracle endpoint recovered", "addr", ep.addr, "failures", ep.failures)
+	}
+
+	ep.failures = 0
+	ep.unhealthyUntil = time.Time{}
+}
+
+// recordFailure deprioritizes the endpoint for the cooldown period after a failed request.
+func (ep *endpoint) recordFailure(logger log.Logger, cooldown time.Duration, err error) {
+	ep.mtx.Lock()
+	defer ep.mtx.Unlock()
+
+	if ep.failures == 0 {
+		logger.Info("oracle endpoint unhealthy", "addr", ep.addr, "cooldown", cooldown, "err", err)
+	}
+
+	ep.failures++
+	ep.unhealthyUntil = time.Now().Add(cooldown)
+}
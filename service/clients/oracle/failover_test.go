@@ -0,0 +1,309 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	client "github.com/skip-mev/slinky/service/clients/oracle"
+	"github.com/skip-mev/slinky/service/metrics"
+	metricsmocks "github.com/skip-mev/slinky/service/metrics/mocks"
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+const timeout = 500 * time.Millisecond
+
+// stubServer is an oracle server that responds with its address after the configured delay, or
+// with the configured error.
+type stubServer struct {
+	types.UnimplementedOracleServer
+
+	addr  string
+	delay time.Duration
+	err   error
+	calls atomic.Int32
+}
+
+func (s *stubServer) Prices(ctx context.Context, _ *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	s.calls.Add(1)
+
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs("addr", s.addr)); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPricesResponse{Prices: map[string]string{"addr": s.addr}}, nil
+}
+
+// startStubServer starts a stub oracle server on a random local port.
+func startStubServer(t *testing.T, delay time.Duration, err error) *stubServer {
+	t.Helper()
+
+	lis, lisErr := net.Listen("tcp", "localhost:0")
+	require.NoError(t, lisErr)
+
+	srv := &stubServer{
+		addr:  fmt.Sprintf("localhost:%d", lis.Addr().(*net.TCPAddr).Port),
+		delay: delay,
+		err:   err,
+	}
+
+	grpcSrv := grpc.NewServer()
+	types.RegisterOracleServer(grpcSrv, srv)
+	go grpcSrv.Serve(lis) //nolint:errcheck
+	t.Cleanup(grpcSrv.Stop)
+
+	return srv
+}
+
+// newFailoverClient creates and starts a failover client for the given servers.
+func newFailoverClient(t *testing.T, m metrics.Metrics, servers []*stubServer, opts ...client.Option) client.OracleClient {
+	t.Helper()
+
+	addrs := make([]string, len(servers))
+	for i, srv := range servers {
+		addrs[i] = srv.addr
+	}
+
+	c, err := client.NewFailoverClient(log.NewTestLogger(t), addrs, timeout, m, opts...)
+	require.NoError(t, err)
+	require.NoError(t, c.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, c.Stop()) })
+
+	return c
+}
+
+func TestNewFailoverClient(t *testing.T) {
+	logger := log.NewNopLogger()
+	nop := metrics.NewNopMetrics()
+
+	testCases := []struct {
+		name    string
+		addrs   []string
+		timeout time.Duration
+		opts    []client.Option
+		err     bool
+	}{
+		{
+			name:    "valid addresses",
+			addrs:   []string{"localhost:8080", "localhost:8081"},
+			timeout: timeout,
+		},
+		{
+			name:    "no addresses",
+			timeout: timeout,
+			err:     true,
+		},
+		{
+			name:    "duplicate addresses",
+			addrs:   []string{"localhost:8080", "localhost:8080"},
+			timeout: timeout,
+			err:     true,
+		},
+		{
+			name:    "invalid address",
+			addrs:   []string{"localhost:8080", ""},
+			timeout: timeout,
+			err:     true,
+		},
+		{
+			name:  "zero timeout",
+			addrs: []string{"localhost:8080"},
+			err:   true,
+		},
+		{
+			name:    "negative hedge delay",
+			addrs:   []string{"localhost:8080"},
+			timeout: timeout,
+			opts:    []client.Option{client.WithHedgeDelay(-time.Second)},
+			err:     true,
+		},
+		{
+			name:    "negative failover cooldown",
+			addrs:   []string{"localhost:8080"},
+			timeout: timeout,
+			opts:    []client.Option{client.WithFailoverCooldown(-time.Second)},
+			err:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.NewFailoverClient(logger, tc.addrs, tc.timeout, nop, tc.opts...)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewClientFromConfig(t *testing.T) {
+	cfg := config.AppConfig{
+		Enabled:       true,
+		OracleAddress: "localhost:8080",
+		ClientTimeout: timeout,
+	}
+
+	c, err := client.NewClientFromConfig(cfg, log.NewNopLogger(), metrics.NewNopMetrics())
+	require.NoError(t, err)
+	require.IsType(t, &client.GRPCClient{}, c)
+
+	cfg.FallbackOracleAddresses = []string{"localhost:8081"}
+	c, err = client.NewClientFromConfig(cfg, log.NewNopLogger(), metrics.NewNopMetrics())
+	require.NoError(t, err)
+	require.IsType(t, &client.FailoverClient{}, c)
+}
+
+func TestFailoverClientPrices(t *testing.T) {
+	t.Run("primary serves the request", func(t *testing.T) {
+		primary := startStubServer(t, 0, nil)
+		secondary := startStubServer(t, 0, nil)
+
+		m := metricsmocks.NewMetrics(t)
+		m.On("ObserveOracleResponseLatency", mock.Anything).Return()
+		m.On("AddOracleResponse", metrics.Success{}).Return()
+		m.On("AddOracleEndpointResponse", primary.addr, metrics.Success{}).Return()
+
+		c := newFailoverClient(t, m, []*stubServer{primary, secondary})
+
+		resp, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, primary.addr, resp.Prices["addr"])
+		require.Equal(t, int32(0), secondary.calls.Load())
+	})
+
+	t.Run("fails over to the secondary and deprioritizes the primary", func(t *testing.T) {
+		primary := startStubServer(t, 0, fmt.Errorf("primary is down"))
+		secondary := startStubServer(t, 0, nil)
+
+		m := metricsmocks.NewMetrics(t)
+		m.On("ObserveOracleResponseLatency", mock.Anything).Return()
+		m.On("AddOracleResponse", metrics.Success{}).Return()
+		m.On("AddOracleEndpointResponse", primary.addr, metrics.Failure{}).Return().Once()
+		m.On("AddOracleEndpointResponse", secondary.addr, metrics.Success{}).Return().Twice()
+
+		c := newFailoverClient(t, m, []*stubServer{primary, secondary}, client.WithFailoverCooldown(time.Minute))
+
+		resp, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, secondary.addr, resp.Prices["addr"])
+
+		// the primary is skipped during its cooldown
+		resp, err = c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, secondary.addr, resp.Prices["addr"])
+		require.Equal(t, int32(1), primary.calls.Load())
+	})
+
+	t.Run("primary is queried again after its cooldown", func(t *testing.T) {
+		primary := startStubServer(t, 0, fmt.Errorf("primary is down"))
+		secondary := startStubServer(t, 0, nil)
+
+		c := newFailoverClient(t, metrics.NewNopMetrics(), []*stubServer{primary, secondary}, client.WithFailoverCooldown(50*time.Millisecond))
+
+		_, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+
+		time.Sleep(100 * time.Millisecond)
+
+		_, err = c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int32(2), primary.calls.Load())
+	})
+
+	t.Run("hedges the request to the secondary", func(t *testing.T) {
+		primary := startStubServer(t, 10*timeout, nil)
+		secondary := startStubServer(t, 0, nil)
+
+		c := newFailoverClient(t, metrics.NewNopMetrics(), []*stubServer{primary, secondary}, client.WithHedgeDelay(timeout/5))
+
+		start := time.Now()
+		resp, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, secondary.addr, resp.Prices["addr"])
+		require.Less(t, time.Since(start), timeout)
+		require.Equal(t, int32(1), primary.calls.Load())
+	})
+
+	t.Run("fails over from a slow primary within the timeout without hedging", func(t *testing.T) {
+		primary := startStubServer(t, 10*timeout, nil)
+		secondary := startStubServer(t, 0, nil)
+
+		c := newFailoverClient(t, metrics.NewNopMetrics(), []*stubServer{primary, secondary})
+
+		start := time.Now()
+		resp, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, secondary.addr, resp.Prices["addr"])
+
+		// the secondary is only queried once the primary's share of the timeout has elapsed
+		require.GreaterOrEqual(t, time.Since(start), timeout/2)
+		require.Less(t, time.Since(start), timeout)
+		require.Equal(t, int32(1), primary.calls.Load())
+	})
+
+	t.Run("fails over from an unreachable primary within the timeout", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "localhost:0")
+		require.NoError(t, err)
+		unreachable := &stubServer{addr: fmt.Sprintf("localhost:%d", lis.Addr().(*net.TCPAddr).Port)}
+		require.NoError(t, lis.Close())
+
+		secondary := startStubServer(t, 0, nil)
+
+		c := newFailoverClient(t, metrics.NewNopMetrics(), []*stubServer{unreachable, secondary})
+
+		start := time.Now()
+		resp, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, secondary.addr, resp.Prices["addr"])
+		require.Less(t, time.Since(start), timeout)
+	})
+
+	t.Run("forwards the call options", func(t *testing.T) {
+		primary := startStubServer(t, 0, nil)
+
+		c := newFailoverClient(t, metrics.NewNopMetrics(), []*stubServer{primary})
+
+		var header metadata.MD
+		_, err := c.Prices(context.Background(), &types.QueryPricesRequest{}, grpc.Header(&header))
+		require.NoError(t, err)
+		require.Equal(t, []string{primary.addr}, header.Get("addr"))
+	})
+
+	t.Run("all endpoints fail", func(t *testing.T) {
+		primary := startStubServer(t, 0, fmt.Errorf("primary is down"))
+		secondary := startStubServer(t, 0, fmt.Errorf("secondary is down"))
+
+		m := metricsmocks.NewMetrics(t)
+		m.On("ObserveOracleResponseLatency", mock.Anything).Return()
+		m.On("AddOracleResponse", metrics.Failure{}).Return()
+		m.On("AddOracleEndpointResponse", mock.Anything, metrics.Failure{}).Return().Twice()
+
+		c := newFailoverClient(t, m, []*stubServer{primary, secondary})
+
+		_, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.ErrorContains(t, err, "primary is down")
+		require.ErrorContains(t, err, "secondary is down")
+	})
+}
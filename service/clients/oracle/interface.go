@@ -46,3 +46,30 @@ func (NoOpClient) Prices(
 ) (*types.QueryPricesResponse, error) {
 	return nil, nil
 }
+
+// PriceDetails is a no-op.
+func (NoOpClient) PriceDetails(
+	_ context.Context,
+	_ *types.QueryPriceDetailsRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPriceDetailsResponse, error) {
+	return nil, nil
+}
+
+// PriceHistory is a no-op.
+func (NoOpClient) PriceHistory(
+	_ context.Context,
+	_ *types.QueryPriceHistoryRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPriceHistoryResponse, error) {
+	return nil, nil
+}
+
+// StreamPrices is a no-op.
+func (NoOpClient) StreamPrices(
+	_ context.Context,
+	_ *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return nil, nil
+}
@@ -1,5 +1,10 @@
 package oracle
 
+import (
+	"crypto/tls"
+	"time"
+)
+
 // Option enables consumers to configure the behavior of an OracleClient on initialization.
 type Option func(OracleClient)
 
@@ -16,3 +21,43 @@ func WithBlockingDial() Option {
 		client.blockingDial = true
 	}
 }
+
+// WithTLSConfig configures the OracleClient to dial the remote oracle server over TLS with the
+// given config.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.tlsConfig = cfg
+	}
+}
+
+// WithHedgeDelay configures the FailoverClient to query the next oracle server if the current
+// oracle server has not responded within the given delay. Requests are not hedged if the delay
+// is zero.
+func WithHedgeDelay(delay time.Duration) Option {
+	return func(c OracleClient) {
+		client, ok := c.(*FailoverClient)
+		if !ok {
+			return
+		}
+
+		client.hedgeDelay = delay
+	}
+}
+
+// WithFailoverCooldown configures the FailoverClient to deprioritize an oracle server for the
+// given duration after a failed request.
+func WithFailoverCooldown(cooldown time.Duration) Option {
+	return func(c OracleClient) {
+		client, ok := c.(*FailoverClient)
+		if !ok {
+			return
+		}
+
+		client.cooldown = cooldown
+	}
+}
@@ -17,6 +17,10 @@ type Metrics interface {
 	// AddOracleResponse increments the number of oracle responses, this can represent a liveness counter. This metric is paginated by status.
 	AddOracleResponse(status Labeller)
 
+	// AddOracleEndpointResponse increments the number of oracle responses served by the given oracle endpoint. This metric is
+	// paginated by endpoint and status, and shows which endpoint served each request when the client fails over between endpoints.
+	AddOracleEndpointResponse(endpoint string, status Labeller)
+
 	// ObserveABCIMethodLatency reports the given latency (as a duration), for the given ABCIMethod, and updates the ABCIMethodLatency histogram w/ that value.
 	ObserveABCIMethodLatency(method ABCIMethod, duration time.Duration)
 
@@ -47,6 +51,7 @@ func NewNopMetrics() Metrics {
 
 func (m *nopMetricsImpl) ObserveOracleResponseLatency(_ time.Duration)                {}
 func (m *nopMetricsImpl) AddOracleResponse(_ Labeller)                                {}
+func (m *nopMetricsImpl) AddOracleEndpointResponse(_ string, _ Labeller)              {}
 func (m *nopMetricsImpl) ObserveABCIMethodLatency(_ ABCIMethod, _ time.Duration)      {}
 func (m *nopMetricsImpl) AddABCIRequest(_ ABCIMethod, _ Labeller)                     {}
 func (m *nopMetricsImpl) ObserveMessageSize(_ MessageType, _ int)                     {}
@@ -70,6 +75,11 @@ func NewMetrics(chainID string) Metrics {
 			Name:      "oracle_responses",
 			Help:      "The number of oracle responses",
 		}, []string{StatusLabel, ChainIDLabel}),
+		oracleEndpointResponseCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: AppNamespace,
+			Name:      "oracle_endpoint_responses",
+			Help:      "The number of oracle responses per oracle endpoint",
+		}, []string{EndpointLabel, StatusLabel, ChainIDLabel}),
 		abciMethodLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: AppNamespace,
 			Name:      "abci_method_latency",
@@ -107,6 +117,7 @@ func NewMetrics(chainID string) Metrics {
 	// register the above metrics
 	prometheus.MustRegister(m.oracleResponseLatency)
 	prometheus.MustRegister(m.oracleResponseCounter)
+	prometheus.MustRegister(m.oracleEndpointResponseCounter)
 	prometheus.MustRegister(m.abciMethodLatency)
 	prometheus.MustRegister(m.abciRequests)
 	prometheus.MustRegister(m.messageSize)
@@ -120,15 +131,16 @@ func NewMetrics(chainID string) Metrics {
 }
 
 type metricsImpl struct {
-	oracleResponseLatency    *prometheus.HistogramVec
-	oracleResponseCounter    *prometheus.CounterVec
-	reportsPerValidator      *prometheus.GaugeVec
-	reportStatusPerValidator *prometheus.CounterVec
-	abciMethodLatency        *prometheus.HistogramVec
-	abciRequests             *prometheus.CounterVec
-	messageSize              *prometheus.HistogramVec
-	prices                   *prometheus.GaugeVec
-	chainID                  string
+	oracleResponseLatency         *prometheus.HistogramVec
+	oracleResponseCounter         *prometheus.CounterVec
+	oracleEndpointResponseCounter *prometheus.CounterVec
+	reportsPerValidator           *prometheus.GaugeVec
+	reportStatusPerValidator      *prometheus.CounterVec
+	abciMethodLatency             *prometheus.HistogramVec
+	abciRequests                  *prometheus.CounterVec
+	messageSize                   *prometheus.HistogramVec
+	prices                        *prometheus.GaugeVec
+	chainID                       string
 }
 
 func (m *metricsImpl) ObserveABCIMethodLatency(method ABCIMethod, duration time.Duration) {
@@ -151,6 +163,14 @@ func (m *metricsImpl) AddOracleResponse(status Labeller) {
 	}).Inc()
 }
 
+func (m *metricsImpl) AddOracleEndpointResponse(endpoint string, status Labeller) {
+	m.oracleEndpointResponseCounter.With(prometheus.Labels{
+		EndpointLabel: endpoint,
+		StatusLabel:   status.Label(),
+		ChainIDLabel:  m.chainID,
+	}).Inc()
+}
+
 func (m *metricsImpl) AddABCIRequest(method ABCIMethod, status Labeller) {
 	m.abciRequests.With(prometheus.Labels{
 		ABCIMethodLabel: method.String(),
@@ -21,6 +21,11 @@ func (_m *Metrics) AddABCIRequest(method metrics.ABCIMethod, status metrics.Labe
 	_m.Called(method, status)
 }
 
+// AddOracleEndpointResponse provides a mock function with given fields: endpoint, status
+func (_m *Metrics) AddOracleEndpointResponse(endpoint string, status metrics.Labeller) {
+	_m.Called(endpoint, status)
+}
+
 // AddOracleResponse provides a mock function with given fields: status
 func (_m *Metrics) AddOracleResponse(status metrics.Labeller) {
 	_m.Called(status)
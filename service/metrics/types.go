@@ -13,6 +13,7 @@ const (
 	ABCIMethodStatusLabel = "abci_method_status"
 	MessageTypeLabel      = "message_type"
 	ValidatorLabel        = "validator"
+	EndpointLabel         = "endpoint"
 
 	// helpful constants.
 	notImplemented = "not_implemented"
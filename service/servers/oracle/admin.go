@@ -0,0 +1,187 @@
+package oracle
+
+import (
+	"context"
+	"crypto/subtle"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+const (
+	// AdminServiceName is the name of the admin grpc service.
+	AdminServiceName = "slinky.service.v1.Admin"
+
+	// AuthorizationHeader is the metadata key of the token that authenticates admin requests.
+	AuthorizationHeader = "authorization"
+
+	// bearerPrefix is the prefix of the admin token in the authorization metadata.
+	bearerPrefix = "Bearer "
+)
+
+// adminServer implements the admin service of the oracle, which lists the status of each provider
+// and applies operator overrides to the oracle while it is running.
+type adminServer struct {
+	types.UnimplementedAdminServer
+
+	os *OracleServer
+}
+
+// authenticateAdmin is a grpc interceptor that rejects admin requests that do not present the
+// admin token as a bearer token in the authorization metadata. Requests to other services are
+// passed through.
+func (os *OracleServer) authenticateAdmin(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, "/"+AdminServiceName+"/") {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(AuthorizationHeader) {
+		token, ok := strings.CutPrefix(value, bearerPrefix)
+		if ok && subtle.ConstantTimeCompare([]byte(token), []byte(os.adminToken.Value())) == 1 {
+			return handler(ctx, req)
+		}
+	}
+
+	os.logger.Warn("rejected unauthenticated admin request", zap.String("method", info.FullMethod))
+	return nil, status.Error(codes.Unauthenticated, "invalid admin token")
+}
+
+// Providers returns the status of each provider of the oracle, sorted by name.
+func (as *adminServer) Providers(_ context.Context, req *types.QueryProvidersRequest) (*types.QueryProvidersResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	statuses := as.os.o.GetProviderStatuses()
+
+	providers := make([]types.ProviderStatus, len(statuses))
+	for i, s := range statuses {
+		providers[i] = toProviderStatus(s)
+	}
+
+	return &types.QueryProvidersResponse{Providers: providers}, nil
+}
+
+// DisableProvider disables the requested provider.
+func (as *adminServer) DisableProvider(_ context.Context, req *types.DisableProviderRequest) (*types.DisableProviderResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	as.os.logger.Info("received request to disable provider", zap.String("provider", req.Provider), zap.Duration("duration", req.Duration))
+
+	if err := as.os.o.DisableProvider(req.Provider, req.Duration); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.DisableProviderResponse{}, nil
+}
+
+// EnableProvider enables the requested provider.
+func (as *adminServer) EnableProvider(_ context.Context, req *types.EnableProviderRequest) (*types.EnableProviderResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	as.os.logger.Info("received request to enable provider", zap.String("provider", req.Provider))
+
+	if err := as.os.o.EnableProvider(req.Provider); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.EnableProviderResponse{}, nil
+}
+
+// ExcludePair excludes the requested currency pair on the requested provider.
+func (as *adminServer) ExcludePair(_ context.Context, req *types.ExcludePairRequest) (*types.ExcludePairResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	as.os.logger.Info(
+		"received request to exclude currency pair",
+		zap.String("provider", req.Provider),
+		zap.String("pair", req.CurrencyPair),
+		zap.Duration("duration", req.Duration),
+	)
+
+	cp, err := oracletypes.CurrencyPairFromString(req.CurrencyPair)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := as.os.o.ExcludePair(req.Provider, cp, req.Duration); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.ExcludePairResponse{}, nil
+}
+
+// IncludePair includes the requested currency pair on the requested provider.
+func (as *adminServer) IncludePair(_ context.Context, req *types.IncludePairRequest) (*types.IncludePairResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	as.os.logger.Info(
+		"received request to include currency pair",
+		zap.String("provider", req.Provider),
+		zap.String("pair", req.CurrencyPair),
+	)
+
+	cp, err := oracletypes.CurrencyPairFromString(req.CurrencyPair)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := as.os.o.IncludePair(req.Provider, cp); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.IncludePairResponse{}, nil
+}
+
+// toProviderStatus converts the status of a provider to its response type. The currency pairs
+// are sorted.
+func toProviderStatus(s oracle.ProviderStatus) types.ProviderStatus {
+	pairs := make([]types.PairStatus, 0, len(s.Pairs))
+	for cp, ps := range s.Pairs {
+		pairs = append(pairs, types.PairStatus{
+			CurrencyPair:  cp.String(),
+			LastUpdate:    ps.LastUpdate,
+			Errors:        ps.Errors,
+			LastError:     ps.LastError,
+			Excluded:      ps.Excluded,
+			ExcludedUntil: ps.ExcludedUntil,
+		})
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].CurrencyPair < pairs[j].CurrencyPair
+	})
+
+	return types.ProviderStatus{
+		Name:             s.Name,
+		ProviderType:     string(s.Type),
+		ConnectionStatus: string(s.Connection),
+		Disabled:         s.Disabled,
+		DisabledUntil:    s.DisabledUntil,
+		Errors:           s.Errors,
+		Pairs:            pairs,
+	}
+}
@@ -0,0 +1,250 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// LivenessPath is the HTTP path that reports whether the oracle is running.
+	LivenessPath = "/healthz"
+
+	// ReadinessPath is the HTTP path that reports whether the oracle is ready to serve prices.
+	ReadinessPath = "/readyz"
+
+	// OracleServiceName is the name of the oracle grpc service, as reported by the grpc health
+	// service.
+	OracleServiceName = "slinky.service.v1.Oracle"
+
+	// healthWatchInterval is the interval at which the readiness of the oracle is re-evaluated for
+	// the clients watching the grpc health service.
+	healthWatchInterval = time.Second
+)
+
+// LivenessStatus is the response body of the liveness endpoint.
+type LivenessStatus struct {
+	// Running is true if the oracle is running.
+	Running bool `json:"running"`
+}
+
+// ReadinessStatus is the response body of the readiness endpoint. It reports whether the oracle is
+// ready along with the currency pairs and providers that are degraded.
+type ReadinessStatus struct {
+	// Ready is true if the oracle meets all of the readiness requirements.
+	Ready bool `json:"ready"`
+
+	// Running is true if the oracle is running.
+	Running bool `json:"running"`
+
+	// LastSyncTime is the last time the oracle updated its prices.
+	LastSyncTime time.Time `json:"last_sync_time"`
+
+	// FreshPairs is the number of currency pairs in the market config with a fresh price, and
+	// TotalPairs is the number of currency pairs in the market config.
+	FreshPairs int `json:"fresh_pairs"`
+	TotalPairs int `json:"total_pairs"`
+
+	// HealthyProviders is the number of providers that reported a fresh price in the latest oracle
+	// update, and TotalProviders is the number of providers the oracle fetches prices from.
+	HealthyProviders int `json:"healthy_providers"`
+	TotalProviders   int `json:"total_providers"`
+
+	// DegradedPairs is the sorted set of currency pairs without a fresh price.
+	DegradedPairs []string `json:"degraded_pairs"`
+
+	// DegradedProviders is the sorted set of providers that did not report a fresh price in the
+	// latest oracle update.
+	DegradedProviders []string `json:"degraded_providers"`
+
+	// Reasons is the set of readiness requirements that are not met.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// readiness evaluates the readiness of the oracle against the health config. A currency pair is fresh
+// if the oracle reported a price for it in the latest update that was not withheld, and a provider is
+// healthy if it reported a fresh price that was used in the latest update. Nothing is fresh once the
+// latest update is older than the max sync age.
+func (os *OracleServer) readiness() ReadinessStatus {
+	rs := ReadinessStatus{
+		Running:           os.o.IsRunning(),
+		DegradedPairs:     make([]string, 0),
+		DegradedProviders: make([]string, 0),
+	}
+
+	if !rs.Running {
+		rs.Reasons = append(rs.Reasons, ErrOracleNotRunning.Error())
+		return rs
+	}
+
+	// evaluate a single oracle update, such that the prices, withheld prices and provider prices
+	// are consistent with the last sync time
+	update := os.o.GetPriceDetails()
+
+	rs.LastSyncTime = update.Timestamp
+	stale := rs.LastSyncTime.IsZero()
+	switch {
+	case stale:
+		rs.Reasons = append(rs.Reasons, "oracle has not updated its prices")
+	case os.healthCfg.MaxSyncAge > 0 && time.Since(rs.LastSyncTime) > os.healthCfg.MaxSyncAge:
+		stale = true
+		rs.Reasons = append(rs.Reasons, fmt.Sprintf("oracle has not updated its prices in %s", os.healthCfg.MaxSyncAge))
+	}
+
+	for cp := range update.Decimals {
+		rs.TotalPairs++
+
+		_, reported := update.Prices[cp]
+		_, isWithheld := update.Withheld[cp]
+		if stale || !reported || isWithheld {
+			rs.DegradedPairs = append(rs.DegradedPairs, cp.String())
+			continue
+		}
+
+		rs.FreshPairs++
+	}
+
+	for _, provider := range os.o.GetProviderNames() {
+		rs.TotalProviders++
+
+		if stale || len(update.ProviderPrices[provider]) == 0 {
+			rs.DegradedProviders = append(rs.DegradedProviders, provider)
+			continue
+		}
+
+		rs.HealthyProviders++
+	}
+
+	sort.Strings(rs.DegradedPairs)
+	sort.Strings(rs.DegradedProviders)
+
+	// If no currency pairs are configured, the fresh pair requirement is trivially met.
+	freshPairRatio := 1.0
+	if rs.TotalPairs > 0 {
+		freshPairRatio = float64(rs.FreshPairs) / float64(rs.TotalPairs)
+	}
+
+	if freshPairRatio < os.healthCfg.MinFreshPairRatio {
+		rs.Reasons = append(rs.Reasons, fmt.Sprintf(
+			"%d of %d currency pairs have fresh prices; at least %.2f%% required",
+			rs.FreshPairs, rs.TotalPairs, os.healthCfg.MinFreshPairRatio*100,
+		))
+	}
+
+	if uint64(rs.HealthyProviders) < os.healthCfg.MinHealthyProviders {
+		rs.Reasons = append(rs.Reasons, fmt.Sprintf(
+			"%d of %d providers are healthy; at least %d required",
+			rs.HealthyProviders, rs.TotalProviders, os.healthCfg.MinHealthyProviders,
+		))
+	}
+
+	rs.Ready = len(rs.Reasons) == 0
+	return rs
+}
+
+// serveLiveness responds with 200 if the oracle is running, and 503 otherwise.
+func (os *OracleServer) serveLiveness(w http.ResponseWriter, _ *http.Request) {
+	ls := LivenessStatus{Running: os.o.IsRunning()}
+
+	code := http.StatusOK
+	if !ls.Running {
+		code = http.StatusServiceUnavailable
+	}
+
+	os.writeJSON(w, code, ls)
+}
+
+// serveReadiness responds with 200 if the oracle is ready, and 503 otherwise. The body lists the
+// degraded currency pairs and providers.
+func (os *OracleServer) serveReadiness(w http.ResponseWriter, _ *http.Request) {
+	rs := os.readiness()
+
+	code := http.StatusOK
+	if !rs.Ready {
+		code = http.StatusServiceUnavailable
+	}
+
+	os.writeJSON(w, code, rs)
+}
+
+// writeJSON writes the given value as the JSON body of the response.
+func (os *OracleServer) writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		os.logger.Error("failed to write health response", zap.Error(err))
+	}
+}
+
+// healthServer implements the standard grpc health-checking protocol. Both the overall health of the
+// server (the empty service name) and the health of the oracle service report the readiness of the
+// oracle.
+type healthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+
+	os *OracleServer
+}
+
+// servingStatus returns the serving status of the given service.
+func (hs *healthServer) servingStatus(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if service != "" && service != OracleServiceName {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+
+	if !hs.os.readiness().Ready {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+// Check returns the serving status of the requested service.
+func (hs *healthServer) Check(
+	_ context.Context,
+	req *grpc_health_v1.HealthCheckRequest,
+) (*grpc_health_v1.HealthCheckResponse, error) {
+	servingStatus := hs.servingStatus(req.GetService())
+	if servingStatus == grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, status.Error(codes.NotFound, "unknown service")
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: servingStatus}, nil
+}
+
+// Watch streams the serving status of the requested service. The current status is sent immediately,
+// and a new response is sent every time the status changes. The stream is closed when the client
+// cancels the request or the server is closed.
+func (hs *healthServer) Watch(
+	req *grpc_health_v1.HealthCheckRequest,
+	stream grpc_health_v1.Health_WatchServer,
+) error {
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		if servingStatus := hs.servingStatus(req.GetService()); servingStatus != last {
+			last = servingStatus
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-hs.os.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
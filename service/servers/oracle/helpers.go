@@ -1,11 +1,19 @@
 package oracle
 
 import (
+	"fmt"
 	"math/big"
+	"sort"
 
+	"github.com/skip-mev/slinky/oracle"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	servertypes "github.com/skip-mev/slinky/service/servers/oracle/types"
 	"github.com/skip-mev/slinky/x/oracle/types"
 )
 
+// bpsDenominator is the number of basis points in a whole.
+var bpsDenominator = big.NewInt(10000)
+
 func ToReqPrices(prices map[types.CurrencyPair]*big.Int) map[string]string {
 	reqPrices := make(map[string]string, len(prices))
 
@@ -15,3 +23,224 @@ func ToReqPrices(prices map[types.CurrencyPair]*big.Int) map[string]string {
 
 	return reqPrices
 }
+
+// toReqWithheld converts the given withheld prices into their request representation, keeping only
+// the currency pairs in the filter (or all of them if the filter is nil).
+func toReqWithheld(withheld map[types.CurrencyPair]string, filter map[types.CurrencyPair]struct{}) map[string]string {
+	reqWithheld := make(map[string]string, len(withheld))
+
+	for cp, reason := range withheld {
+		if filter != nil {
+			if _, ok := filter[cp]; !ok {
+				continue
+			}
+		}
+
+		reqWithheld[cp.String()] = reason
+	}
+
+	return reqWithheld
+}
+
+// ToPriceDetails returns the details of each reported price in the given oracle update, keeping only the
+// currency pairs in the filter (or all of them if the filter is nil). The details include the aggregated price
+// before smoothing, the raw prices reported by each provider for the currency pair and the spread between them,
+// as well as the raw prices reported for the other currency pairs in its conversion paths. Provider prices are
+// sorted by provider name. Currency pairs without decimals use their legacy decimals. A price is marked as restored
+// if all of its provider prices were restored from the snapshot persisted by a previous run of the oracle.
+func ToPriceDetails(update oracle.PriceDetails, filter map[types.CurrencyPair]struct{}) map[string]servertypes.PriceDetails {
+	prices := filterPrices(update.Prices, filter)
+	details := make(map[string]servertypes.PriceDetails, len(prices))
+
+	for cp, price := range prices {
+		reported := toProviderPrices(update.ProviderPrices, cp)
+
+		var (
+			lowest, highest *big.Int
+			restored        = true
+		)
+		for _, results := range update.ProviderPrices {
+			result, ok := results[cp]
+			if !ok || result.Value == nil {
+				continue
+			}
+
+			restored = restored && result.Restored
+
+			if lowest == nil || result.Value.Cmp(lowest) < 0 {
+				lowest = result.Value
+			}
+			if highest == nil || result.Value.Cmp(highest) > 0 {
+				highest = result.Value
+			}
+		}
+
+		spread := new(big.Int)
+		if lowest != nil {
+			spread.Sub(highest, lowest)
+		}
+
+		var spreadBps uint64
+		if price.Sign() > 0 {
+			spreadBps = new(big.Int).Div(new(big.Int).Mul(spread, bpsDenominator), price).Uint64()
+		}
+
+		rawPrice := price
+		if raw, ok := update.RawPrices[cp]; ok && raw != nil {
+			rawPrice = raw
+		}
+
+		cpDecimals, ok := update.Decimals[cp]
+		if !ok {
+			cpDecimals = cp.LegacyDecimals()
+		}
+
+		conversionPaths := make([]string, len(update.ConversionPaths[cp]))
+		conversionPrices := make(map[string]servertypes.ProviderPrices)
+		for i, path := range update.ConversionPaths[cp] {
+			conversionPaths[i] = path.String()
+
+			for _, conversion := range path {
+				if conversion.CurrencyPair == cp {
+					continue
+				}
+
+				if legPrices := toProviderPrices(update.ProviderPrices, conversion.CurrencyPair); len(legPrices) > 0 {
+					conversionPrices[conversion.CurrencyPair.String()] = servertypes.ProviderPrices{Prices: legPrices}
+				}
+			}
+		}
+
+		details[cp.String()] = servertypes.PriceDetails{
+			Price:            price.String(),
+			RawPrice:         rawPrice.String(),
+			Decimals:         cpDecimals,
+			NumProviders:     uint64(len(reported)),
+			ProviderPrices:   reported,
+			Spread:           spread.String(),
+			SpreadBps:        spreadBps,
+			Restored:         restored && len(reported) > 0,
+			ConversionPrices: conversionPrices,
+			ConversionPaths:  conversionPaths,
+		}
+	}
+
+	return details
+}
+
+// toProviderPrices returns the raw prices reported by each provider for the given currency pair, sorted by
+// provider name.
+func toProviderPrices(
+	providerPrices map[string]map[types.CurrencyPair]providertypes.Result[*big.Int],
+	cp types.CurrencyPair,
+) []servertypes.ProviderPrice {
+	var reported []servertypes.ProviderPrice
+	for provider, results := range providerPrices {
+		result, ok := results[cp]
+		if !ok || result.Value == nil {
+			continue
+		}
+
+		reported = append(reported, toProviderPrice(provider, result))
+	}
+
+	sort.Slice(reported, func(i, j int) bool {
+		return reported[i].Provider < reported[j].Provider
+	})
+
+	return reported
+}
+
+// toProviderPrice converts the given price reported by the provider into its response representation,
+// including the volume and best bid and ask reported alongside the price, if any.
+func toProviderPrice(provider string, result providertypes.Result[*big.Int]) servertypes.ProviderPrice {
+	price := servertypes.ProviderPrice{
+		Provider:  provider,
+		Price:     result.Value.String(),
+		Timestamp: result.Timestamp,
+		Restored:  result.Restored,
+	}
+
+	if result.Volume != nil {
+		price.Volume = result.Volume.Text('f', -1)
+	}
+
+	if result.Bid != nil && result.Ask != nil {
+		price.Bid = result.Bid.String()
+		price.Ask = result.Ask.String()
+	}
+
+	return price
+}
+
+// ToPriceHistory converts the given price history entries into their response representation, keeping only the
+// currency pairs in the filter (or all of them if the filter is nil). Provider prices are sorted by provider name.
+func ToPriceHistory(entries []oracle.PriceHistoryEntry, filter map[types.CurrencyPair]struct{}) []servertypes.PriceHistoryEntry {
+	history := make([]servertypes.PriceHistoryEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		providerPrices := make(map[string]servertypes.ProviderPrices)
+		for provider, results := range entry.ProviderPrices {
+			for cp, result := range results {
+				if _, ok := filter[cp]; (filter != nil && !ok) || result.Value == nil {
+					continue
+				}
+
+				prices := providerPrices[cp.String()]
+				prices.Prices = append(prices.Prices, toProviderPrice(provider, result))
+				providerPrices[cp.String()] = prices
+			}
+		}
+
+		for _, prices := range providerPrices {
+			sort.Slice(prices.Prices, func(i, j int) bool {
+				return prices.Prices[i].Provider < prices.Prices[j].Provider
+			})
+		}
+
+		history = append(history, servertypes.PriceHistoryEntry{
+			Timestamp:      entry.Timestamp,
+			Prices:         ToReqPrices(filterPrices(entry.Prices, filter)),
+			ProviderPrices: providerPrices,
+		})
+	}
+
+	return history
+}
+
+// toCurrencyPairFilter converts the given set of currency pair strings into a set of currency
+// pairs. A nil set is returned if no currency pairs are given, meaning all prices are kept.
+func toCurrencyPairFilter(cps []string) (map[types.CurrencyPair]struct{}, error) {
+	if len(cps) == 0 {
+		return nil, nil
+	}
+
+	filter := make(map[types.CurrencyPair]struct{}, len(cps))
+	for _, cpStr := range cps {
+		cp, err := types.CurrencyPairFromString(cpStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid currency pair %s: %w", cpStr, err)
+		}
+
+		filter[cp] = struct{}{}
+	}
+
+	return filter, nil
+}
+
+// filterPrices returns the subset of prices whose currency pairs are in the filter. All prices
+// are returned if the filter is nil.
+func filterPrices(prices map[types.CurrencyPair]*big.Int, filter map[types.CurrencyPair]struct{}) map[types.CurrencyPair]*big.Int {
+	if filter == nil {
+		return prices
+	}
+
+	filtered := make(map[types.CurrencyPair]*big.Int, len(filter))
+	for cp, price := range prices {
+		if _, ok := filter[cp]; ok {
+			filtered[cp] = price
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,44 @@
+package oracle
+
+import (
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// Option is a function that can be used to configure an OracleServer.
+type Option func(*OracleServer)
+
+// WithHealthConfig sets the config of the readiness checks on the OracleServer.
+func WithHealthConfig(cfg config.HealthConfig) Option {
+	return func(os *OracleServer) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(err)
+		}
+
+		os.healthCfg = cfg
+	}
+}
+
+// WithAdminConfig sets the config of the admin service on the OracleServer. The admin service is
+// only served if it is enabled.
+func WithAdminConfig(cfg config.AdminConfig) Option {
+	return func(os *OracleServer) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(err)
+		}
+
+		os.adminCfg = cfg
+	}
+}
+
+// WithTLSConfig sets the config of TLS on the OracleServer. If enabled, the server only serves TLS
+// connections and, optionally, requires clients to present a certificate signed by the configured
+// certificate authority.
+func WithTLSConfig(cfg config.TLSConfig) Option {
+	return func(os *OracleServer) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(err)
+		}
+
+		os.tlsCfg = cfg
+	}
+}
@@ -14,9 +14,10 @@ import (
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/pkg/sync"
 	"github.com/skip-mev/slinky/service/servers/oracle/types"
 )
@@ -45,16 +46,32 @@ type OracleServer struct { //nolint
 
 	// logger to log incoming requests
 	logger *zap.Logger
+
+	// healthCfg is the config of the readiness checks
+	healthCfg config.HealthConfig
+
+	// adminCfg is the config of the admin service
+	adminCfg config.AdminConfig
+
+	// adminToken is the token that authenticates admin requests, read when the server starts
+	adminToken config.Secret
+
+	// tlsCfg is the config of TLS on the server
+	tlsCfg config.TLSConfig
 }
 
 // NewOracleServer returns a new instance of the OracleServer, given an implementation of the Oracle interface.
-func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
+func NewOracleServer(o oracle.Oracle, logger *zap.Logger, opts ...Option) *OracleServer {
 	logger = logger.With(zap.String("server", "oracle"))
 
 	os := &OracleServer{
 		o:      o,
 		logger: logger,
 	}
+
+	for _, opt := range opts {
+		opt(os)
+	}
 	os.Closer = sync.NewCloser().WithCallback(func() {
 		// if the server has been started, close it
 		if os.httpSrv != nil {
@@ -74,7 +91,7 @@ func (os *OracleServer) routeRequest(w http.ResponseWriter, r *http.Request) {
 		r.Header.Get("Content-Type"), "application/grpc") {
 
 		os.grpcSrv.ServeHTTP(w, r)
-	} else {
+	} else if os.requireGatewayClientCert(w, r) {
 		os.gatewayMux.ServeHTTP(w, r)
 	}
 }
@@ -88,13 +105,30 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 		Addr:              serverEndpoint,
 		ReadHeaderTimeout: DefaultServerShutdownTimeout,
 	}
-	// create grpc server
-	os.grpcSrv = grpc.NewServer()
+	// create grpc server, authenticating requests to the admin service and requiring a client
+	// certificate on requests to the oracle service if configured
+	os.grpcSrv = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(os.authenticateAdmin, os.requireOracleClientCert),
+		grpc.StreamInterceptor(os.requireOracleClientCertStream),
+	)
 	// register oracle server
 	types.RegisterOracleServer(os.grpcSrv, os)
+	// register the standard grpc health-checking service
+	grpc_health_v1.RegisterHealthServer(os.grpcSrv, &healthServer{os: os})
+	// register the admin service, if enabled
+	if os.adminCfg.Enabled {
+		token, err := os.adminCfg.ReadToken()
+		if err != nil {
+			return err
+		}
+
+		os.adminToken = token
+		types.RegisterAdminServer(os.grpcSrv, &adminServer{os: os})
+	}
 
 	// register the grpc-gateway
-	// it handles the http request and dials the server endpoint with the grpc request
+	// it handles the http request and calls the server in-process, such that it does not
+	// need to dial the server endpoint (and present a client certificate if TLS is enabled)
 	os.gatewayMux = runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
 			EmitDefaults: true,
@@ -102,16 +136,30 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 			OrigName:     true,
 		}),
 	)
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	err := types.RegisterOracleHandlerFromEndpoint(ctx, os.gatewayMux, serverEndpoint, opts)
+	err := types.RegisterOracleHandlerServer(ctx, os.gatewayMux, os)
 	if err != nil {
 		return err
 	}
 
 	router := http.NewServeMux()
 	router.HandleFunc("/", os.routeRequest)
+	router.HandleFunc(LivenessPath, os.serveLiveness)
+	router.HandleFunc(ReadinessPath, os.serveReadiness)
+
+	// serve http/2 over TLS if enabled, otherwise serve http/2 over cleartext
+	if os.tlsCfg.Enabled {
+		if os.httpSrv.TLSConfig, err = os.tlsCfg.ServerTLSConfig(); err != nil {
+			return err
+		}
+
+		if err := http2.ConfigureServer(os.httpSrv, &http2.Server{}); err != nil {
+			return err
+		}
 
-	os.httpSrv.Handler = h2c.NewHandler(router, &http2.Server{})
+		os.httpSrv.Handler = router
+	} else {
+		os.httpSrv.Handler = h2c.NewHandler(router, &http2.Server{})
+	}
 
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -139,9 +187,15 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 			"starting grpc server",
 			zap.String("host", host),
 			zap.String("port", port),
+			zap.Bool("tls", os.tlsCfg.Enabled),
 		)
 
-		err = os.httpSrv.ListenAndServe()
+		if os.tlsCfg.Enabled {
+			// the certificate is loaded from the TLS config of the server
+			err = os.httpSrv.ListenAndServeTLS("", "")
+		} else {
+			err = os.httpSrv.ListenAndServe()
+		}
 		if err != nil {
 			return fmt.Errorf("[grpc server]: error serving: %w", err)
 		}
@@ -173,15 +227,117 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 
 	// run the request in a goroutine, to unblock server + ctx cancellation
 	go func() {
-		// get the prices
-		prices := os.o.GetPrices()
-
-		// get the latest timestamp of the latest update from the oracle
-		timestamp := os.o.GetLastSyncTime()
+		// get the prices, the timestamp and the withheld prices of the latest oracle update
+		update := os.o.GetPriceDetails()
 
 		resCh <- &types.QueryPricesResponse{
-			Prices:    ToReqPrices(prices),
-			Timestamp: timestamp,
+			Prices:    ToReqPrices(update.Prices),
+			Timestamp: update.Timestamp,
+			Withheld:  toReqWithheld(update.Withheld, nil),
+		}
+	}()
+
+	// defer to context closure
+	select {
+	case <-ctx.Done():
+		os.logger.Error("context cancelled")
+		return nil, context.Canceled
+	case resp := <-resCh:
+		return resp, nil
+	}
+}
+
+// PriceDetails calls the underlying oracle's implementation of GetPriceDetails, returning the aggregated price of each
+// currency pair along with the provider prices that it was derived from, all taken from the same oracle update. Details can
+// optionally be filtered to a set of currency pairs.
+func (os *OracleServer) PriceDetails(ctx context.Context, req *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Info("received request for price details", zap.Strings("currency_pairs", req.CurrencyPairs))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	filter, err := toCurrencyPairFilter(req.CurrencyPairs)
+	if err != nil {
+		return nil, err
+	}
+
+	resCh := make(chan *types.QueryPriceDetailsResponse)
+
+	// run the request in a goroutine, to unblock server + ctx cancellation
+	go func() {
+		update := os.o.GetPriceDetails()
+
+		select {
+		case resCh <- &types.QueryPriceDetailsResponse{
+			Prices:    ToPriceDetails(update, filter),
+			Timestamp: update.Timestamp,
+			Withheld:  toReqWithheld(update.Withheld, filter),
+		}:
+		case <-ctx.Done():
+		}
+	}()
+
+	// defer to context closure
+	select {
+	case <-ctx.Done():
+		os.logger.Error("context cancelled")
+		return nil, context.Canceled
+	case resp := <-resCh:
+		return resp, nil
+	}
+}
+
+// PriceHistory calls the underlying oracle's implementation of GetPriceHistory, returning the prices reported by
+// the oracle in each update within the requested time range along with the provider prices that each price was
+// derived from. The history can optionally be filtered to a set of currency pairs. A zero start or end time leaves
+// the time range unbounded on that side.
+func (os *OracleServer) PriceHistory(ctx context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Info(
+		"received request for price history",
+		zap.Strings("currency_pairs", req.CurrencyPairs),
+		zap.Time("start_time", req.StartTime),
+		zap.Time("end_time", req.EndTime),
+	)
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	filter, err := toCurrencyPairFilter(req.CurrencyPairs)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.StartTime.IsZero() && !req.EndTime.IsZero() && req.EndTime.Before(req.StartTime) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	resCh := make(chan *types.QueryPriceHistoryResponse)
+
+	// run the request in a goroutine, to unblock server + ctx cancellation
+	go func() {
+		entries := os.o.GetPriceHistory(req.StartTime, req.EndTime)
+
+		select {
+		case resCh <- &types.QueryPriceHistoryResponse{
+			Entries: ToPriceHistory(entries, filter),
+		}:
+		case <-ctx.Done():
 		}
 	}()
 
@@ -195,6 +351,108 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	}
 }
 
+// StreamPrices streams the latest prices from the underlying oracle. The current prices are sent
+// immediately (if the oracle has synced), and a new response is sent every time the oracle updates its
+// prices. Responses can optionally be filtered to a set of currency pairs and throttled to a minimum
+// interval, in which case the latest prices are sent once the interval has elapsed. The stream is closed when the client cancels the request or the server is closed.
+func (os *OracleServer) StreamPrices(req *types.StreamPricesRequest, stream types.Oracle_StreamPricesServer) error {
+	// check that the request is non-nil
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	os.logger.Info("received request to stream prices", zap.Strings("currency_pairs", req.CurrencyPairs))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return ErrOracleNotRunning
+	}
+
+	filter, err := toCurrencyPairFilter(req.CurrencyPairs)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	updates := os.o.SubscribePrices(ctx)
+
+	var (
+		lastSent time.Time
+		// trailing fires once the minimum interval of the last response has elapsed, if an update
+		// was held back because it arrived within the interval.
+		trailing <-chan time.Time
+		timer    *time.Timer
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	send := func() error {
+		if timer != nil {
+			timer.Stop()
+			timer, trailing = nil, nil
+		}
+
+		update := os.o.GetPriceDetails()
+		if update.Timestamp.IsZero() {
+			return nil
+		}
+		lastSent = time.Now()
+
+		return stream.Send(&types.QueryPricesResponse{
+			Prices:    ToReqPrices(filterPrices(update.Prices, filter)),
+			Timestamp: update.Timestamp,
+			Withheld:  toReqWithheld(update.Withheld, filter),
+		})
+	}
+
+	// send the current prices before waiting for updates
+	if err := send(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.logger.Info("price stream closed by client")
+			return ctx.Err()
+		case <-os.Done():
+			os.logger.Info("price stream closed by server")
+			return nil
+		case _, ok := <-updates:
+			if !ok {
+				return ctx.Err()
+			}
+
+			// hold the update if it is within the minimum interval of the last response, such that
+			// the latest prices are sent once the interval has elapsed
+			if wait := req.MinInterval - time.Since(lastSent); !lastSent.IsZero() && wait > 0 {
+				if timer == nil {
+					timer = time.NewTimer(wait)
+					trailing = timer.C
+				}
+
+				continue
+			}
+
+			if err := send(); err != nil {
+				os.logger.Error("failed to send prices", zap.Error(err))
+				return err
+			}
+		case <-trailing:
+			timer, trailing = nil, nil
+
+			if err := send(); err != nil {
+				os.logger.Error("failed to send prices", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
 // Close closes the underlying oracle server, and blocks until all open requests have been satisfied.
 func (os *OracleServer) Close() error {
 	// close + close server if necessary
@@ -2,20 +2,35 @@ package oracle_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/config/testutils"
 	"github.com/skip-mev/slinky/oracle/mocks"
+	providertypes "github.com/skip-mev/slinky/providers/types"
 	client "github.com/skip-mev/slinky/service/clients/oracle"
 	"github.com/skip-mev/slinky/service/metrics"
 	server "github.com/skip-mev/slinky/service/servers/oracle"
@@ -29,6 +44,7 @@ const (
 	timeout       = 1 * time.Second
 	delay         = 20 * time.Second
 	grpcErrPrefix = "rpc error: code = Unknown desc = "
+	adminToken    = "0123456789abcdef"
 )
 
 type ServerTestSuite struct {
@@ -46,12 +62,46 @@ func TestServerTestSuite(t *testing.T) {
 	suite.Run(t, new(ServerTestSuite))
 }
 
+// writeAdminToken writes the given admin token to a file and returns the path of the file.
+func writeAdminToken(t *testing.T, token string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "admin_token")
+	require.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0o600))
+	return path
+}
+
+func TestOracleServerAdminTokenTooShort(t *testing.T) {
+	srv := server.NewOracleServer(
+		mocks.NewOracle(t),
+		zap.NewNop(),
+		server.WithAdminConfig(config.AdminConfig{
+			Enabled: true,
+			Token:   config.SecretConfig{File: writeAdminToken(t, "token")},
+		}),
+	)
+
+	require.Error(t, srv.StartServer(context.Background(), localhost, port))
+}
+
 func (s *ServerTestSuite) SetupTest() {
 	// mock logger
 	logger := zap.NewExample()
 
 	s.mockOracle = mocks.NewOracle(s.T())
-	s.srv = server.NewOracleServer(s.mockOracle, logger)
+	s.srv = server.NewOracleServer(
+		s.mockOracle,
+		logger,
+		server.WithHealthConfig(config.HealthConfig{
+			MinFreshPairRatio:   0.5,
+			MinHealthyProviders: 2,
+			MaxSyncAge:          time.Minute,
+		}),
+		server.WithAdminConfig(config.AdminConfig{
+			Enabled: true,
+			Token:   config.SecretConfig{File: writeAdminToken(s.T(), adminToken)},
+		}),
+	)
 
 	var err error
 	s.client, err = client.NewClient(
@@ -109,9 +159,9 @@ func (s *ServerTestSuite) TestOracleServerNotRunning() {
 }
 
 func (s *ServerTestSuite) TestOracleServerTimeout() {
-	// set the mock oracle to delay GetPrices response (delay for absurd time)
+	// set the mock oracle to delay GetPriceDetails response (delay for absurd time)
 	s.mockOracle.On("IsRunning").Return(true)
-	s.mockOracle.On("GetPrices").Return(nil).After(delay)
+	s.mockOracle.On("GetPriceDetails").Return(oracle.PriceDetails{Timestamp: time.Now()}).After(delay)
 
 	// call from client
 	_, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
@@ -133,12 +183,22 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 		Quote: "USD",
 	}
 
-	s.mockOracle.On("GetPrices").Return(map[types.CurrencyPair]*big.Int{
-		cp1: big.NewInt(100),
-		cp2: big.NewInt(200),
-	})
+	cp3 := types.CurrencyPair{
+		Base:  "ATOM",
+		Quote: "USD",
+	}
+
 	ts := time.Now()
-	s.mockOracle.On("GetLastSyncTime").Return(ts)
+	s.mockOracle.On("GetPriceDetails").Return(oracle.PriceDetails{
+		Timestamp: ts,
+		Prices: map[types.CurrencyPair]*big.Int{
+			cp1: big.NewInt(100),
+			cp2: big.NewInt(200),
+		},
+		Withheld: map[types.CurrencyPair]string{
+			cp3: "insufficient_quorum",
+		},
+	})
 
 	// call from grpc client
 	resp, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
@@ -147,6 +207,7 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	// check response
 	s.Require().Equal(resp.Prices[cp1.String()], big.NewInt(100).String())
 	s.Require().Equal(resp.Prices[cp2.String()], big.NewInt(200).String())
+	s.Require().Equal(map[string]string{cp3.String(): "insufficient_quorum"}, resp.Withheld)
 	// check timestamp
 
 	s.Require().Equal(resp.Timestamp, ts.UTC())
@@ -162,6 +223,619 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
 }
 
+func (s *ServerTestSuite) TestOracleServerPriceDetails() {
+	s.mockOracle.On("IsRunning").Return(true)
+	cp1 := types.CurrencyPair{
+		Base:  "BTC",
+		Quote: "USD",
+	}
+
+	cp2 := types.CurrencyPair{
+		Base:  "ETH",
+		Quote: "USD",
+	}
+
+	// withheld prices are filtered along with the prices
+	cp3 := types.CurrencyPair{
+		Base:  "ATOM",
+		Quote: "USD",
+	}
+
+	// the price of cp4 is converted from the prices of cp1 and BTC/ETH
+	cp4 := types.NewCurrencyPair("ETH", "BTC")
+	btceth := types.NewCurrencyPair("BTC", "ETH")
+
+	ts := time.Now().UTC()
+	s.mockOracle.On("GetPriceDetails").Return(oracle.PriceDetails{
+		Timestamp: ts,
+		Prices: map[types.CurrencyPair]*big.Int{
+			cp1: big.NewInt(100),
+			cp2: big.NewInt(200),
+			cp4: big.NewInt(5),
+		},
+		// the price of cp1 is smoothed, so its raw price differs from the reported price
+		RawPrices: map[types.CurrencyPair]*big.Int{
+			cp1: big.NewInt(104),
+		},
+		ProviderPrices: map[string]map[types.CurrencyPair]providertypes.Result[*big.Int]{
+			"b": {
+				cp1:    {Value: big.NewInt(102), Timestamp: ts, Volume: big.NewFloat(1.5), Bid: big.NewInt(101), Ask: big.NewInt(103)},
+				btceth: {Value: big.NewInt(20), Timestamp: ts},
+			},
+			"a": {
+				cp1: {Value: big.NewInt(99), Timestamp: ts.Add(-time.Second)},
+				cp2: {Value: big.NewInt(200), Timestamp: ts, Restored: true},
+			},
+		},
+		Withheld: map[types.CurrencyPair]string{
+			cp3: "insufficient_quorum",
+		},
+		// cp1 is configured with 18 decimals, while cp2 falls back to its legacy decimals
+		Decimals: map[types.CurrencyPair]uint64{
+			cp1: 18,
+		},
+		ConversionPaths: map[types.CurrencyPair][]config.Conversions{
+			cp4: {
+				{{CurrencyPair: btceth, Invert: true}},
+				{{CurrencyPair: cp1, Invert: true}, {CurrencyPair: cp2}},
+			},
+		},
+	})
+
+	// call from grpc client
+	resp, err := s.client.PriceDetails(context.Background(), &stypes.QueryPriceDetailsRequest{
+		CurrencyPairs: []string{cp1.String()},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(ts, resp.Timestamp)
+	s.Require().Len(resp.Prices, 1)
+	s.Require().Empty(resp.Withheld)
+
+	details, ok := resp.Prices[cp1.String()]
+	s.Require().True(ok)
+	s.Require().Equal(stypes.PriceDetails{
+		Price:        "100",
+		Decimals:     18,
+		NumProviders: 2,
+		ProviderPrices: []stypes.ProviderPrice{
+			{Provider: "a", Price: "99", Timestamp: ts.Add(-time.Second)},
+			{Provider: "b", Price: "102", Timestamp: ts, Volume: "1.5", Bid: "101", Ask: "103"},
+		},
+		Spread:    "3",
+		SpreadBps: 300,
+		RawPrice:  "104",
+	}, details)
+
+	// converted prices include the provider prices of each other currency pair in their conversion paths
+	resp, err = s.client.PriceDetails(context.Background(), &stypes.QueryPriceDetailsRequest{
+		CurrencyPairs: []string{cp4.String()},
+	})
+	s.Require().NoError(err)
+
+	details, ok = resp.Prices[cp4.String()]
+	s.Require().True(ok)
+	s.Require().Empty(details.ProviderPrices)
+	s.Require().Equal(map[string]stypes.ProviderPrices{
+		btceth.String(): {Prices: []stypes.ProviderPrice{{Provider: "b", Price: "20", Timestamp: ts}}},
+		cp1.String(): {Prices: []stypes.ProviderPrice{
+			{Provider: "a", Price: "99", Timestamp: ts.Add(-time.Second)},
+			{Provider: "b", Price: "102", Timestamp: ts, Volume: "1.5", Bid: "101", Ask: "103"},
+		}},
+		cp2.String(): {Prices: []stypes.ProviderPrice{{Provider: "a", Price: "200", Timestamp: ts, Restored: true}}},
+	}, details.ConversionPrices)
+	s.Require().Equal([]string{
+		fmt.Sprintf("invert(%s)", btceth),
+		fmt.Sprintf("invert(%s) -> %s", cp1, cp2),
+	}, details.ConversionPaths)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/prices/details", localhost, port))
+	s.Require().NoError(err)
+
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), fmt.Sprintf(`"%s":{"price":"200","decimals":"8","num_providers":"1"`, cp2.String()))
+	s.Require().Contains(string(respBz), `"raw_price":"200"`)
+
+	// the price of cp2 is only reported by restored provider prices
+	s.Require().Contains(string(respBz), `"restored":true`)
+}
+
+func (s *ServerTestSuite) TestOracleServerPriceDetailsInvalidCurrencyPair() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	_, err := s.client.PriceDetails(context.Background(), &stypes.QueryPriceDetailsRequest{
+		CurrencyPairs: []string{"BTCUSD"},
+	})
+	s.Require().Error(err)
+}
+
+func (s *ServerTestSuite) TestOracleServerPriceHistory() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	cp1 := types.NewCurrencyPair("BTC", "USD")
+	cp2 := types.NewCurrencyPair("ETH", "USD")
+
+	ts := time.Now().UTC()
+	start := ts.Add(-time.Minute)
+	s.mockOracle.On("GetPriceHistory", start, time.Time{}).Return([]oracle.PriceHistoryEntry{
+		{
+			Timestamp: ts.Add(-time.Second),
+			Prices: map[types.CurrencyPair]*big.Int{
+				cp1: big.NewInt(100),
+				cp2: big.NewInt(200),
+			},
+			ProviderPrices: map[string]map[types.CurrencyPair]providertypes.Result[*big.Int]{
+				"b": {
+					cp1: {Value: big.NewInt(101), Timestamp: ts.Add(-time.Second)},
+				},
+				"a": {
+					cp1: {Value: big.NewInt(99), Timestamp: ts.Add(-2 * time.Second)},
+					cp2: {Value: big.NewInt(200), Timestamp: ts.Add(-time.Second)},
+				},
+			},
+		},
+		{
+			Timestamp: ts,
+			Prices: map[types.CurrencyPair]*big.Int{
+				cp1: big.NewInt(102),
+			},
+		},
+	}).Once()
+
+	// call from grpc client
+	resp, err := s.client.PriceHistory(context.Background(), &stypes.QueryPriceHistoryRequest{
+		CurrencyPairs: []string{cp1.String()},
+		StartTime:     start,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]stypes.PriceHistoryEntry{
+		{
+			Timestamp: ts.Add(-time.Second),
+			Prices:    map[string]string{cp1.String(): "100"},
+			ProviderPrices: map[string]stypes.ProviderPrices{
+				cp1.String(): {
+					Prices: []stypes.ProviderPrice{
+						{Provider: "a", Price: "99", Timestamp: ts.Add(-2 * time.Second)},
+						{Provider: "b", Price: "101", Timestamp: ts.Add(-time.Second)},
+					},
+				},
+			},
+		},
+		{
+			Timestamp: ts,
+			Prices:    map[string]string{cp1.String(): "102"},
+		},
+	}, resp.Entries)
+
+	// call from http client with a time range
+	end := ts.Add(-time.Second).Truncate(time.Second)
+	s.mockOracle.On("GetPriceHistory", start.Truncate(time.Second), end).Return([]oracle.PriceHistoryEntry{
+		{
+			Timestamp: end,
+			Prices: map[types.CurrencyPair]*big.Int{
+				cp2: big.NewInt(200),
+			},
+		},
+	}).Once()
+
+	httpResp, err := s.httpClient.Get(fmt.Sprintf(
+		"http://%s:%s/slinky/oracle/v1/prices/history?start_time=%s&end_time=%s",
+		localhost, port, start.Truncate(time.Second).Format(time.RFC3339), end.Format(time.RFC3339),
+	))
+	s.Require().NoError(err)
+
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), fmt.Sprintf(`"prices":{"%s":"200"}`, cp2.String()))
+}
+
+func (s *ServerTestSuite) TestOracleServerPriceHistoryInvalidRequest() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	_, err := s.client.PriceHistory(context.Background(), &stypes.QueryPriceHistoryRequest{
+		CurrencyPairs: []string{"BTCUSD"},
+	})
+	s.Require().Error(err)
+
+	// the end time cannot be before the start time
+	_, err = s.client.PriceHistory(context.Background(), &stypes.QueryPriceHistoryRequest{
+		StartTime: time.Now(),
+		EndTime:   time.Now().Add(-time.Minute),
+	})
+	s.Require().Error(err)
+	s.Require().Equal(grpcErrPrefix+server.ErrInvalidTimeRange.Error(), err.Error())
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesNotRunning() {
+	// set the mock oracle to not be running
+	s.mockOracle.On("IsRunning").Return(false)
+
+	stream, err := s.client.StreamPrices(context.Background(), &stypes.StreamPricesRequest{})
+	s.Require().NoError(err)
+
+	// expect oracle not running error
+	_, err = stream.Recv()
+	s.Require().Equal(err.Error(), grpcErrPrefix+server.ErrOracleNotRunning.Error())
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesInvalidCurrencyPair() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	stream, err := s.client.StreamPrices(context.Background(), &stypes.StreamPricesRequest{
+		CurrencyPairs: []string{"BTCUSD"},
+	})
+	s.Require().NoError(err)
+
+	_, err = stream.Recv()
+	s.Require().Error(err)
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPrices() {
+	cp1 := types.CurrencyPair{
+		Base:  "BTC",
+		Quote: "USD",
+	}
+
+	cp2 := types.CurrencyPair{
+		Base:  "ETH",
+		Quote: "USD",
+	}
+
+	updates := make(chan struct{})
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("SubscribePrices", mock.Anything).Return((<-chan struct{})(updates))
+	ts := time.Now()
+	s.mockOracle.On("GetPriceDetails").Return(oracle.PriceDetails{
+		Timestamp: ts,
+		Prices: map[types.CurrencyPair]*big.Int{
+			cp1: big.NewInt(100),
+			cp2: big.NewInt(200),
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := s.client.StreamPrices(ctx, &stypes.StreamPricesRequest{
+		CurrencyPairs: []string{"btc/usd"},
+	})
+	s.Require().NoError(err)
+
+	// the current prices are sent immediately, followed by a response after every update
+	for i := 0; i < 3; i++ {
+		if i > 0 {
+			updates <- struct{}{}
+		}
+
+		resp, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]string{cp1.String(): "100"}, resp.Prices)
+		s.Require().Equal(ts.UTC(), resp.Timestamp)
+	}
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesMinInterval() {
+	updates := make(chan struct{})
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("SubscribePrices", mock.Anything).Return((<-chan struct{})(updates))
+	s.mockOracle.On("GetPriceDetails").Return(oracle.PriceDetails{Timestamp: time.Now()})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	minInterval := 500 * time.Millisecond
+	stream, err := s.client.StreamPrices(ctx, &stypes.StreamPricesRequest{
+		MinInterval: minInterval,
+	})
+	s.Require().NoError(err)
+
+	start := time.Now()
+	_, err = stream.Recv()
+	s.Require().NoError(err)
+
+	// updates are skipped until the minimum interval has elapsed
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case updates <- struct{}{}:
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+	}()
+
+	_, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(time.Since(start), minInterval)
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesTrailingUpdate() {
+	btc := types.NewCurrencyPair("BTC", "USD")
+
+	var price atomic.Int64
+	price.Store(100)
+
+	updates := make(chan struct{})
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("SubscribePrices", mock.Anything).Return((<-chan struct{})(updates))
+	s.mockOracle.On("GetPriceDetails").Return(func() oracle.PriceDetails {
+		return oracle.PriceDetails{
+			Timestamp: time.Now(),
+			Prices:    map[types.CurrencyPair]*big.Int{btc: big.NewInt(price.Load())},
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	minInterval := 500 * time.Millisecond
+	stream, err := s.client.StreamPrices(ctx, &stypes.StreamPricesRequest{
+		MinInterval: minInterval,
+	})
+	s.Require().NoError(err)
+
+	start := time.Now()
+	resp, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Equal("100", resp.Prices[btc.String()])
+
+	// the final update arrives within the minimum interval, and is sent once the interval has
+	// elapsed even though no further updates arrive
+	price.Store(200)
+	updates <- struct{}{}
+
+	resp, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Equal("200", resp.Prices[btc.String()])
+	s.Require().GreaterOrEqual(time.Since(start), minInterval)
+}
+
+func (s *ServerTestSuite) TestOracleServerLiveness() {
+	s.mockOracle.On("IsRunning").Return(true).Once()
+	s.mockOracle.On("IsRunning").Return(false)
+
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s%s", localhost, port, server.LivenessPath))
+	s.Require().NoError(err)
+	defer httpResp.Body.Close()
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+
+	var ls server.LivenessStatus
+	s.Require().NoError(json.NewDecoder(httpResp.Body).Decode(&ls))
+	s.Require().True(ls.Running)
+
+	httpResp, err = s.httpClient.Get(fmt.Sprintf("http://%s:%s%s", localhost, port, server.LivenessPath))
+	s.Require().NoError(err)
+	defer httpResp.Body.Close()
+	s.Require().Equal(http.StatusServiceUnavailable, httpResp.StatusCode)
+}
+
+// setupReadiness sets the mock oracle to report fresh prices for the given number of currency pairs
+// out of three, and fresh provider prices for the given number of providers out of three.
+func (s *ServerTestSuite) setupReadiness(freshPairs, healthyProviders int, lastSync time.Time) {
+	cps := []types.CurrencyPair{
+		{Base: "BTC", Quote: "USD"},
+		{Base: "ETH", Quote: "USD"},
+		{Base: "ATOM", Quote: "USD"},
+	}
+	providers := []string{"binance", "coinbase", "kraken"}
+
+	decimals := make(map[types.CurrencyPair]uint64)
+	prices := make(map[types.CurrencyPair]*big.Int)
+	for i, cp := range cps {
+		decimals[cp] = 8
+		if i < freshPairs {
+			prices[cp] = big.NewInt(100)
+		}
+	}
+
+	providerPrices := make(map[string]map[types.CurrencyPair]providertypes.Result[*big.Int])
+	for _, provider := range providers[:healthyProviders] {
+		providerPrices[provider] = map[types.CurrencyPair]providertypes.Result[*big.Int]{
+			cps[0]: providertypes.NewResult[*big.Int](big.NewInt(100), lastSync),
+		}
+	}
+
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPriceDetails").Return(oracle.PriceDetails{
+		Timestamp:      lastSync,
+		Prices:         prices,
+		ProviderPrices: providerPrices,
+		Withheld:       map[types.CurrencyPair]string{},
+		Decimals:       decimals,
+	})
+	s.mockOracle.On("GetProviderNames").Return(providers)
+}
+
+func (s *ServerTestSuite) getReadiness() (int, server.ReadinessStatus) {
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s%s", localhost, port, server.ReadinessPath))
+	s.Require().NoError(err)
+	defer httpResp.Body.Close()
+
+	var rs server.ReadinessStatus
+	s.Require().NoError(json.NewDecoder(httpResp.Body).Decode(&rs))
+
+	return httpResp.StatusCode, rs
+}
+
+func (s *ServerTestSuite) TestOracleServerReadinessReady() {
+	s.setupReadiness(2, 2, time.Now())
+
+	code, rs := s.getReadiness()
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().True(rs.Ready)
+	s.Require().Equal(2, rs.FreshPairs)
+	s.Require().Equal(3, rs.TotalPairs)
+	s.Require().Equal(2, rs.HealthyProviders)
+	s.Require().Equal(3, rs.TotalProviders)
+	s.Require().Equal([]string{"ATOM/USD"}, rs.DegradedPairs)
+	s.Require().Equal([]string{"kraken"}, rs.DegradedProviders)
+	s.Require().Empty(rs.Reasons)
+}
+
+func (s *ServerTestSuite) TestOracleServerReadinessDegraded() {
+	s.setupReadiness(1, 1, time.Now())
+
+	code, rs := s.getReadiness()
+	s.Require().Equal(http.StatusServiceUnavailable, code)
+	s.Require().False(rs.Ready)
+	s.Require().Equal([]string{"ATOM/USD", "ETH/USD"}, rs.DegradedPairs)
+	s.Require().Equal([]string{"coinbase", "kraken"}, rs.DegradedProviders)
+	s.Require().Len(rs.Reasons, 2)
+}
+
+func (s *ServerTestSuite) TestOracleServerReadinessStale() {
+	s.setupReadiness(3, 3, time.Now().Add(-2*time.Minute))
+
+	code, rs := s.getReadiness()
+	s.Require().Equal(http.StatusServiceUnavailable, code)
+	s.Require().False(rs.Ready)
+	s.Require().Equal(0, rs.FreshPairs)
+	s.Require().Equal(0, rs.HealthyProviders)
+	s.Require().Len(rs.DegradedPairs, 3)
+	s.Require().Len(rs.DegradedProviders, 3)
+}
+
+func (s *ServerTestSuite) TestOracleServerReadinessNotRunning() {
+	s.mockOracle.On("IsRunning").Return(false)
+
+	code, rs := s.getReadiness()
+	s.Require().Equal(http.StatusServiceUnavailable, code)
+	s.Require().False(rs.Ready)
+	s.Require().Equal([]string{server.ErrOracleNotRunning.Error()}, rs.Reasons)
+}
+
+func (s *ServerTestSuite) TestOracleServerGRPCHealth() {
+	s.setupReadiness(1, 1, time.Now())
+
+	conn, err := grpc.Dial(localhost+":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	healthClient := grpc_health_v1.NewHealthClient(conn)
+
+	// the oracle is not ready, so neither the server nor the oracle service are serving
+	for _, service := range []string{"", server.OracleServiceName} {
+		resp, err := healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		s.Require().NoError(err)
+		s.Require().Equal(grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+	}
+
+	_, err = healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	stream, err := healthClient.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	s.Require().NoError(err)
+
+	resp, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Equal(grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+}
+
+// adminClient returns a client of the admin service, along with a context that authenticates
+// requests with the given token.
+func (s *ServerTestSuite) adminClient(token string) (stypes.AdminClient, context.Context) {
+	conn, err := grpc.Dial(localhost+":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { conn.Close() })
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), server.AuthorizationHeader, "Bearer "+token)
+	return stypes.NewAdminClient(conn), ctx
+}
+
+func (s *ServerTestSuite) TestOracleServerAdminUnauthenticated() {
+	adminClient, ctx := s.adminClient("invalid")
+
+	// requests with an invalid token are rejected
+	_, err := adminClient.Providers(ctx, &stypes.QueryProvidersRequest{})
+	s.Require().Equal(codes.Unauthenticated, status.Code(err))
+
+	// requests without a token are rejected
+	_, err = adminClient.DisableProvider(context.Background(), &stypes.DisableProviderRequest{Provider: "provider1"})
+	s.Require().Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *ServerTestSuite) TestOracleServerAdminProviders() {
+	btc := types.NewCurrencyPair("BITCOIN", "USD")
+	eth := types.NewCurrencyPair("ETHEREUM", "USD")
+	now := time.Now().UTC()
+
+	s.mockOracle.On("GetProviderStatuses").Return([]oracle.ProviderStatus{
+		{
+			Name:          "provider1",
+			Type:          providertypes.WebSockets,
+			Connection:    providertypes.Connected,
+			Disabled:      true,
+			DisabledUntil: now.Add(time.Minute),
+			Errors:        3,
+			Pairs: map[types.CurrencyPair]oracle.PairStatus{
+				eth: {
+					Errors:    3,
+					LastError: "no response",
+				},
+				btc: {
+					LastUpdate:    now,
+					Excluded:      true,
+					ExcludedUntil: now.Add(time.Hour),
+				},
+			},
+		},
+	})
+
+	adminClient, ctx := s.adminClient(adminToken)
+	resp, err := adminClient.Providers(ctx, &stypes.QueryProvidersRequest{})
+	s.Require().NoError(err)
+
+	s.Require().Len(resp.Providers, 1)
+	provider := resp.Providers[0]
+	s.Require().Equal("provider1", provider.Name)
+	s.Require().Equal(string(providertypes.WebSockets), provider.ProviderType)
+	s.Require().Equal(string(providertypes.Connected), provider.ConnectionStatus)
+	s.Require().True(provider.Disabled)
+	s.Require().True(now.Add(time.Minute).Equal(provider.DisabledUntil))
+	s.Require().Equal(uint64(3), provider.Errors)
+
+	// the currency pairs are sorted
+	s.Require().Len(provider.Pairs, 2)
+	s.Require().Equal(btc.String(), provider.Pairs[0].CurrencyPair)
+	s.Require().True(now.Equal(provider.Pairs[0].LastUpdate))
+	s.Require().True(provider.Pairs[0].Excluded)
+	s.Require().True(now.Add(time.Hour).Equal(provider.Pairs[0].ExcludedUntil))
+	s.Require().Equal(eth.String(), provider.Pairs[1].CurrencyPair)
+	s.Require().Equal(uint64(3), provider.Pairs[1].Errors)
+	s.Require().Equal("no response", provider.Pairs[1].LastError)
+	s.Require().False(provider.Pairs[1].Excluded)
+}
+
+func (s *ServerTestSuite) TestOracleServerAdminOverrides() {
+	btc := types.NewCurrencyPair("BITCOIN", "USD")
+
+	s.mockOracle.On("DisableProvider", "provider1", time.Minute).Return(nil)
+	s.mockOracle.On("EnableProvider", "unknown").Return(fmt.Errorf("unknown provider unknown"))
+	s.mockOracle.On("ExcludePair", "provider1", btc, time.Duration(0)).Return(nil)
+	s.mockOracle.On("IncludePair", "provider1", btc).Return(nil)
+
+	adminClient, ctx := s.adminClient(adminToken)
+
+	_, err := adminClient.DisableProvider(ctx, &stypes.DisableProviderRequest{Provider: "provider1", Duration: time.Minute})
+	s.Require().NoError(err)
+
+	_, err = adminClient.EnableProvider(ctx, &stypes.EnableProviderRequest{Provider: "unknown"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = adminClient.ExcludePair(ctx, &stypes.ExcludePairRequest{Provider: "provider1", CurrencyPair: "BITCOIN-USD"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = adminClient.ExcludePair(ctx, &stypes.ExcludePairRequest{Provider: "provider1", CurrencyPair: btc.String()})
+	s.Require().NoError(err)
+
+	_, err = adminClient.IncludePair(ctx, &stypes.IncludePairRequest{Provider: "provider1", CurrencyPair: btc.String()})
+	s.Require().NoError(err)
+}
+
 // test that the oracle server closes when expected.
 func (s *ServerTestSuite) TestOracleServerClose() {
 	// close the server, and check that no requests are received
@@ -199,3 +873,145 @@ func TestOracleFailureStopsServer(t *testing.T) {
 		t.Fatal("server failed to stop")
 	}
 }
+
+func TestOracleServerTLS(t *testing.T) {
+	const tlsPort = "8082"
+	files := testutils.CreateTLSFiles(t)
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true).Maybe()
+	mockOracle.On("GetProviderNames").Return([]string{}).Maybe()
+	mockOracle.On("GetPriceDetails").Return(oracle.PriceDetails{
+		Timestamp: time.Now(),
+		Prices: map[types.CurrencyPair]*big.Int{
+			types.NewCurrencyPair("BTC", "USD"): big.NewInt(100),
+		},
+	}).Maybe()
+
+	srv := server.NewOracleServer(
+		mockOracle,
+		zap.NewNop(),
+		server.WithTLSConfig(config.TLSConfig{
+			Enabled:           true,
+			CertFile:          files.ServerCertFile,
+			KeyFile:           files.ServerKeyFile,
+			CAFile:            files.CAFile,
+			RequireClientCert: true,
+		}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, tlsPort)
+
+	// newClient creates and starts a client of the server with the given TLS config.
+	newClient := func(cfg config.TLSConfig) client.OracleClient {
+		c, err := client.NewClientFromConfig(
+			config.AppConfig{
+				Enabled:       true,
+				OracleAddress: localhost + ":" + tlsPort,
+				ClientTimeout: timeout,
+				TLS:           cfg,
+			},
+			log.NewTestLogger(t),
+			metrics.NewNopMetrics(),
+		)
+		require.NoError(t, err)
+		require.NoError(t, c.Start(context.Background()))
+		t.Cleanup(func() { require.NoError(t, c.Stop()) })
+
+		return c
+	}
+
+	t.Run("client with a certificate", func(t *testing.T) {
+		c := newClient(config.TLSConfig{
+			Enabled:  true,
+			CertFile: files.ClientCertFile,
+			KeyFile:  files.ClientKeyFile,
+			CAFile:   files.CAFile,
+		})
+
+		require.Eventually(t, func() bool {
+			resp, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+			return err == nil && resp.Prices["BTC/USD"] == "100"
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("http client with a certificate", func(t *testing.T) {
+		cfg := config.TLSConfig{
+			Enabled:  true,
+			CertFile: files.ClientCertFile,
+			KeyFile:  files.ClientKeyFile,
+			CAFile:   files.CAFile,
+		}
+		tlsCfg, err := cfg.ClientTLSConfig()
+		require.NoError(t, err)
+
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsCfg}}
+		httpResp, err := httpClient.Get(fmt.Sprintf("https://%s:%s/slinky/oracle/v1/prices", localhost, tlsPort))
+		require.NoError(t, err)
+		defer httpResp.Body.Close()
+
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+		respBz, err := io.ReadAll(httpResp.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(respBz), `{"prices":{"BTC/USD":"100"}`)
+	})
+
+	t.Run("client without a certificate", func(t *testing.T) {
+		c := newClient(config.TLSConfig{
+			Enabled: true,
+			CAFile:  files.CAFile,
+		})
+
+		_, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	// Client certificates are only required by the oracle service, such that the health endpoints
+	// remain reachable by probes without a certificate.
+	withoutCert := config.TLSConfig{
+		Enabled: true,
+		CAFile:  files.CAFile,
+	}
+	withoutCertTLS, err := withoutCert.ClientTLSConfig()
+	require.NoError(t, err)
+
+	t.Run("http client without a certificate", func(t *testing.T) {
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: withoutCertTLS}}
+
+		httpResp, err := httpClient.Get(fmt.Sprintf("https://%s:%s%s", localhost, tlsPort, server.LivenessPath))
+		require.NoError(t, err)
+		httpResp.Body.Close()
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+
+		httpResp, err = httpClient.Get(fmt.Sprintf("https://%s:%s/slinky/oracle/v1/prices", localhost, tlsPort))
+		require.NoError(t, err)
+		httpResp.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, httpResp.StatusCode)
+	})
+
+	t.Run("grpc health check without a certificate", func(t *testing.T) {
+		conn, err := grpc.Dial(localhost+":"+tlsPort, grpc.WithTransportCredentials(credentials.NewTLS(withoutCertTLS)))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.NoError(t, err)
+	})
+
+	t.Run("client without tls", func(t *testing.T) {
+		c := newClient(config.TLSConfig{})
+
+		_, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+		require.Error(t, err)
+	})
+
+	srv.Close()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
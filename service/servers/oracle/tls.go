@@ -0,0 +1,88 @@
+package oracle
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requireOracleClientCert is a grpc interceptor that rejects requests to the oracle service from
+// clients that did not present a certificate signed by the configured certificate authority, if
+// client certificates are required. Client certificates are only verified at the TLS layer if
+// given, such that the health endpoints and the other services remain reachable without one.
+func (os *OracleServer) requireOracleClientCert(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := os.verifyOracleClientCert(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// requireOracleClientCertStream is the stream equivalent of requireOracleClientCert.
+func (os *OracleServer) requireOracleClientCertStream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := os.verifyOracleClientCert(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// verifyOracleClientCert returns an error if the given method belongs to the oracle service and
+// the client of the request did not present a verified certificate, while client certificates
+// are required.
+func (os *OracleServer) verifyOracleClientCert(ctx context.Context, method string) error {
+	if !os.requiresClientCert() || !strings.HasPrefix(method, "/"+OracleServiceName+"/") {
+		return nil
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && hasVerifiedClientCert(&info.State) {
+			return nil
+		}
+	}
+
+	os.logger.Warn("rejected oracle request without a client certificate", zap.String("method", method))
+	return status.Error(codes.Unauthenticated, "client certificate required")
+}
+
+// requireGatewayClientCert wraps the grpc-gateway, which serves the oracle service over http, such
+// that requests from clients that did not present a verified certificate are rejected if client
+// certificates are required.
+func (os *OracleServer) requireGatewayClientCert(w http.ResponseWriter, r *http.Request) bool {
+	if !os.requiresClientCert() || hasVerifiedClientCert(r.TLS) {
+		return true
+	}
+
+	os.logger.Warn("rejected oracle request without a client certificate", zap.String("path", r.URL.Path))
+	http.Error(w, "client certificate required", http.StatusUnauthorized)
+	return false
+}
+
+// requiresClientCert returns true if clients of the oracle service must present a certificate.
+func (os *OracleServer) requiresClientCert() bool {
+	return os.tlsCfg.Enabled && os.tlsCfg.RequireClientCert
+}
+
+// hasVerifiedClientCert returns true if the client presented a certificate that was verified
+// against the configured certificate authority during the TLS handshake.
+func hasVerifiedClientCert(state *tls.ConnectionState) bool {
+	return state != nil && len(state.VerifiedChains) > 0
+}
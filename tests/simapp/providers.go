@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"sync"
 
 	"go.uber.org/zap"
 
@@ -16,6 +17,7 @@ import (
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
 	providermetrics "github.com/skip-mev/slinky/providers/base/metrics"
+	"github.com/skip-mev/slinky/providers/base/replay"
 	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
 	"github.com/skip-mev/slinky/providers/static"
@@ -34,9 +36,58 @@ import (
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
 
+// ProviderFactoryOption is a function that is used to configure the default provider factory.
+type ProviderFactoryOption func(*providerFactoryOptions)
+
+// providerFactoryOptions is the set of options of the default provider factory.
+type providerFactoryOptions struct {
+	// recorder records the traffic of every provider, if set.
+	recorder *replay.Recorder
+
+	// player replays the recorded traffic of every provider instead of connecting to the
+	// data providers, if set.
+	player *replay.Player
+}
+
+// WithRecorder is an option that is used to record the raw websocket frames and HTTP responses
+// received by every provider.
+func WithRecorder(recorder *replay.Recorder) ProviderFactoryOption {
+	return func(o *providerFactoryOptions) {
+		if recorder == nil {
+			panic("recorder cannot be nil")
+		}
+
+		o.recorder = recorder
+	}
+}
+
+// WithPlayer is an option that is used to replay recorded provider traffic instead of connecting
+// to the data providers. This allows the oracle to run deterministically without network access.
+func WithPlayer(player *replay.Player) ProviderFactoryOption {
+	return func(o *providerFactoryOptions) {
+		if player == nil {
+			panic("player cannot be nil")
+		}
+
+		o.player = player
+	}
+}
+
 // DefaultProviderFactory returns a sample implementation of the provider factory. This provider
 // factory function returns providers that are API & websocket based.
-func DefaultProviderFactory() providertypes.ProviderFactory[oracletypes.CurrencyPair, *big.Int] {
+func DefaultProviderFactory(opts ...ProviderFactoryOption) providertypes.ProviderFactory[oracletypes.CurrencyPair, *big.Int] {
+	var (
+		once       sync.Once
+		mWebSocket wsmetrics.WebSocketMetrics
+		mAPI       apimetrics.APIMetrics
+		mProviders providermetrics.ProviderMetrics
+		options    providerFactoryOptions
+	)
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	return func(logger *zap.Logger, cfg config.OracleConfig) ([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int], error) {
 		if err := cfg.ValidateBasic(); err != nil {
 			return nil, err
@@ -44,24 +95,34 @@ func DefaultProviderFactory() providertypes.ProviderFactory[oracletypes.Currency
 
 		cps := cfg.Market.GetCurrencyPairs()
 
-		// Create the metrics that are used by the providers.
-		mWebSocket := wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics)
-		mAPI := apimetrics.NewAPIMetricsFromConfig(cfg.Metrics)
-		mProviders := providermetrics.NewProviderMetricsFromConfig(cfg.Metrics)
+		// Create the metrics that are used by the providers. The metrics are only created once
+		// since the factory is invoked again whenever the oracle config is reloaded.
+		once.Do(func() {
+			mWebSocket = wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics)
+			mAPI = apimetrics.NewAPIMetricsFromConfig(cfg.Metrics)
+			mProviders = providermetrics.NewProviderMetricsFromConfig(cfg.Metrics)
+		})
 
 		// Create the providers.
 		providers := make([]providertypes.Provider[oracletypes.CurrencyPair, *big.Int], 0)
 		for _, p := range cfg.Providers {
+			// Report the provider prices with the same decimals as the aggregated prices.
+			market, err := p.Market.WithDecimals(cfg.Market)
+			if err != nil {
+				return nil, err
+			}
+			p.Market = market
+
 			switch {
 			case p.API.Enabled:
-				provider, err := apiProviderFromProviderConfig(logger, p, cps, mAPI, mProviders)
+				provider, err := apiProviderFromProviderConfig(logger, p, cps, mAPI, mProviders, options)
 				if err != nil {
 					return nil, err
 				}
 
 				providers = append(providers, provider)
 			case p.WebSocket.Enabled:
-				provider, err := webSocketProviderFromProviderConfig(logger, p, cps, mWebSocket, mProviders)
+				provider, err := webSocketProviderFromProviderConfig(logger, p, cps, mWebSocket, mProviders, options)
 				if err != nil {
 					return nil, err
 				}
@@ -85,6 +146,7 @@ func apiProviderFromProviderConfig(
 	cps []oracletypes.CurrencyPair,
 	mAPI apimetrics.APIMetrics,
 	mProvider providermetrics.ProviderMetrics,
+	options providerFactoryOptions,
 ) (providertypes.Provider[oracletypes.CurrencyPair, *big.Int], error) {
 	// Validate the provider config.
 	err := cfg.ValidateBasic()
@@ -134,14 +196,21 @@ func apiProviderFromProviderConfig(
 		return nil, err
 	}
 
-	// If a custom request handler is not provided, create a new default one.
+	// If a custom request handler is not provided, create a new default one. The default request
+	// handler adds the configured headers and query parameters, i.e. API keys, to each request.
 	if requestHandler == nil {
-		requestHandler, err = apihandlers.NewRequestHandlerImpl(client)
+		requestHandler, err = apihandlers.NewRequestHandlerImpl(client, apihandlers.WithAPIConfig(cfg.API))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// Record or replay the provider traffic, if configured.
+	requestHandler, err = options.requestHandler(logger, cfg.Name, requestHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the API query handler which encapsulates all of the fetching and parsing logic.
 	apiQueryHandler, err := apihandlers.NewAPIQueryHandler[oracletypes.CurrencyPair, *big.Int](
 		logger,
@@ -173,6 +242,7 @@ func webSocketProviderFromProviderConfig(
 	cps []oracletypes.CurrencyPair,
 	wsMetrics wsmetrics.WebSocketMetrics,
 	pMetrics providermetrics.ProviderMetrics,
+	options providerFactoryOptions,
 ) (providertypes.Provider[oracletypes.CurrencyPair, *big.Int], error) {
 	// Validate the provider config.
 	err := cfg.ValidateBasic()
@@ -238,6 +308,7 @@ func webSocketProviderFromProviderConfig(
 		connHandler, err = wshandlers.NewWebSocketHandlerImpl(
 			cfg.WebSocket,
 			wshandlers.WithPreDialHook(kucoin.PreDialHook(cfg.API, requestHandler)),
+			wshandlers.WithMetrics(wsMetrics),
 		)
 	case mexc.Name:
 		wsDataHandler, err = mexc.NewWebSocketDataHandler(logger, cfg)
@@ -252,12 +323,18 @@ func webSocketProviderFromProviderConfig(
 
 	// If a custom request handler is not provided, create a new default one.
 	if connHandler == nil {
-		connHandler, err = wshandlers.NewWebSocketHandlerImpl(cfg.WebSocket)
+		connHandler, err = wshandlers.NewWebSocketHandlerImpl(cfg.WebSocket, wshandlers.WithMetrics(wsMetrics))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// Record or replay the provider traffic, if configured.
+	connHandler, err = options.connHandler(logger, cfg, connHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the websocket query handler which encapsulates all fetching and parsing logic.
 	wsQueryHandler, err := wshandlers.NewWebSocketQueryHandler[oracletypes.CurrencyPair, *big.Int](
 		logger,
@@ -278,6 +355,7 @@ func webSocketProviderFromProviderConfig(
 		base.WithWebSocketConfig[oracletypes.CurrencyPair, *big.Int](cfg.WebSocket),
 		base.WithIDs[oracletypes.CurrencyPair, *big.Int](filteredCPs),
 		base.WithMetrics[oracletypes.CurrencyPair, *big.Int](pMetrics),
+		base.WithWebSocketMetrics[oracletypes.CurrencyPair, *big.Int](wsMetrics),
 	)
 }
 
@@ -305,3 +383,39 @@ func filterForConfiguredCurrencyPairs(
 
 	return filteredCps, nil
 }
+
+// requestHandler returns a request handler that records the responses returned by the given request
+// handler, or that replays the recorded responses, if configured. Otherwise, the given request handler
+// is returned.
+func (o providerFactoryOptions) requestHandler(
+	logger *zap.Logger,
+	name string,
+	handler apihandlers.RequestHandler,
+) (apihandlers.RequestHandler, error) {
+	switch {
+	case o.player != nil:
+		return replay.NewReplayRequestHandler(o.player, name, handler.Type())
+	case o.recorder != nil:
+		return replay.NewRecordingRequestHandler(logger, name, handler, o.recorder)
+	default:
+		return handler, nil
+	}
+}
+
+// connHandler returns a websocket connection handler that records the frames read by the given
+// connection handler, or that replays the recorded frames, if configured. Otherwise, the given
+// connection handler is returned.
+func (o providerFactoryOptions) connHandler(
+	logger *zap.Logger,
+	cfg config.ProviderConfig,
+	handler wshandlers.WebSocketConnHandler,
+) (wshandlers.WebSocketConnHandler, error) {
+	switch {
+	case o.player != nil:
+		return replay.NewReplayWebSocketConnHandler(o.player, cfg.Name, cfg.WebSocket.ReadTimeout)
+	case o.recorder != nil:
+		return replay.NewRecordingWebSocketConnHandler(logger, cfg.Name, handler, o.recorder)
+	default:
+		return handler, nil
+	}
+}
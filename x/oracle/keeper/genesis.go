@@ -18,7 +18,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 
 	// initialize all CurrencyPairs + genesis prices
 	for _, cpg := range gs.CurrencyPairGenesis {
-		state := types.NewCurrencyPairState(cpg.Id, cpg.Nonce, cpg.CurrencyPairPrice)
+		state := types.NewCurrencyPairState(cpg.Id, cpg.Nonce, cpg.CurrencyPairPrice, cpg.GetDecimalsOrDefault())
 
 		if err := k.currencyPairs.Set(ctx, cpg.CurrencyPair.String(), state); err != nil {
 			panic(fmt.Errorf("error in genesis: %w", err))
@@ -52,6 +52,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			Id:                cps.Id,
 			Nonce:             cps.Nonce,
 			CurrencyPairPrice: cps.Price,
+			Decimals:          cps.Decimals,
 		})
 	})
 
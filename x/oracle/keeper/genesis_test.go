@@ -262,3 +262,35 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		}
 	})
 }
+
+func (s *KeeperTestSuite) TestGenesisDecimals() {
+	gs := types.GenesisState{
+		CurrencyPairGenesis: []types.CurrencyPairGenesis{
+			{
+				CurrencyPair: types.CurrencyPair{
+					Base:  "PEPE",
+					Quote: "USD",
+				},
+				Id:       0,
+				Decimals: 18,
+			},
+			{
+				// geneses exported before decimals were stored in state use the legacy decimals
+				CurrencyPair: types.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USD",
+				},
+				Id: 1,
+			},
+		},
+		NextId: 2,
+	}
+	s.oracleKeeper.InitGenesis(s.ctx, gs)
+
+	expected := map[string]uint64{"PEPE/USD": 18, "BITCOIN/USD": 8}
+	for _, cpg := range s.oracleKeeper.ExportGenesis(s.ctx).CurrencyPairGenesis {
+		decimals, ok := expected[cpg.CurrencyPair.String()]
+		s.Require().True(ok)
+		s.Require().Equal(decimals, cpg.Decimals)
+	}
+}
@@ -85,11 +85,16 @@ func (q queryServer) GetPrice(goCtx context.Context, req *types.GetPriceRequest)
 		return nil, fmt.Errorf("no ID found for CurrencyPair: %v", cp)
 	}
 
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, fmt.Errorf("no decimals found for CurrencyPair: %v", cp)
+	}
+
 	// return the QuotePrice + Nonce
 	return &types.GetPriceResponse{
 		Price:    &qpn.QuotePrice,
 		Nonce:    qpn.Nonce(),
-		Decimals: uint64(cp.Decimals()),
+		Decimals: decimals,
 		Id:       id,
 	}, nil
 }
@@ -124,10 +129,15 @@ func (q queryServer) GetPrices(goCtx context.Context, req *types.GetPricesReques
 			return nil, fmt.Errorf("no ID found for CurrencyPair: %v", cp)
 		}
 
+		decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+		if err != nil {
+			return nil, fmt.Errorf("no decimals found for CurrencyPair: %v", cp)
+		}
+
 		prices = append(prices, types.GetPriceResponse{
 			Price:    &qpn.QuotePrice,
 			Nonce:    qpn.Nonce(),
-			Decimals: uint64(cp.Decimals()),
+			Decimals: decimals,
 			Id:       id,
 		})
 	}
@@ -177,7 +177,7 @@ func (k Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp types.CurrencyPair,
 			return err
 		}
 
-		cps = types.NewCurrencyPairState(id, 0, &qp)
+		cps = types.NewCurrencyPairState(id, 0, &qp, cp.LegacyDecimals())
 	} else {
 		// update the nonce
 		cps.Nonce++
@@ -189,23 +189,48 @@ func (k Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp types.CurrencyPair,
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
-// the nonce for the CurrencyPair is set to 0.
+// the nonce for the CurrencyPair is set to 0, and its price is reported with the legacy decimals of the CurrencyPair.
 func (k Keeper) CreateCurrencyPair(ctx sdk.Context, cp types.CurrencyPair) error {
+	return k.CreateCurrencyPairWithDecimals(ctx, cp, cp.LegacyDecimals())
+}
+
+// CreateCurrencyPairWithDecimals creates a CurrencyPair in state whose price is reported with the given number of decimals, and sets its ID
+// to the next available ID. If the CurrencyPair already exists, or the decimals are invalid, return an error. The nonce for the CurrencyPair is set to 0.
+func (k Keeper) CreateCurrencyPairWithDecimals(ctx sdk.Context, cp types.CurrencyPair, decimals uint64) error {
 	// check if the currency pair already exists
 	if k.HasCurrencyPair(ctx, cp) {
 		return types.NewCurrencyPairAlreadyExistsError(cp)
 	}
 
+	if err := types.ValidateDecimals(decimals); err != nil {
+		return err
+	}
+
 	id, err := k.nextCurrencyPairID.Next(ctx)
 	if err != nil {
 		return err
 	}
 
-	state := types.NewCurrencyPairState(id, 0, nil)
+	state := types.NewCurrencyPairState(id, 0, nil, decimals)
 
 	return k.currencyPairs.Set(ctx, cp.String(), state)
 }
 
+// GetDecimalsForCurrencyPair returns the number of decimals that the price of a given CurrencyPair is reported with. If the CurrencyPair
+// does not exist, return an error. CurrencyPairs that were stored before decimals were tracked in state use their legacy decimals.
+func (k Keeper) GetDecimalsForCurrencyPair(ctx sdk.Context, cp types.CurrencyPair) (uint64, error) {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return 0, err
+	}
+
+	if cps.Decimals == 0 {
+		return cp.LegacyDecimals(), nil
+	}
+
+	return cps.Decimals, nil
+}
+
 // GetIDForCurrencyPair returns the ID for a given CurrencyPair. If the CurrencyPair does not exist, return 0, false, if
 // it does, return true and the ID.
 func (k Keeper) GetIDForCurrencyPair(ctx sdk.Context, cp types.CurrencyPair) (uint64, bool) {
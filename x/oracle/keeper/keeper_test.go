@@ -6,6 +6,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,14 +29,17 @@ type KeeperTestSuite struct {
 
 	oracleKeeper keeper.Keeper
 	ctx          sdk.Context
+	storeKey     *storetypes.KVStoreKey
+	cdc          codec.Codec
 }
 
 func (s *KeeperTestSuite) SetupTest() {
-	key := storetypes.NewKVStoreKey(types.StoreKey)
-	ss := runtime.NewKVStoreService(key)
+	s.storeKey = storetypes.NewKVStoreKey(types.StoreKey)
+	ss := runtime.NewKVStoreService(s.storeKey)
 	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.cdc = encCfg.Codec
 	s.oracleKeeper = keeper.NewKeeper(ss, encCfg.Codec, moduleAuthAddr)
-	s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+	s.ctx = testutil.DefaultContext(s.storeKey, storetypes.NewTransientStoreKey("transient_key"))
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -198,6 +202,38 @@ func (s *KeeperTestSuite) TestCreateCurrencyPair() {
 		err := s.oracleKeeper.CreateCurrencyPair(s.ctx, cp)
 		s.Require().Equal(err.Error(), types.NewCurrencyPairAlreadyExistsError(cp).Error())
 	})
+
+	s.Run("creating a currency-pair uses the legacy decimals", func() {
+		decimals, err := s.oracleKeeper.GetDecimalsForCurrencyPair(s.ctx, cp)
+		s.Require().Nil(err)
+		s.Require().Equal(cp.LegacyDecimals(), decimals)
+	})
+}
+
+func (s *KeeperTestSuite) TestCreateCurrencyPairWithDecimals() {
+	cp := types.CurrencyPair{
+		Base:  "PEPE",
+		Quote: "USD",
+	}
+
+	s.Run("creating a currency-pair with invalid decimals fails", func() {
+		s.Require().NotNil(s.oracleKeeper.CreateCurrencyPairWithDecimals(s.ctx, cp, 0))
+		s.Require().NotNil(s.oracleKeeper.CreateCurrencyPairWithDecimals(s.ctx, cp, types.MaxDecimals+1))
+		s.Require().False(s.oracleKeeper.HasCurrencyPair(s.ctx, cp))
+	})
+
+	s.Run("creating a currency-pair with decimals stores the decimals", func() {
+		s.Require().Nil(s.oracleKeeper.CreateCurrencyPairWithDecimals(s.ctx, cp, 18))
+
+		decimals, err := s.oracleKeeper.GetDecimalsForCurrencyPair(s.ctx, cp)
+		s.Require().Nil(err)
+		s.Require().Equal(uint64(18), decimals)
+	})
+
+	s.Run("getting the decimals of a currency-pair that does not exist fails", func() {
+		_, err := s.oracleKeeper.GetDecimalsForCurrencyPair(s.ctx, types.CurrencyPair{Base: "NOT", Quote: "FOUND"})
+		s.Require().NotNil(err)
+	})
 }
 
 func (s *KeeperTestSuite) TestIDForCurrencyPair() {
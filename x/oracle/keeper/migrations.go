@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place state migrations of the x/oracle module.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator for the given keeper.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the x/oracle module's state from consensus version 1 to 2. Version 2 stores the
+// number of decimals that the price of each CurrencyPair is reported with. Every existing CurrencyPair is
+// assigned its legacy decimals (18 if the quote is ETHEREUM, 8 otherwise) so that its reported prices are
+// unchanged.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	states := make(map[string]types.CurrencyPairState)
+	if err := m.k.IterateCurrencyPairs(ctx, func(cp types.CurrencyPair, cps types.CurrencyPairState) {
+		if cps.Decimals == 0 {
+			cps.Decimals = cp.LegacyDecimals()
+			states[cp.String()] = cps
+		}
+	}); err != nil {
+		return err
+	}
+
+	// write the migrated states outside of the iteration to avoid mutating the store while iterating
+	for cp, cps := range states {
+		if err := m.k.currencyPairs.Set(ctx, cp, cps); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
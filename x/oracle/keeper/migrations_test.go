@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/skip-mev/slinky/x/oracle/keeper"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	btc := types.CurrencyPair{Base: "BITCOIN", Quote: "USD"}
+	usdc := types.CurrencyPair{Base: "USDC", Quote: "ETHEREUM"}
+	pepe := types.CurrencyPair{Base: "PEPE", Quote: "USD"}
+
+	// write the currency-pair states as they were stored in consensus version 1 i.e. without decimals
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(s.storeKey))
+	states := collections.NewMap(
+		sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](s.cdc),
+	)
+	s.Require().Nil(states.Set(s.ctx, btc.String(), types.CurrencyPairState{Id: 0}))
+	s.Require().Nil(states.Set(s.ctx, usdc.String(), types.CurrencyPairState{Id: 1}))
+
+	// currency-pairs that already have decimals are not modified
+	s.Require().Nil(s.oracleKeeper.CreateCurrencyPairWithDecimals(s.ctx, pepe, 18))
+
+	s.Require().Nil(keeper.NewMigrator(s.oracleKeeper).Migrate1to2(s.ctx))
+
+	expected := map[types.CurrencyPair]uint64{
+		btc:  8,
+		usdc: 18,
+		pepe: 18,
+	}
+	for cp, decimals := range expected {
+		state, err := states.Get(s.ctx, cp.String())
+		s.Require().Nil(err)
+		s.Require().Equal(decimals, state.Decimals, cp.String())
+		s.Require().Nil(state.ValidateBasic())
+	}
+}
@@ -41,7 +41,9 @@ func (m *msgServer) AddCurrencyPairs(goCtx context.Context, req *types.MsgAddCur
 		// only set the currency-pair if it does not already exist in state
 		if !m.k.HasCurrencyPair(ctx, cp) {
 			// set to state, initial nonce will be zero (no price updates have been made for this CurrencyPair)
-			m.k.CreateCurrencyPair(ctx, cp)
+			if err := m.k.CreateCurrencyPairWithDecimals(ctx, cp, req.GetDecimalsForCurrencyPair(cp)); err != nil {
+				return nil, fmt.Errorf("error creating CurrencyPair %s: %w", cp, err)
+			}
 		}
 	}
 
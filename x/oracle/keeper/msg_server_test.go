@@ -80,6 +80,43 @@ func (s *KeeperTestSuite) TestMsgAddCurrencyPairs() {
 			},
 			true,
 		},
+		{
+			"if decimals are set for a CurrencyPair, they are stored, and existing CurrencyPairs keep their decimals",
+			&types.MsgAddCurrencyPairs{
+				Authority: sdk.AccAddress([]byte(moduleAuth)).String(),
+				CurrencyPairs: []types.CurrencyPair{
+					{
+						Base:  "E",
+						Quote: "F",
+					},
+					{
+						Base:  "G",
+						Quote: "H",
+					},
+				},
+				Decimals: []types.CurrencyPairDecimals{
+					{CurrencyPair: types.CurrencyPair{Base: "E", Quote: "F"}, Decimals: 18},
+					{CurrencyPair: types.CurrencyPair{Base: "G", Quote: "H"}, Decimals: 18},
+				},
+			},
+			true,
+		},
+		{
+			"if the decimals of a new CurrencyPair are invalid - fail",
+			&types.MsgAddCurrencyPairs{
+				Authority: sdk.AccAddress([]byte(moduleAuth)).String(),
+				CurrencyPairs: []types.CurrencyPair{
+					{
+						Base:  "I",
+						Quote: "J",
+					},
+				},
+				Decimals: []types.CurrencyPairDecimals{
+					{CurrencyPair: types.CurrencyPair{Base: "I", Quote: "J"}, Decimals: types.MaxDecimals + 1},
+				},
+			},
+			false,
+		},
 	}
 
 	initCP := types.CurrencyPair{
@@ -125,11 +162,17 @@ func (s *KeeperTestSuite) TestMsgAddCurrencyPairs() {
 				nonce, err := s.oracleKeeper.GetNonceForCurrencyPair(s.ctx, cp)
 				s.Require().Nil(err)
 
-				// check the nonce is correct (if the cp had already existed in state, check that it was not overwritten)
+				// get the decimals for cpg.CurrencyPair
+				decimals, err := s.oracleKeeper.GetDecimalsForCurrencyPair(s.ctx, cp)
+				s.Require().Nil(err)
+
+				// check the nonce + decimals are correct (if the cp had already existed in state, check that it was not overwritten)
 				if cp.String() == "E/F" {
 					s.Require().Equal(nonce, uint64(100))
+					s.Require().Equal(decimals, cp.LegacyDecimals())
 				} else {
 					s.Require().Equal(nonce, uint64(0))
+					s.Require().Equal(decimals, tc.req.GetDecimalsForCurrencyPair(cp))
 				}
 			}
 		})
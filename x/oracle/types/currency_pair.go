@@ -7,6 +7,10 @@ import (
 
 const (
 	ethereum = "ETHEREUM"
+
+	// MaxDecimals is the maximum number of decimals that the price of a CurrencyPair can be
+	// reported with.
+	MaxDecimals = 36
 )
 
 // NewCurrencyPair returns a new CurrencyPair with the given base and quote strings.
@@ -58,21 +62,33 @@ func CurrencyPairFromString(s string) (CurrencyPair, error) {
 	return cp, cp.ValidateBasic()
 }
 
-// Decimals returns the number of decimals that the quote will be reported to. If the quote is Ethereum, then
-// the number of decimals is 18. Otherwise, the decimals will be reorted to 8.
-func (cp *CurrencyPair) Decimals() int {
+// LegacyDecimals returns the number of decimals that the quote was reported to before decimals were
+// configurable per CurrencyPair. If the quote is Ethereum, then the number of decimals is 18. Otherwise,
+// the decimals will be reported to 8. This is used for CurrencyPairs that do not set their decimals, and
+// to migrate CurrencyPairs that were created before decimals were stored in state.
+func (cp *CurrencyPair) LegacyDecimals() uint64 {
 	if strings.ToUpper(cp.Quote) == ethereum {
 		return 18
 	}
 	return 8
 }
 
-// NewCurrencyPairState returns a new CurrencyPairState given an Id, nonce, and QuotePrice.
-func NewCurrencyPairState(id uint64, nonce uint64, quotePrice *QuotePrice) CurrencyPairState {
+// ValidateDecimals checks that the given number of decimals is within (0, MaxDecimals].
+func ValidateDecimals(decimals uint64) error {
+	if decimals == 0 || decimals > MaxDecimals {
+		return fmt.Errorf("invalid decimals %d, must be between 1 and %d", decimals, MaxDecimals)
+	}
+	return nil
+}
+
+// NewCurrencyPairState returns a new CurrencyPairState given an Id, nonce, QuotePrice, and the number of
+// decimals the price is reported with.
+func NewCurrencyPairState(id uint64, nonce uint64, quotePrice *QuotePrice, decimals uint64) CurrencyPairState {
 	return CurrencyPairState{
-		Id:    id,
-		Nonce: nonce,
-		Price: quotePrice,
+		Id:       id,
+		Nonce:    nonce,
+		Price:    quotePrice,
+		Decimals: decimals,
 	}
 }
 
@@ -89,5 +105,5 @@ func (cps *CurrencyPairState) ValidateBasic() error {
 		return fmt.Errorf("invalid nonce, price update but zero nonce: %v", cps.Nonce)
 	}
 
-	return nil
+	return ValidateDecimals(cps.Decimals)
 }
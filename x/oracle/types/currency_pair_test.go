@@ -111,11 +111,11 @@ func TestToFromString(t *testing.T) {
 	}
 }
 
-func TestDecimals(t *testing.T) {
+func TestLegacyDecimals(t *testing.T) {
 	tcs := []struct {
 		name string
 		cp   types.CurrencyPair
-		dec  int
+		dec  uint64
 	}{
 		{
 			"if the quote is ethereum, return 18",
@@ -131,7 +131,7 @@ func TestDecimals(t *testing.T) {
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
-			assert.Equal(t, tc.cp.Decimals(), tc.dec)
+			assert.Equal(t, tc.cp.LegacyDecimals(), tc.dec)
 		})
 	}
 }
@@ -163,8 +163,9 @@ func TestCurrencyPairState(t *testing.T) {
 		{
 			"zero nonce, and nil price - valid",
 			types.CurrencyPairState{
-				Nonce: 0,
-				Price: nil,
+				Nonce:    0,
+				Price:    nil,
+				Decimals: 8,
 			},
 			true,
 		},
@@ -175,9 +176,28 @@ func TestCurrencyPairState(t *testing.T) {
 				Price: &types.QuotePrice{
 					Price: math.NewInt(1),
 				},
+				Decimals: 8,
 			},
 			true,
 		},
+		{
+			"zero decimals - invalid",
+			types.CurrencyPairState{
+				Nonce:    0,
+				Price:    nil,
+				Decimals: 0,
+			},
+			false,
+		},
+		{
+			"decimals greater than max decimals - invalid",
+			types.CurrencyPairState{
+				Nonce:    0,
+				Price:    nil,
+				Decimals: types.MaxDecimals + 1,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range tcs {
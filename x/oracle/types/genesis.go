@@ -20,10 +20,24 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 	if cpg.CurrencyPairPrice == nil && cpg.Nonce != 0 {
 		return fmt.Errorf("invalid nonce, no price update but non-zero nonce: %v", cpg.Nonce)
 	}
+	// zero decimals are allowed for geneses exported before decimals were stored in state, in which
+	// case the legacy decimals of the CurrencyPair are used
+	if cpg.Decimals != 0 {
+		return ValidateDecimals(cpg.Decimals)
+	}
 
 	return nil
 }
 
+// GetDecimalsOrDefault returns the number of decimals that the price of the CurrencyPair is reported with. If the
+// genesis does not set the decimals, the legacy decimals of the CurrencyPair are returned.
+func (cpg *CurrencyPairGenesis) GetDecimalsOrDefault() uint64 {
+	if cpg.Decimals == 0 {
+		return cpg.CurrencyPair.LegacyDecimals()
+	}
+	return cpg.Decimals
+}
+
 // NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses.
 func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
 	return &GenesisState{
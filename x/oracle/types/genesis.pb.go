@@ -151,6 +151,9 @@ type CurrencyPairState struct {
 	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// ID is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Decimals is the number of decimals that the price of the CurrencyPair is
+	// reported with
+	Decimals uint64 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
 func (m *CurrencyPairState) Reset()         { *m = CurrencyPairState{} }
@@ -207,6 +210,13 @@ func (m *CurrencyPairState) GetId() uint64 {
 	return 0
 }
 
+func (m *CurrencyPairState) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 // CurrencyPairGenesis is the information necessary for initialization of a
 // CurrencyPair.
 type CurrencyPairGenesis struct {
@@ -220,6 +230,9 @@ type CurrencyPairGenesis struct {
 	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// id is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// decimals is the number of decimals that the price of the CurrencyPair is
+	// reported with. If zero, the legacy default for the CurrencyPair is used
+	Decimals uint64 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
 func (m *CurrencyPairGenesis) Reset()         { *m = CurrencyPairGenesis{} }
@@ -283,6 +296,70 @@ func (m *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+func (m *CurrencyPairGenesis) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// CurrencyPairDecimals is the number of decimals that the price of a
+// CurrencyPair is reported with.
+type CurrencyPairDecimals struct {
+	// The CurrencyPair that the decimals are set for
+	CurrencyPair CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// decimals is the number of decimals that the price of the CurrencyPair is
+	// reported with
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *CurrencyPairDecimals) Reset()         { *m = CurrencyPairDecimals{} }
+func (m *CurrencyPairDecimals) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairDecimals) ProtoMessage()    {}
+func (*CurrencyPairDecimals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{4}
+}
+func (m *CurrencyPairDecimals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairDecimals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairDecimals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairDecimals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairDecimals.Merge(m, src)
+}
+func (m *CurrencyPairDecimals) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairDecimals) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairDecimals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairDecimals proto.InternalMessageInfo
+
+func (m *CurrencyPairDecimals) GetCurrencyPair() CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return CurrencyPair{}
+}
+
+func (m *CurrencyPairDecimals) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -298,7 +375,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{4}
+	return fileDescriptor_de36a97821ccc13b, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,46 +423,50 @@ func init() {
 	proto.RegisterType((*QuotePrice)(nil), "slinky.oracle.v1.QuotePrice")
 	proto.RegisterType((*CurrencyPairState)(nil), "slinky.oracle.v1.CurrencyPairState")
 	proto.RegisterType((*CurrencyPairGenesis)(nil), "slinky.oracle.v1.CurrencyPairGenesis")
+	proto.RegisterType((*CurrencyPairDecimals)(nil), "slinky.oracle.v1.CurrencyPairDecimals")
 	proto.RegisterType((*GenesisState)(nil), "slinky.oracle.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/genesis.proto", fileDescriptor_de36a97821ccc13b) }
 
 var fileDescriptor_de36a97821ccc13b = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0xfe, 0x38, 0x0e, 0xb7, 0x1c, 0x5c, 0xda, 0x13, 0xa5, 0x42, 0x49, 0xa9, 0x84,
-	0x54, 0x84, 0xce, 0xd1, 0x95, 0x05, 0xb1, 0x91, 0x1b, 0xa0, 0x03, 0x52, 0x09, 0x4c, 0x2c, 0x51,
-	0xea, 0x98, 0xd4, 0x6a, 0x13, 0x47, 0xb1, 0x5b, 0x5d, 0x37, 0x46, 0xc6, 0x1b, 0x19, 0xf9, 0x23,
-	0xf8, 0x07, 0xd8, 0x3a, 0x9e, 0x98, 0xd0, 0x09, 0x15, 0xd4, 0xfe, 0x23, 0xa8, 0xb6, 0x5b, 0x52,
-	0x0e, 0xa1, 0xdb, 0xfc, 0xde, 0xf3, 0x7b, 0xdf, 0xf7, 0x79, 0xcf, 0x86, 0x16, 0x1f, 0xd3, 0x64,
-	0x34, 0x73, 0x58, 0x16, 0xe0, 0x31, 0x71, 0xa6, 0x27, 0x4e, 0x44, 0x12, 0xc2, 0x29, 0x47, 0x69,
-	0xc6, 0x04, 0x33, 0xef, 0xa8, 0x38, 0x52, 0x71, 0x34, 0x3d, 0x69, 0xd6, 0x23, 0x16, 0x31, 0x19,
-	0x74, 0xd6, 0x27, 0x75, 0xaf, 0x69, 0x47, 0x8c, 0x45, 0x63, 0xe2, 0x48, 0x6b, 0x30, 0x79, 0xef,
-	0x08, 0x1a, 0x13, 0x2e, 0x82, 0x38, 0xd5, 0x17, 0xee, 0x61, 0xc6, 0x63, 0xc6, 0x7d, 0x95, 0xa9,
-	0x0c, 0x15, 0x6a, 0xbb, 0xb0, 0x7a, 0x3a, 0xc9, 0x32, 0x92, 0xe0, 0x59, 0x3f, 0xa0, 0x99, 0x69,
-	0xc2, 0x92, 0x1b, 0x70, 0xd2, 0x00, 0x2d, 0xd0, 0xb9, 0xe9, 0xc9, 0xb3, 0x59, 0x87, 0xe5, 0xd7,
-	0x13, 0x26, 0x48, 0xa3, 0x20, 0x9d, 0xca, 0x78, 0xb6, 0xff, 0xe9, 0xb3, 0x6d, 0x7c, 0xf8, 0xd1,
-	0x32, 0xda, 0x5f, 0x01, 0x84, 0xd2, 0xd7, 0xcf, 0x28, 0x26, 0xe6, 0x73, 0x58, 0x4e, 0xd7, 0x07,
-	0x55, 0xc3, 0x7d, 0x3c, 0x5f, 0xd8, 0xc6, 0xe5, 0xc2, 0x3e, 0x52, 0xba, 0x3c, 0x1c, 0x21, 0xca,
-	0x9c, 0x38, 0x10, 0x43, 0xd4, 0x4b, 0xc4, 0xb7, 0x2f, 0xc7, 0x50, 0x37, 0xd4, 0x4b, 0x84, 0xa7,
-	0x32, 0xcd, 0x57, 0xf0, 0xf6, 0x60, 0xcc, 0xf0, 0xc8, 0xdf, 0x92, 0x48, 0xed, 0x4a, 0xb7, 0x89,
-	0x14, 0x2b, 0xda, 0xb0, 0xa2, 0xb7, 0x9b, 0x1b, 0xee, 0xfe, 0x5a, 0xe8, 0xfc, 0xa7, 0x0d, 0xbc,
-	0x03, 0x99, 0xbc, 0x8d, 0x98, 0x0f, 0x60, 0x55, 0x95, 0x1b, 0x12, 0x1a, 0x0d, 0x45, 0xa3, 0xd8,
-	0x02, 0x9d, 0x92, 0x57, 0x91, 0xbe, 0x97, 0xd2, 0xd5, 0xe6, 0xf0, 0x30, 0x3f, 0x87, 0x37, 0x22,
-	0x10, 0xc4, 0x7c, 0x9a, 0x27, 0xa9, 0x74, 0xef, 0xa3, 0xbf, 0x17, 0x82, 0xfe, 0x60, 0xbb, 0xa5,
-	0xf9, 0xc2, 0x06, 0x1b, 0x80, 0x3a, 0x2c, 0x27, 0x2c, 0xc1, 0x6a, 0x64, 0x25, 0x4f, 0x19, 0xe6,
-	0x01, 0x2c, 0xd0, 0x50, 0xab, 0x17, 0x68, 0xd8, 0xbe, 0x04, 0xb0, 0x96, 0x57, 0x7d, 0xa1, 0xd6,
-	0x6f, 0xf6, 0xe0, 0x2d, 0xac, 0xdd, 0x7e, 0x1a, 0xd0, 0x4c, 0xeb, 0x5b, 0x57, 0xf5, 0xf3, 0xd9,
-	0xb2, 0x03, 0xc3, 0xab, 0xe2, 0xfc, 0x3e, 0x3d, 0x58, 0xdb, 0x29, 0xe5, 0x2b, 0xa0, 0xc2, 0xb5,
-	0x81, 0x0e, 0xf3, 0xe5, 0xfa, 0xbb, 0x70, 0xc5, 0xab, 0x70, 0xa5, 0x2d, 0xdc, 0x47, 0x00, 0xab,
-	0x1a, 0x48, 0x4d, 0xd3, 0x87, 0x47, 0xbb, 0xad, 0xe8, 0xd7, 0xde, 0x00, 0xad, 0x62, 0xa7, 0xd2,
-	0x7d, 0xf8, 0x7f, 0x3a, 0x5d, 0x4a, 0x43, 0xd6, 0xf0, 0x3f, 0xc6, 0x76, 0x17, 0xde, 0x48, 0xc8,
-	0x99, 0xf0, 0x69, 0xa8, 0xc7, 0xbe, 0xb7, 0x36, 0x7b, 0xa1, 0x7b, 0x3a, 0x5f, 0x5a, 0xe0, 0x62,
-	0x69, 0x81, 0x5f, 0x4b, 0x0b, 0x9c, 0xaf, 0x2c, 0xe3, 0x62, 0x65, 0x19, 0xdf, 0x57, 0x96, 0xf1,
-	0xee, 0x51, 0x44, 0xc5, 0x70, 0x32, 0x40, 0x98, 0xc5, 0x0e, 0x1f, 0xd1, 0xf4, 0x38, 0x26, 0x53,
-	0x47, 0x7f, 0xcb, 0xb3, 0xcd, 0xc7, 0x14, 0xb3, 0x94, 0xf0, 0xc1, 0x9e, 0x7c, 0x72, 0x4f, 0x7e,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xea, 0x46, 0x20, 0x4f, 0xb6, 0x03, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbd, 0x8e, 0xd3, 0x4c,
+	0x14, 0xf5, 0x64, 0x9d, 0xfd, 0xf6, 0x9b, 0x84, 0x85, 0x75, 0xb2, 0x22, 0x44, 0xc8, 0x0e, 0x91,
+	0x90, 0x82, 0xd0, 0xda, 0xda, 0xd0, 0x20, 0x3a, 0xbc, 0x48, 0x90, 0x02, 0x29, 0x18, 0x2a, 0x1a,
+	0xcb, 0x19, 0x0f, 0xce, 0x28, 0xb1, 0xc7, 0xf2, 0x4c, 0xa2, 0x4d, 0x81, 0x44, 0x49, 0xc7, 0x96,
+	0x94, 0x3c, 0x04, 0x2f, 0x40, 0x97, 0x72, 0x45, 0x85, 0x10, 0x0a, 0x28, 0x79, 0x07, 0x6a, 0x94,
+	0x99, 0x49, 0x70, 0xb2, 0x2b, 0x44, 0x41, 0x37, 0xf7, 0xdc, 0xb9, 0xf7, 0x9c, 0x73, 0xe7, 0x07,
+	0x9a, 0x6c, 0x48, 0x92, 0xc1, 0xc4, 0xa1, 0x59, 0x80, 0x86, 0xd8, 0x19, 0x1f, 0x3b, 0x11, 0x4e,
+	0x30, 0x23, 0xcc, 0x4e, 0x33, 0xca, 0xa9, 0x71, 0x4d, 0xe6, 0x6d, 0x99, 0xb7, 0xc7, 0xc7, 0xf5,
+	0x6a, 0x44, 0x23, 0x2a, 0x92, 0xce, 0x72, 0x25, 0xf7, 0xd5, 0xad, 0x88, 0xd2, 0x68, 0x88, 0x1d,
+	0x11, 0xf5, 0x46, 0xaf, 0x1c, 0x4e, 0x62, 0xcc, 0x78, 0x10, 0xa7, 0x6a, 0xc3, 0x0d, 0x44, 0x59,
+	0x4c, 0x99, 0x2f, 0x2b, 0x65, 0x20, 0x53, 0x4d, 0x17, 0x96, 0x4f, 0x46, 0x59, 0x86, 0x13, 0x34,
+	0xe9, 0x06, 0x24, 0x33, 0x0c, 0xa8, 0xbb, 0x01, 0xc3, 0x35, 0xd0, 0x00, 0xad, 0xff, 0x3d, 0xb1,
+	0x36, 0xaa, 0xb0, 0xf8, 0x6c, 0x44, 0x39, 0xae, 0x15, 0x04, 0x28, 0x83, 0x07, 0x7b, 0xef, 0x3f,
+	0x58, 0xda, 0x9b, 0x6f, 0x0d, 0xad, 0xf9, 0x09, 0x40, 0x28, 0xb0, 0x6e, 0x46, 0x10, 0x36, 0x1e,
+	0xc2, 0x62, 0xba, 0x5c, 0xc8, 0x1e, 0xee, 0xdd, 0xe9, 0xcc, 0xd2, 0xbe, 0xce, 0xac, 0x43, 0xc9,
+	0xcb, 0xc2, 0x81, 0x4d, 0xa8, 0x13, 0x07, 0xbc, 0x6f, 0x77, 0x12, 0xfe, 0xf9, 0xe3, 0x11, 0x54,
+	0x82, 0x3a, 0x09, 0xf7, 0x64, 0xa5, 0xf1, 0x14, 0x5e, 0xed, 0x0d, 0x29, 0x1a, 0xf8, 0x6b, 0x27,
+	0x82, 0xbb, 0xd4, 0xae, 0xdb, 0xd2, 0xab, 0xbd, 0xf2, 0x6a, 0xbf, 0x58, 0xed, 0x70, 0xf7, 0x96,
+	0x44, 0x67, 0xdf, 0x2d, 0xe0, 0xed, 0x8b, 0xe2, 0x75, 0xc6, 0xb8, 0x05, 0xcb, 0xb2, 0x5d, 0x1f,
+	0x93, 0xa8, 0xcf, 0x6b, 0x3b, 0x0d, 0xd0, 0xd2, 0xbd, 0x92, 0xc0, 0x9e, 0x08, 0xa8, 0xf9, 0x0e,
+	0xc0, 0x83, 0xfc, 0x20, 0x9e, 0xf3, 0x80, 0x63, 0xe3, 0x7e, 0xde, 0x4a, 0xa9, 0x7d, 0xd3, 0xde,
+	0x3e, 0x11, 0xfb, 0xb7, 0x6f, 0x57, 0x9f, 0xce, 0x2c, 0xb0, 0x72, 0x50, 0x85, 0xc5, 0x84, 0x26,
+	0x48, 0xce, 0x4c, 0xf7, 0x64, 0x60, 0xec, 0xc3, 0x02, 0x09, 0x15, 0x7d, 0x81, 0x84, 0x46, 0x1d,
+	0xee, 0x85, 0x18, 0x91, 0x38, 0x18, 0xb2, 0x9a, 0x2e, 0xd0, 0x75, 0xdc, 0xfc, 0x09, 0x60, 0x25,
+	0xaf, 0xe8, 0xb1, 0xbc, 0x1b, 0x46, 0x07, 0x5e, 0x41, 0x0a, 0xf6, 0xd3, 0x80, 0x64, 0x4a, 0x9b,
+	0x79, 0x51, 0x5b, 0xbe, 0x5a, 0xa8, 0xd3, 0xbc, 0x32, 0xca, 0x1f, 0xb6, 0x07, 0x2b, 0x1b, 0xad,
+	0x7c, 0x69, 0xb6, 0xf0, 0xd7, 0x66, 0x0f, 0xf2, 0xed, 0xba, 0x9b, 0xc6, 0x77, 0x2e, 0x1a, 0xd7,
+	0x2f, 0x35, 0x5e, 0xdc, 0x32, 0xfe, 0x1a, 0x56, 0xf3, 0xca, 0x1f, 0x29, 0xfc, 0x5f, 0x1a, 0xcf,
+	0xd3, 0x17, 0xb6, 0xe8, 0xdf, 0x02, 0x58, 0x56, 0xb3, 0x96, 0x97, 0xc0, 0x87, 0x87, 0x9b, 0x53,
+	0x52, 0xaf, 0xb4, 0x06, 0x1a, 0x3b, 0xad, 0x52, 0xfb, 0xf6, 0x9f, 0xf9, 0x55, 0x2b, 0x25, 0xa3,
+	0x82, 0x2e, 0x39, 0xd1, 0xeb, 0xf0, 0xbf, 0x04, 0x9f, 0x72, 0x9f, 0x84, 0x4a, 0xcc, 0xee, 0x32,
+	0xec, 0x84, 0xee, 0xc9, 0x74, 0x6e, 0x82, 0xf3, 0xb9, 0x09, 0x7e, 0xcc, 0x4d, 0x70, 0xb6, 0x30,
+	0xb5, 0xf3, 0x85, 0xa9, 0x7d, 0x59, 0x98, 0xda, 0xcb, 0x3b, 0x11, 0xe1, 0xfd, 0x51, 0xcf, 0x46,
+	0x34, 0x76, 0xd8, 0x80, 0xa4, 0x47, 0x31, 0x1e, 0x3b, 0xea, 0x3b, 0x39, 0x5d, 0x7d, 0x28, 0x7c,
+	0x92, 0x62, 0xd6, 0xdb, 0x15, 0x4f, 0xe5, 0xde, 0xaf, 0x01, 0x00, 0xb1, 0x5d, 0x31, 0x76, 0x6e,
+	0x04, 0x00, 0x00,
 }
 
 func (m *CurrencyPair) Marshal() (dAtA []byte, err error) {
@@ -491,6 +572,11 @@ func (m *CurrencyPairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -536,6 +622,11 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -571,6 +662,44 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CurrencyPairDecimals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairDecimals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairDecimals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -673,6 +802,9 @@ func (m *CurrencyPairState) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovGenesis(uint64(m.Id))
 	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
 	return n
 }
 
@@ -694,6 +826,23 @@ func (m *CurrencyPairGenesis) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovGenesis(uint64(m.Id))
 	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *CurrencyPairDecimals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
 	return n
 }
 
@@ -1074,6 +1223,25 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1231,6 +1399,127 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairDecimals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairDecimals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairDecimals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
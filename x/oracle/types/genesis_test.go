@@ -34,6 +34,20 @@ func TestGenesisValidation(t *testing.T) {
 			0,
 			false,
 		},
+		{
+			"if the decimals of a currency-pair genesis are invalid - fail",
+			[]types.CurrencyPairGenesis{
+				{
+					CurrencyPair: types.CurrencyPair{
+						Base:  "AA",
+						Quote: "BB",
+					},
+					Decimals: types.MaxDecimals + 1,
+				},
+			},
+			1,
+			false,
+		},
 		{
 			"if the CurrencyPairPrice is nil, but the nonce is non-zero - fail",
 			[]types.CurrencyPairGenesis{
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,6 +19,17 @@ func NewMsgAddCurrencyPairs(authority string, cps []CurrencyPair) MsgAddCurrency
 	}
 }
 
+// GetDecimalsForCurrencyPair returns the number of decimals that the price of the given currency-pair is
+// reported with. If the message does not set the decimals, the legacy decimals of the currency-pair are returned.
+func (m MsgAddCurrencyPairs) GetDecimalsForCurrencyPair(cp CurrencyPair) uint64 {
+	for _, d := range m.Decimals {
+		if d.CurrencyPair == cp {
+			return d.Decimals
+		}
+	}
+	return cp.LegacyDecimals()
+}
+
 // GetSigners, get the addresses that must sign this message. In this case, the signer
 // must be the module authority.
 func (m MsgAddCurrencyPairs) GetSigners() []sdk.AccAddress {
@@ -26,7 +39,8 @@ func (m MsgAddCurrencyPairs) GetSigners() []sdk.AccAddress {
 }
 
 // ValidateBasic determines whether or not the information in the message is formatted correctly, specifically
-// whether the authority is a valid acc-address, and that each CurrencyPair in the message is formatted correctly.
+// whether the authority is a valid acc-address, that each CurrencyPair in the message is formatted correctly, and
+// that the decimals are set at most once for each of the message's CurrencyPairs.
 func (m MsgAddCurrencyPairs) ValidateBasic() error {
 	// validate authority address
 	_, err := sdk.AccAddressFromBech32(m.Authority)
@@ -35,10 +49,29 @@ func (m MsgAddCurrencyPairs) ValidateBasic() error {
 	}
 
 	// validate currency pairs
+	cps := make(map[CurrencyPair]struct{}, len(m.CurrencyPairs))
 	for _, cp := range m.CurrencyPairs {
 		if err := cp.ValidateBasic(); err != nil {
 			return err
 		}
+		cps[cp] = struct{}{}
+	}
+
+	// validate decimals
+	seen := make(map[CurrencyPair]struct{}, len(m.Decimals))
+	for _, d := range m.Decimals {
+		if _, ok := cps[d.CurrencyPair]; !ok {
+			return fmt.Errorf("decimals set for currency pair %s that is not being added", d.CurrencyPair)
+		}
+
+		if _, ok := seen[d.CurrencyPair]; ok {
+			return fmt.Errorf("decimals set more than once for currency pair %s", d.CurrencyPair)
+		}
+		seen[d.CurrencyPair] = struct{}{}
+
+		if err := ValidateDecimals(d.Decimals); err != nil {
+			return fmt.Errorf("invalid decimals for currency pair %s: %w", d.CurrencyPair, err)
+		}
 	}
 
 	return nil
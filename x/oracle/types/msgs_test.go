@@ -61,6 +61,60 @@ func TestValidateBasicMsgAddCurrencyPairs(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"if decimals are set for a currency pair that is not being added - fail",
+			types.MsgAddCurrencyPairs{
+				Authority: sdk.AccAddress([]byte("abc")).String(),
+				CurrencyPairs: []types.CurrencyPair{
+					{Base: "A", Quote: "B"},
+				},
+				Decimals: []types.CurrencyPairDecimals{
+					{CurrencyPair: types.CurrencyPair{Base: "C", Quote: "D"}, Decimals: 18},
+				},
+			},
+			false,
+		},
+		{
+			"if decimals are set more than once for a currency pair - fail",
+			types.MsgAddCurrencyPairs{
+				Authority: sdk.AccAddress([]byte("abc")).String(),
+				CurrencyPairs: []types.CurrencyPair{
+					{Base: "A", Quote: "B"},
+				},
+				Decimals: []types.CurrencyPairDecimals{
+					{CurrencyPair: types.CurrencyPair{Base: "A", Quote: "B"}, Decimals: 18},
+					{CurrencyPair: types.CurrencyPair{Base: "A", Quote: "B"}, Decimals: 12},
+				},
+			},
+			false,
+		},
+		{
+			"if the decimals are invalid - fail",
+			types.MsgAddCurrencyPairs{
+				Authority: sdk.AccAddress([]byte("abc")).String(),
+				CurrencyPairs: []types.CurrencyPair{
+					{Base: "A", Quote: "B"},
+				},
+				Decimals: []types.CurrencyPairDecimals{
+					{CurrencyPair: types.CurrencyPair{Base: "A", Quote: "B"}, Decimals: 0},
+				},
+			},
+			false,
+		},
+		{
+			"if the decimals are valid - pass",
+			types.MsgAddCurrencyPairs{
+				Authority: sdk.AccAddress([]byte("abc")).String(),
+				CurrencyPairs: []types.CurrencyPair{
+					{Base: "A", Quote: "B"},
+					{Base: "C", Quote: "D"},
+				},
+				Decimals: []types.CurrencyPairDecimals{
+					{CurrencyPair: types.CurrencyPair{Base: "A", Quote: "B"}, Decimals: 18},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -42,6 +42,10 @@ type MsgAddCurrencyPairs struct {
 	// set of CurrencyPairs to be added to the module (+ prices if they are to be
 	// set)
 	CurrencyPairs []CurrencyPair `protobuf:"bytes,2,rep,name=currency_pairs,json=currencyPairs,proto3" json:"currency_pairs"`
+	// decimals is the number of decimals that the prices of the added
+	// CurrencyPairs are reported with. CurrencyPairs without an entry use the
+	// legacy default (18 if the quote is ETHEREUM, 8 otherwise)
+	Decimals []CurrencyPairDecimals `protobuf:"bytes,3,rep,name=decimals,proto3" json:"decimals"`
 }
 
 func (m *MsgAddCurrencyPairs) Reset()         { *m = MsgAddCurrencyPairs{} }
@@ -91,6 +95,13 @@ func (m *MsgAddCurrencyPairs) GetCurrencyPairs() []CurrencyPair {
 	return nil
 }
 
+func (m *MsgAddCurrencyPairs) GetDecimals() []CurrencyPairDecimals {
+	if m != nil {
+		return m.Decimals
+	}
+	return nil
+}
+
 type MsgAddCurrencyPairsResponse struct {
 }
 
@@ -234,35 +245,36 @@ func init() {
 func init() { proto.RegisterFile("slinky/oracle/v1/tx.proto", fileDescriptor_1cd987ee4c7d5236) }
 
 var fileDescriptor_1cd987ee4c7d5236 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x48, 0xe9, 0x41, 0xa4, 0xf4, 0xca, 0x0c, 0xa5,
-	0xe4, 0x30, 0x14, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0x74, 0x48, 0x49, 0x26, 0xe7,
-	0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x1c, 0xc2, 0xd3, 0xcf,
-	0x2d, 0x4e, 0x07, 0xe9, 0xcb, 0x2d, 0x4e, 0x87, 0x4a, 0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb,
-	0x83, 0x49, 0xa8, 0x90, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0xc4, 0x0c, 0x10, 0x0b, 0x22, 0xaa, 0x74,
-	0x8d, 0x91, 0x4b, 0xd8, 0xb7, 0x38, 0xdd, 0x31, 0x25, 0xc5, 0xb9, 0xb4, 0xa8, 0x28, 0x35, 0x2f,
-	0xb9, 0x32, 0x20, 0x31, 0xb3, 0xa8, 0x58, 0xc8, 0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf,
-	0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11,
-	0xa8, 0xf5, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41,
-	0x08, 0xa5, 0x42, 0xde, 0x5c, 0x7c, 0xc9, 0x50, 0x83, 0xe2, 0x0b, 0x40, 0x26, 0x49, 0x30, 0x29,
-	0x30, 0x6b, 0x70, 0x1b, 0xc9, 0xe9, 0xa1, 0xfb, 0x5b, 0x0f, 0xd9, 0x42, 0x27, 0x96, 0x13, 0xf7,
-	0xe4, 0x19, 0x82, 0x78, 0x93, 0x91, 0x1d, 0x61, 0x65, 0xf5, 0x62, 0x81, 0x3c, 0x43, 0xd3, 0xf3,
-	0x0d, 0x5a, 0x08, 0x0b, 0xba, 0x9e, 0x6f, 0xd0, 0x52, 0x86, 0x06, 0x58, 0x05, 0x2c, 0xc8, 0xb0,
-	0x78, 0x40, 0x49, 0x96, 0x4b, 0x1a, 0x8b, 0x70, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0xd2, 0x0e, 0x46, 0x2e, 0x31, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4, 0xdc, 0xfc, 0xb2, 0x54, 0xea, 0x78,
-	0x5d, 0x8b, 0x4b, 0x10, 0xc5, 0xeb, 0xf1, 0x99, 0x29, 0x10, 0xdf, 0x73, 0x06, 0xf1, 0x23, 0xfb,
-	0xcb, 0x33, 0x85, 0x24, 0x9f, 0x05, 0xa7, 0x96, 0xa0, 0xfa, 0x4c, 0x81, 0x4b, 0x0e, 0xbb, 0xcb,
-	0x61, 0x9e, 0x33, 0x7a, 0xc9, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x94, 0xc1, 0x25, 0x80, 0x11,
-	0xb1, 0xaa, 0x98, 0x11, 0x81, 0x25, 0x9c, 0xa4, 0x74, 0x89, 0x52, 0x06, 0xb3, 0x51, 0xa8, 0x90,
-	0x4b, 0x18, 0x5b, 0x50, 0x6a, 0x60, 0x35, 0x05, 0x8b, 0x4a, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xac,
-	0x94, 0x62, 0x6d, 0x78, 0xbe, 0x41, 0x8b, 0xd1, 0xc9, 0xf9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x8b, 0xb3, 0x33, 0x0b, 0x74, 0x73, 0x53, 0xcb, 0xf4, 0xd1, 0x03, 0xb8, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0x9c, 0x19, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x66, 0xbb, 0x5e,
-	0xb8, 0x03, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x77, 0x1b, 0x15, 0x33, 0xa2, 0xb6, 0xdb, 0xa2, 0xe9, 0x8a, 0xd3, 0x10, 0x51, 0x62,
+	0x20, 0x3b, 0xb6, 0x82, 0x87, 0xde, 0x9a, 0x7a, 0x50, 0x24, 0x20, 0xdb, 0x9b, 0x97, 0xb0, 0xdd,
+	0x1d, 0x26, 0x43, 0x33, 0x3b, 0xeb, 0xbc, 0x49, 0x68, 0x6e, 0xe2, 0xd1, 0x93, 0x1f, 0xa1, 0x1f,
+	0x21, 0x07, 0x0f, 0x7e, 0x84, 0x1e, 0x8b, 0x27, 0x4f, 0x22, 0xc9, 0x21, 0xfa, 0x2d, 0x24, 0x99,
+	0x89, 0x6d, 0x9a, 0x45, 0x22, 0xf4, 0x12, 0xf2, 0xde, 0xfb, 0xbf, 0xff, 0x7b, 0xef, 0xb7, 0xbb,
+	0x68, 0x13, 0x3a, 0x3c, 0x3d, 0xea, 0x13, 0xa9, 0xa2, 0xb8, 0x43, 0x49, 0x6f, 0x9b, 0xe8, 0xe3,
+	0x20, 0x53, 0x52, 0x4b, 0x6f, 0xd5, 0x94, 0x02, 0x53, 0x0a, 0x7a, 0xdb, 0x3e, 0x5e, 0x10, 0x33,
+	0x9a, 0x52, 0xe0, 0x60, 0x3a, 0xfc, 0xcd, 0x58, 0x82, 0x90, 0xd0, 0x9a, 0x46, 0xc4, 0x04, 0xb6,
+	0x74, 0xdf, 0x44, 0x44, 0x00, 0x9b, 0xf4, 0x09, 0x60, 0xb6, 0xb0, 0x16, 0x09, 0x9e, 0x4a, 0x32,
+	0xfd, 0xb5, 0xa9, 0x0d, 0x26, 0x99, 0x34, 0x1e, 0x93, 0x7f, 0x26, 0x5b, 0x39, 0x59, 0x41, 0xeb,
+	0x4d, 0x60, 0x7b, 0x49, 0xb2, 0xdf, 0x55, 0x8a, 0xa6, 0x71, 0xff, 0x6d, 0xc4, 0x15, 0x78, 0x2f,
+	0x50, 0x31, 0xea, 0xea, 0xb6, 0x54, 0x5c, 0xf7, 0x4b, 0x6e, 0xd9, 0xad, 0x16, 0x1b, 0xa5, 0x6f,
+	0x5f, 0xea, 0x1b, 0x76, 0xfc, 0x5e, 0x92, 0x28, 0x0a, 0x70, 0xa0, 0x15, 0x4f, 0x59, 0x78, 0x2e,
+	0xf5, 0xde, 0xa0, 0x3b, 0xb1, 0x35, 0x6a, 0x65, 0x13, 0xa7, 0xd2, 0x4a, 0xb9, 0x50, 0xbd, 0xb5,
+	0x83, 0x83, 0xcb, 0x77, 0x07, 0x17, 0x07, 0x36, 0xae, 0x9d, 0xfe, 0xd8, 0x72, 0xc2, 0xdb, 0xf1,
+	0xdc, 0x12, 0xaf, 0xd0, 0xcd, 0x84, 0xc6, 0x5c, 0x44, 0x1d, 0x28, 0x15, 0xa6, 0x36, 0x4f, 0xfe,
+	0x6d, 0xf3, 0xd2, 0xaa, 0xad, 0xdd, 0xdf, 0xee, 0xdd, 0xdd, 0x5f, 0x27, 0x5b, 0xce, 0xc7, 0xf1,
+	0xa0, 0x76, 0xbe, 0xea, 0xa7, 0xf1, 0xa0, 0xf6, 0xc8, 0xa2, 0x3f, 0x9e, 0xc1, 0xcf, 0x41, 0x51,
+	0x79, 0x88, 0x1e, 0xe4, 0xa4, 0x43, 0x0a, 0x99, 0x4c, 0x81, 0x56, 0xbe, 0xba, 0xe8, 0x5e, 0x13,
+	0x58, 0x48, 0x85, 0xec, 0xd1, 0xab, 0x81, 0x58, 0x43, 0x6b, 0x73, 0x10, 0x5b, 0x3c, 0x31, 0x1c,
+	0x8b, 0xe1, 0xdd, 0x8b, 0x84, 0x5e, 0x27, 0xff, 0x75, 0xd9, 0x01, 0xd5, 0xf3, 0x97, 0x95, 0x11,
+	0xce, 0xdf, 0x7c, 0x76, 0xdc, 0xce, 0x6f, 0x17, 0x15, 0x9a, 0xc0, 0xbc, 0x36, 0x5a, 0x5d, 0x78,
+	0x45, 0x1e, 0x2f, 0x3e, 0x8b, 0x1c, 0x4e, 0x7e, 0x7d, 0x29, 0xd9, 0x6c, 0xa2, 0xf7, 0x1e, 0xad,
+	0xe7, 0xa1, 0xac, 0xe6, 0xba, 0xe4, 0x28, 0xfd, 0x67, 0xcb, 0x2a, 0x67, 0x23, 0xfd, 0xeb, 0x1f,
+	0xc6, 0x83, 0x9a, 0xdb, 0xd8, 0x3f, 0x1d, 0x62, 0xf7, 0x6c, 0x88, 0xdd, 0x9f, 0x43, 0xec, 0x7e,
+	0x1e, 0x61, 0xe7, 0x6c, 0x84, 0x9d, 0xef, 0x23, 0xec, 0xbc, 0x7b, 0xca, 0xb8, 0x6e, 0x77, 0x0f,
+	0x83, 0x58, 0x0a, 0x02, 0x47, 0x3c, 0xab, 0x0b, 0xda, 0x23, 0x97, 0x01, 0xeb, 0x7e, 0x46, 0xe1,
+	0xf0, 0xc6, 0xf4, 0xb3, 0x7a, 0xfe, 0x67, 0x00, 0x7a, 0xbb, 0x09, 0xe7, 0x02, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -413,6 +425,20 @@ func (m *MsgAddCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Decimals) > 0 {
+		for iNdEx := len(m.Decimals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decimals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.CurrencyPairs) > 0 {
 		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -549,6 +575,12 @@ func (m *MsgAddCurrencyPairs) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.Decimals) > 0 {
+		for _, e := range m.Decimals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -690,6 +722,40 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decimals = append(m.Decimals, CurrencyPairDecimals{})
+			if err := m.Decimals[len(m.Decimals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])